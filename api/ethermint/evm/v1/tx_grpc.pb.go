@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_EthereumTx_FullMethodName   = "/ethermint.evm.v1.Msg/EthereumTx"
 	Msg_UpdateParams_FullMethodName = "/ethermint.evm.v1.Msg/UpdateParams"
+	Msg_SetFeeDenom_FullMethodName  = "/ethermint.evm.v1.Msg/SetFeeDenom"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +33,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to opt in paying the fees of its Ethereum
+	// transactions in an alternative fee denomination.
+	SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +64,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error) {
+	out := new(MsgSetFeeDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +82,9 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to opt in paying the fees of its Ethereum
+	// transactions in an alternative fee denomination.
+	SetFeeDenom(context.Context, *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +98,9 @@ func (UnimplementedMsgServer) EthereumTx(context.Context, *MsgEthereumTx) (*MsgE
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetFeeDenom(context.Context, *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeDenom not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +150,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeDenom(ctx, req.(*MsgSetFeeDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +183,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeDenom",
+			Handler:    _Msg_SetFeeDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
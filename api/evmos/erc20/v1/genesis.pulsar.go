@@ -586,10 +586,57 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]string
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field NativePrecompiles as it is not of Message kind"))
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                 protoreflect.MessageDescriptor
-	fd_Params_enable_erc20    protoreflect.FieldDescriptor
-	fd_Params_enable_evm_hook protoreflect.FieldDescriptor
+	md_Params                    protoreflect.MessageDescriptor
+	fd_Params_enable_erc20       protoreflect.FieldDescriptor
+	fd_Params_enable_evm_hook    protoreflect.FieldDescriptor
+	fd_Params_native_precompiles protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -597,6 +644,7 @@ func init() {
 	md_Params = File_evmos_erc20_v1_genesis_proto.Messages().ByName("Params")
 	fd_Params_enable_erc20 = md_Params.Fields().ByName("enable_erc20")
 	fd_Params_enable_evm_hook = md_Params.Fields().ByName("enable_evm_hook")
+	fd_Params_native_precompiles = md_Params.Fields().ByName("native_precompiles")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -676,6 +724,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.NativePrecompiles) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.NativePrecompiles})
+		if !f(fd_Params_native_precompiles, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -695,6 +749,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.EnableErc20 != false
 	case "evmos.erc20.v1.Params.enable_evm_hook":
 		return x.EnableEvmHook != false
+	case "evmos.erc20.v1.Params.native_precompiles":
+		return len(x.NativePrecompiles) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.erc20.v1.Params"))
@@ -715,6 +771,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.EnableErc20 = false
 	case "evmos.erc20.v1.Params.enable_evm_hook":
 		x.EnableEvmHook = false
+	case "evmos.erc20.v1.Params.native_precompiles":
+		x.NativePrecompiles = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.erc20.v1.Params"))
@@ -737,6 +795,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "evmos.erc20.v1.Params.enable_evm_hook":
 		value := x.EnableEvmHook
 		return protoreflect.ValueOfBool(value)
+	case "evmos.erc20.v1.Params.native_precompiles":
+		if len(x.NativePrecompiles) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.NativePrecompiles}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.erc20.v1.Params"))
@@ -761,6 +825,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.EnableErc20 = value.Bool()
 	case "evmos.erc20.v1.Params.enable_evm_hook":
 		x.EnableEvmHook = value.Bool()
+	case "evmos.erc20.v1.Params.native_precompiles":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.NativePrecompiles = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.erc20.v1.Params"))
@@ -781,6 +849,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "evmos.erc20.v1.Params.native_precompiles":
+		if x.NativePrecompiles == nil {
+			x.NativePrecompiles = []string{}
+		}
+		value := &_Params_3_list{list: &x.NativePrecompiles}
+		return protoreflect.ValueOfList(value)
 	case "evmos.erc20.v1.Params.enable_erc20":
 		panic(fmt.Errorf("field enable_erc20 of message evmos.erc20.v1.Params is not mutable"))
 	case "evmos.erc20.v1.Params.enable_evm_hook":
@@ -802,6 +876,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "evmos.erc20.v1.Params.enable_evm_hook":
 		return protoreflect.ValueOfBool(false)
+	case "evmos.erc20.v1.Params.native_precompiles":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.erc20.v1.Params"))
@@ -877,6 +954,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableEvmHook {
 			n += 2
 		}
+		if len(x.NativePrecompiles) > 0 {
+			for _, s := range x.NativePrecompiles {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -906,6 +989,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NativePrecompiles) > 0 {
+			for iNdEx := len(x.NativePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NativePrecompiles[iNdEx])
+				copy(dAtA[i:], x.NativePrecompiles[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativePrecompiles[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if x.EnableEvmHook {
 			i--
 			if x.EnableEvmHook {
@@ -1015,6 +1107,38 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableEvmHook = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativePrecompiles", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativePrecompiles = append(x.NativePrecompiles, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1120,6 +1244,9 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEvmHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// native_precompiles is the list of the token pair ERC20 addresses served by a native ERC20
+	// precompiled contract, which operates on the bank balances of the pair denom.
+	NativePrecompiles []string `protobuf:"bytes,3,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1156,6 +1283,13 @@ func (x *Params) GetEnableEvmHook() bool {
 	return false
 }
 
+func (x *Params) GetNativePrecompiles() []string {
+	if x != nil {
+		return x.NativePrecompiles
+	}
+	return nil
+}
+
 var File_evmos_erc20_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_evmos_erc20_v1_genesis_proto_rawDesc = []byte{
@@ -1173,14 +1307,17 @@ var file_evmos_erc20_v1_genesis_proto_rawDesc = []byte{
 	0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65,
 	0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
 	0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x66, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x72,
-	0x63, 0x32, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x72, 0x63, 0x32, 0x30, 0x12, 0x39, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x65, 0x76, 0x6d, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x56, 0x4d, 0x48, 0x6f,
-	0x6f, 0x6b, 0x52, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x6d, 0x48, 0x6f, 0x6f,
-	0x6b, 0x42, 0xa5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65,
+	0x72, 0x63, 0x32, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x72, 0x63, 0x32, 0x30, 0x12, 0x39, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x65, 0x76, 0x6d, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x56, 0x4d, 0x48,
+	0x6f, 0x6f, 0x6b, 0x52, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x6d, 0x48, 0x6f,
+	0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x73, 0x42, 0xa5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e,
 	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
 	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73,
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: loka/compliance/v1/query.proto
+
+package compliancev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName        = "/loka.compliance.v1.Query/Params"
+	Query_AddressStatus_FullMethodName = "/loka.compliance.v1.Query/AddressStatus"
+	Query_KYCApproved_FullMethodName   = "/loka.compliance.v1.Query/KYCApproved"
+	Query_Frozen_FullMethodName        = "/loka.compliance.v1.Query/Frozen"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/compliance module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AddressStatus queries the KYC approval and frozen status of an address.
+	AddressStatus(ctx context.Context, in *QueryAddressStatusRequest, opts ...grpc.CallOption) (*QueryAddressStatusResponse, error)
+	// KYCApproved queries the KYC-approved addresses.
+	KYCApproved(ctx context.Context, in *QueryKYCApprovedRequest, opts ...grpc.CallOption) (*QueryKYCApprovedResponse, error)
+	// Frozen queries the frozen addresses.
+	Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressStatus(ctx context.Context, in *QueryAddressStatusRequest, opts ...grpc.CallOption) (*QueryAddressStatusResponse, error) {
+	out := new(QueryAddressStatusResponse)
+	err := c.cc.Invoke(ctx, Query_AddressStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KYCApproved(ctx context.Context, in *QueryKYCApprovedRequest, opts ...grpc.CallOption) (*QueryKYCApprovedResponse, error) {
+	out := new(QueryKYCApprovedResponse)
+	err := c.cc.Invoke(ctx, Query_KYCApproved_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error) {
+	out := new(QueryFrozenResponse)
+	err := c.cc.Invoke(ctx, Query_Frozen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params queries the parameters of x/compliance module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AddressStatus queries the KYC approval and frozen status of an address.
+	AddressStatus(context.Context, *QueryAddressStatusRequest) (*QueryAddressStatusResponse, error)
+	// KYCApproved queries the KYC-approved addresses.
+	KYCApproved(context.Context, *QueryKYCApprovedRequest) (*QueryKYCApprovedResponse, error)
+	// Frozen queries the frozen addresses.
+	Frozen(context.Context, *QueryFrozenRequest) (*QueryFrozenResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) AddressStatus(context.Context, *QueryAddressStatusRequest) (*QueryAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressStatus not implemented")
+}
+func (UnimplementedQueryServer) KYCApproved(context.Context, *QueryKYCApprovedRequest) (*QueryKYCApprovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KYCApproved not implemented")
+}
+func (UnimplementedQueryServer) Frozen(context.Context, *QueryFrozenRequest) (*QueryFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Frozen not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressStatus(ctx, req.(*QueryAddressStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KYCApproved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKYCApprovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KYCApproved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KYCApproved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KYCApproved(ctx, req.(*QueryKYCApprovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Frozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Frozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Frozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Frozen(ctx, req.(*QueryFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loka.compliance.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AddressStatus",
+			Handler:    _Query_AddressStatus_Handler,
+		},
+		{
+			MethodName: "KYCApproved",
+			Handler:    _Query_KYCApproved_Handler,
+		},
+		{
+			MethodName: "Frozen",
+			Handler:    _Query_Frozen_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "loka/compliance/v1/query.proto",
+}
@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: loka/compliance/v1/tx.proto
+
+package compliancev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateParams_FullMethodName = "/loka.compliance.v1.Msg/UpdateParams"
+	Msg_SetKYC_FullMethodName       = "/loka.compliance.v1.Msg/SetKYC"
+	Msg_SetFrozen_FullMethodName    = "/loka.compliance.v1.Msg/SetFrozen"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defined a governance operation for updating the x/compliance module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetKYC adds or removes addresses from the KYC-approved set, it can be executed by
+	// the governance account or the admin.
+	SetKYC(ctx context.Context, in *MsgSetKYC, opts ...grpc.CallOption) (*MsgSetKYCResponse, error)
+	// SetFrozen freezes or unfreezes addresses, it can be executed by the governance
+	// account or the admin.
+	SetFrozen(ctx context.Context, in *MsgSetFrozen, opts ...grpc.CallOption) (*MsgSetFrozenResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetKYC(ctx context.Context, in *MsgSetKYC, opts ...grpc.CallOption) (*MsgSetKYCResponse, error) {
+	out := new(MsgSetKYCResponse)
+	err := c.cc.Invoke(ctx, Msg_SetKYC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFrozen(ctx context.Context, in *MsgSetFrozen, opts ...grpc.CallOption) (*MsgSetFrozenResponse, error) {
+	out := new(MsgSetFrozenResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFrozen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// UpdateParams defined a governance operation for updating the x/compliance module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetKYC adds or removes addresses from the KYC-approved set, it can be executed by
+	// the governance account or the admin.
+	SetKYC(context.Context, *MsgSetKYC) (*MsgSetKYCResponse, error)
+	// SetFrozen freezes or unfreezes addresses, it can be executed by the governance
+	// account or the admin.
+	SetFrozen(context.Context, *MsgSetFrozen) (*MsgSetFrozenResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) SetKYC(context.Context, *MsgSetKYC) (*MsgSetKYCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKYC not implemented")
+}
+func (UnimplementedMsgServer) SetFrozen(context.Context, *MsgSetFrozen) (*MsgSetFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrozen not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetKYC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetKYC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetKYC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetKYC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetKYC(ctx, req.(*MsgSetKYC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFrozen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFrozen(ctx, req.(*MsgSetFrozen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loka.compliance.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetKYC",
+			Handler:    _Msg_SetKYC_Handler,
+		},
+		{
+			MethodName: "SetFrozen",
+			Handler:    _Msg_SetFrozen_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "loka/compliance/v1/tx.proto",
+}
@@ -34,14 +34,14 @@ import (
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
 	feesKeeper      evmante.FeeMarketKeeper
-	evmDenom        string
+	evmParams       *evmtypes.Params
 	feemarketParams *feemarkettypes.Params
 }
 
 // NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
 // Cosmos transactions.
-func NewMinGasPriceDecorator(fk evmante.FeeMarketKeeper, evmDenom string, feemarketParams *feemarkettypes.Params) MinGasPriceDecorator {
-	return MinGasPriceDecorator{feesKeeper: fk, evmDenom: evmDenom, feemarketParams: feemarketParams}
+func NewMinGasPriceDecorator(fk evmante.FeeMarketKeeper, evmParams *evmtypes.Params, feemarketParams *feemarkettypes.Params) MinGasPriceDecorator {
+	return MinGasPriceDecorator{feesKeeper: fk, evmParams: evmParams, feemarketParams: feemarketParams}
 }
 
 func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -58,10 +58,14 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	}
 	minGasPrices := sdk.DecCoins{
 		{
-			Denom:  mpd.evmDenom,
+			Denom:  mpd.evmParams.EvmDenom,
 			Amount: minGasPrice,
 		},
 	}
+	// the fees can be paid in the alternative fee denominations at their conversion rate
+	for _, fd := range mpd.evmParams.FeeDenoms {
+		minGasPrices = append(minGasPrices, sdk.DecCoin{Denom: fd.Denom, Amount: minGasPrice.Mul(fd.Rate)})
+	}
 
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
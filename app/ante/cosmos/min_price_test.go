@@ -128,7 +128,8 @@ func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
 				// s.SetupTest(et.isCheckTx)
 				ctx := suite.ctx.WithIsReCheckTx(et.isCheckTx)
 				feemarketParams := suite.app.FeeMarketKeeper.GetParams(ctx)
-				dec := cosmosante.NewMinGasPriceDecorator(suite.app.FeeMarketKeeper,  evmtypes.DefaultEVMDenom, &feemarketParams)
+				evmParams := evmtypes.DefaultParams()
+				dec := cosmosante.NewMinGasPriceDecorator(suite.app.FeeMarketKeeper, &evmParams, &feemarketParams)
 				_, err := dec.AnteHandle(ctx, tc.malleate(), et.simulate, testutil.NextFn)
 
 				if tc.expPass || (et.simulate && tc.allowPassOnSimulate) {
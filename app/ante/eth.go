@@ -27,6 +27,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/loka-network/loka/v1/app/ante/interfaces"
 	ethermint "github.com/loka-network/loka/v1/types"
@@ -60,22 +61,68 @@ func NewCachedAccountGetter(ctx sdk.Context, ak evmtypes.AccountKeeper) AccountG
 	}
 }
 
-// VerifyEthAccount validates checks that the sender balance is greater than the total transaction cost.
+// EthFeeGranter returns the fee granter of the cosmos tx wrapping the Ethereum txs, which pays their
+// fees through its feegrant allowance to the senders. It's nil when the senders pay the fees.
+func EthFeeGranter(tx sdk.Tx) sdk.AccAddress {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return nil
+	}
+	return feeTx.FeeGranter()
+}
+
+// EthFeeDenom returns the alternative fee denomination the fees of the Ethereum txs are paid in, selected
+// by the fee amount of the cosmos tx wrapping them, or nil when they're paid in evm_denom. The wrapper isn't
+// signed so the fee denomination must have been opted in with MsgSetFeeDenom by the accounts paying the fees,
+// i.e the fee granter of a sponsored tx or the senders.
+func EthFeeDenom(ctx sdk.Context, tx sdk.Tx, evmParams *evmtypes.Params, evmKeeper interfaces.EVMKeeper) (*evmtypes.FeeDenom, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, nil
+	}
+	feeDenom, ok := evmParams.FeeDenomOf(feeTx.GetFee())
+	if !ok {
+		return nil, nil
+	}
+
+	granter := EthFeeGranter(tx)
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+		payer := granter
+		if payer == nil {
+			payer = msgEthTx.GetFrom()
+		}
+		if evmKeeper.GetAccountFeeDenom(ctx, payer) != feeDenom.Denom {
+			return nil, errorsmod.Wrapf(evmtypes.ErrInvalidFeeDenom, "%s didn't opt in paying the fees in %s", payer, feeDenom.Denom)
+		}
+	}
+	return &feeDenom, nil
+}
+
+// VerifyEthAccount validates checks that the sender balance is greater than the total transaction cost,
+// or than the transaction value when the fees are paid by a fee granter or in an alternative fee denomination.
 // The account will be created in memory if it doesn't exist, i.e cannot be found on store, which will eventually set to
 // store when increasing nonce.
 // This AnteHandler decorator will fail if:
 // - any of the msgs is not a MsgEthereumTx
 // - from address is empty
 // - account balance is lower than the transaction cost
+// - account balance is lower than the transaction value of a sponsored tx or of a tx paying an alternative fee denom
 func VerifyEthAccount(
 	ctx sdk.Context, tx sdk.Tx,
-	evmKeeper interfaces.EVMKeeper, evmDenom string,
+	evmKeeper interfaces.EVMKeeper, evmParams *evmtypes.Params,
+	feeDenom *evmtypes.FeeDenom,
 	accountGetter AccountGetter,
 ) error {
 	if !ctx.IsCheckTx() {
 		return nil
 	}
 
+	// the fees paid by a fee granter or in an alternative fee denomination are checked when deducted
+	valueOnly := len(EthFeeGranter(tx)) > 0 || feeDenom != nil
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -98,7 +145,17 @@ func VerifyEthAccount(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		balance := evmKeeper.GetBalance(ctx, from, evmDenom)
+		balance := evmKeeper.GetBalance(ctx, from, evmParams.EvmDenom)
+		if valueOnly {
+			// the sender only needs to cover the value
+			if balance.Cmp(ethTx.Value()) < 0 {
+				return errorsmod.Wrapf(
+					errortypes.ErrInsufficientFunds,
+					"failed to check sender balance: sender balance < tx value (%s < %s)", balance, ethTx.Value(),
+				)
+			}
+			continue
+		}
 		if err := keeper.CheckSenderBalanceFromTx(sdkmath.NewIntFromBigIntMut(balance), ethTx); err != nil {
 			return errorsmod.Wrap(err, "failed to check sender balance")
 		}
@@ -117,7 +174,9 @@ func VerifyEthAccount(
 // - the message is not a MsgEthereumTx
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
-// - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price)
+// - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price), converted
+// to the alternative fee denomination the fees are paid in if not nil
+// - the fee granter has no allowance or not enough balance to pay the fees of a sponsored tx
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -127,11 +186,18 @@ func CheckEthGasConsume(
 	evmKeeper interfaces.EVMKeeper,
 	baseFee *big.Int,
 	maxGasWanted uint64,
-	evmDenom string,
+	evmParams *evmtypes.Params,
+	feeDenom *evmtypes.FeeDenom,
+	feegrantKeeper authante.FeegrantKeeper,
 ) (sdk.Context, error) {
 	gasWanted := uint64(0)
 	var events sdk.Events
 
+	granter := EthFeeGranter(tx)
+	if granter != nil && feegrantKeeper == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
 
@@ -167,27 +233,46 @@ func CheckEthGasConsume(
 			continue
 		}
 
-		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai)
+		fees, err := keeper.VerifyFee(txData, evmParams.EvmDenom, baseFee, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai, ctx.IsCheckTx())
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
+		if feeDenom != nil {
+			fees = interfaces.EthMsgFee(fees.AmountOf(evmParams.EvmDenom).BigInt(), evmParams.EvmDenom, feeDenom)
+		}
 
 		fromBytes := common.FromHex(msgEthTx.From)
-		err = evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(fromBytes))
+		payer := common.BytesToAddress(fromBytes)
+		if granter != nil {
+			if err := feegrantKeeper.UseGrantedFees(ctx, granter, fromBytes, fees, []sdk.Msg{msg}); err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, payer)
+			}
+			payer = common.BytesToAddress(granter)
+		}
+
+		err = evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, payer)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
 
-		events = append(events,
-			sdk.NewEvent(
-				sdk.EventTypeTx,
-				sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
-			),
-		)
+		attrs := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyFee, fees.String())}
+		if granter != nil {
+			attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyFeePayer, granter.String()))
+		}
+		events = append(events, sdk.NewEvent(sdk.EventTypeTx, attrs...))
 	}
 
 	ctx.EventManager().EmitEvents(events)
 
+	if granter != nil {
+		// the gas refund and the tx receipt account the fees to the sponsor
+		ctx = evmtypes.WithFeePayer(ctx, common.BytesToAddress(granter))
+	}
+	if feeDenom != nil {
+		// the gas is refunded in the fee denomination
+		ctx = evmtypes.WithFeeDenom(ctx, *feeDenom)
+	}
+
 	blockGasLimit := ethermint.BlockGasLimit(ctx)
 
 	// return error if the tx gas is greater than the block limit (max gas)
@@ -255,6 +340,10 @@ func CheckEthCanTransfer(
 		}
 		fromBytes := common.FromHex(msgEthTx.From)
 		from := common.BytesToAddress(fromBytes)
+		// check that the permission policies allow the sender to create or call contracts
+		if err := evmParams.AccessControl.CheckPermission(from, tx.To() == nil); err != nil {
+			return err
+		}
 		// check that caller has enough balance to cover asset transfer for **topmost** call
 		// NOTE: here the gas consumed is from the context with the infinite gas meter
 		if value.Sign() > 0 && !canTransfer(ctx, evmKeeper, evmParams.EvmDenom, from, value) {
@@ -0,0 +1,335 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/app/ante"
+	"github.com/loka-network/loka/v1/app/ante/interfaces"
+	"github.com/loka-network/loka/v1/encoding"
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// mockEVMKeeper records the fees deducted from the payers
+type mockEVMKeeper struct {
+	interfaces.EVMKeeper
+	deducted  map[common.Address]sdk.Coins
+	feeDenoms map[string]string
+}
+
+func (k *mockEVMKeeper) GetAccountFeeDenom(_ sdk.Context, addr sdk.AccAddress) string {
+	return k.feeDenoms[addr.String()]
+}
+
+func (k *mockEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, fees sdk.Coins, from common.Address) error {
+	k.deducted[from] = k.deducted[from].Add(fees...)
+	return nil
+}
+
+// mockFeegrantKeeper accepts the fees with the allowances granted to the grantees
+type mockFeegrantKeeper struct {
+	allowances map[string]feegrant.FeeAllowanceI
+}
+
+func (k mockFeegrantKeeper) UseGrantedFees(ctx context.Context, _, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	allowance, ok := k.allowances[string(grantee)]
+	if !ok {
+		return errors.New("fee-grant not found")
+	}
+	_, err := allowance.Accept(ctx, fee, msgs)
+	return err
+}
+
+func TestCheckEthCanTransferPermissions(t *testing.T) {
+	listed := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+
+	buildTx := func(from common.Address, to *common.Address) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(9000),
+			GasLimit: params.TxGasContractCreation,
+			GasPrice: big.NewInt(0),
+			Amount:   big.NewInt(0),
+			To:       to,
+		})
+		msg.From = from.Hex()
+		tx, err := msg.BuildTx(encoding.MakeConfig().TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+		require.NoError(t, err)
+		return tx
+	}
+
+	allowlist := evmtypes.AccessPolicy{AccessType: evmtypes.AccessTypeAllowlist, Addresses: []string{listed.Hex()}}
+	denylist := evmtypes.AccessPolicy{AccessType: evmtypes.AccessTypeDenylist, Addresses: []string{listed.Hex()}}
+
+	testCases := []struct {
+		name          string
+		accessControl evmtypes.AccessControl
+		from          common.Address
+		to            *common.Address
+		expErr        error
+	}{
+		{"open", evmtypes.AccessControl{}, other, nil, nil},
+		{"create allowlist, listed", evmtypes.AccessControl{Create: allowlist}, listed, nil, nil},
+		{"create allowlist, not listed", evmtypes.AccessControl{Create: allowlist}, other, nil, evmtypes.ErrCreateNotPermitted},
+		{"create allowlist, call", evmtypes.AccessControl{Create: allowlist}, other, &contract, nil},
+		{"create denylist, listed", evmtypes.AccessControl{Create: denylist}, listed, nil, evmtypes.ErrCreateNotPermitted},
+		{"create denylist, not listed", evmtypes.AccessControl{Create: denylist}, other, nil, nil},
+		{"call allowlist, listed", evmtypes.AccessControl{Call: allowlist}, listed, &contract, nil},
+		{"call allowlist, not listed", evmtypes.AccessControl{Call: allowlist}, other, &contract, evmtypes.ErrCallNotPermitted},
+		{"call allowlist, create", evmtypes.AccessControl{Call: allowlist}, other, nil, nil},
+		{"call denylist, listed", evmtypes.AccessControl{Call: denylist}, listed, &contract, evmtypes.ErrCallNotPermitted},
+		{"call denylist, not listed", evmtypes.AccessControl{Call: denylist}, other, &contract, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmParams := evmtypes.DefaultParams()
+			evmParams.AccessControl = tc.accessControl
+
+			// the transactions don't transfer any value so the keeper isn't queried
+			err := ante.CheckEthCanTransfer(sdk.Context{}, buildTx(tc.from, tc.to), nil, params.Rules{}, nil, &evmParams)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckEthGasConsumeFeeGranter(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+	granter := utiltx.GenerateAddress()
+	now := time.Now()
+	// 21000 gas at a gas price of 10
+	fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(210000)))
+
+	buildTx := func(granter sdk.AccAddress) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(9000),
+			GasLimit: params.TxGas,
+			GasPrice: big.NewInt(10),
+			Amount:   big.NewInt(0),
+			To:       &sender,
+		})
+		msg.From = sender.Hex()
+		builder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+		builder.SetFeeGranter(granter)
+		tx, err := msg.BuildTx(builder, evmtypes.DefaultEVMDenom)
+		require.NoError(t, err)
+		return tx
+	}
+
+	testCases := []struct {
+		name       string
+		granter    sdk.AccAddress
+		allowance  feegrant.FeeAllowanceI
+		noFeegrant bool
+		expErr     bool
+	}{
+		{"paid by the sender", nil, nil, false, false},
+		{"paid by the granter", granter.Bytes(), &feegrant.BasicAllowance{}, false, false},
+		{"spend limit", granter.Bytes(), &feegrant.BasicAllowance{SpendLimit: fees}, false, false},
+		{"no allowance", granter.Bytes(), nil, false, true},
+		{"insufficient allowance", granter.Bytes(), &feegrant.BasicAllowance{SpendLimit: fees.QuoInt(sdkmath.NewInt(2))}, false, true},
+		{"expired allowance", granter.Bytes(), &feegrant.BasicAllowance{Expiration: &time.Time{}}, false, true},
+		{"fee grants not enabled", granter.Bytes(), &feegrant.BasicAllowance{}, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.
+				WithContext(context.Background()).
+				WithEventManager(sdk.NewEventManager()).
+				WithBlockTime(now).
+				WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: -1}})
+			evmKeeper := &mockEVMKeeper{deducted: make(map[common.Address]sdk.Coins)}
+			feegrantKeeper := mockFeegrantKeeper{allowances: make(map[string]feegrant.FeeAllowanceI)}
+			if tc.allowance != nil {
+				feegrantKeeper.allowances[string(sender.Bytes())] = tc.allowance
+			}
+			var fk authante.FeegrantKeeper = feegrantKeeper
+			if tc.noFeegrant {
+				fk = nil
+			}
+
+			evmParams := evmtypes.DefaultParams()
+			rules := params.Rules{IsHomestead: true, IsIstanbul: true}
+			newCtx, err := ante.CheckEthGasConsume(ctx, buildTx(tc.granter), rules, evmKeeper, nil, 0, &evmParams, nil, fk)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Empty(t, evmKeeper.deducted)
+				return
+			}
+			require.NoError(t, err)
+
+			payer, sponsored := evmtypes.FeePayerFromContext(newCtx)
+			require.Equal(t, tc.granter != nil, sponsored)
+			feePayer, found := ctx.EventManager().Events()[0].GetAttribute(sdk.AttributeKeyFeePayer)
+			require.Equal(t, sponsored, found)
+			if sponsored {
+				require.Equal(t, granter, payer)
+				require.Equal(t, tc.granter.String(), feePayer.Value)
+				require.Equal(t, map[common.Address]sdk.Coins{granter: fees}, evmKeeper.deducted)
+				return
+			}
+			require.Equal(t, map[common.Address]sdk.Coins{sender: fees}, evmKeeper.deducted)
+		})
+	}
+}
+
+func TestEthFeeDenom(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+	granter := utiltx.GenerateAddress()
+	evmParams := evmtypes.DefaultParams()
+	evmParams.FeeDenoms = []evmtypes.FeeDenom{
+		{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)},
+		{Denom: "uosmo", Rate: sdkmath.LegacyOneDec()},
+	}
+
+	buildTx := func(granter sdk.AccAddress, fees sdk.Coins) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(9000),
+			GasLimit: params.TxGas,
+			GasPrice: big.NewInt(10),
+			Amount:   big.NewInt(0),
+			To:       &sender,
+		})
+		msg.From = sender.Hex()
+		builder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+		builder.SetFeeGranter(granter)
+		_, err := msg.BuildTx(builder, evmtypes.DefaultEVMDenom)
+		require.NoError(t, err)
+		builder.SetFeeAmount(fees)
+		return builder.GetTx()
+	}
+
+	evmFees := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 210000))
+	atomFees := sdk.NewCoins(sdk.NewInt64Coin("uatom", 105000))
+
+	testCases := []struct {
+		name      string
+		granter   sdk.AccAddress
+		fees      sdk.Coins
+		feeDenoms map[common.Address]string
+		expDenom  string
+		expErr    error
+	}{
+		{"evm denom", nil, evmFees, map[common.Address]string{sender: "uatom"}, "", nil},
+		{"unregistered denom", nil, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1)), map[common.Address]string{sender: "ufoo"}, "", nil},
+		{"opted in by the sender", nil, atomFees, map[common.Address]string{sender: "uatom"}, "uatom", nil},
+		{"not opted in", nil, atomFees, nil, "", evmtypes.ErrInvalidFeeDenom},
+		{"other denom opted in", nil, atomFees, map[common.Address]string{sender: "uosmo"}, "", evmtypes.ErrInvalidFeeDenom},
+		{"opted in by the granter", granter.Bytes(), atomFees, map[common.Address]string{granter: "uatom"}, "uatom", nil},
+		{"opted in by the sender of a sponsored tx", granter.Bytes(), atomFees, map[common.Address]string{sender: "uatom"}, "", evmtypes.ErrInvalidFeeDenom},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmKeeper := &mockEVMKeeper{feeDenoms: make(map[string]string)}
+			for addr, denom := range tc.feeDenoms {
+				evmKeeper.feeDenoms[sdk.AccAddress(addr.Bytes()).String()] = denom
+			}
+
+			feeDenom, err := ante.EthFeeDenom(sdk.Context{}, buildTx(tc.granter, tc.fees), &evmParams, evmKeeper)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expDenom == "" {
+				require.Nil(t, feeDenom)
+				return
+			}
+			require.Equal(t, tc.expDenom, feeDenom.Denom)
+		})
+	}
+}
+
+func TestCheckEthGasConsumeFeeDenom(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+	// 1 aloka is paid with 0.5 uatom
+	feeDenom := evmtypes.FeeDenom{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)}
+
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(9000),
+		GasLimit: params.TxGas,
+		GasPrice: big.NewInt(10),
+		Amount:   big.NewInt(0),
+		To:       &sender,
+	})
+	msg.From = sender.Hex()
+	tx, err := msg.BuildTx(encoding.MakeConfig().TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.
+		WithContext(context.Background()).
+		WithEventManager(sdk.NewEventManager()).
+		WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: -1}})
+	evmKeeper := &mockEVMKeeper{deducted: make(map[common.Address]sdk.Coins)}
+	evmParams := evmtypes.DefaultParams()
+	rules := params.Rules{IsHomestead: true, IsIstanbul: true}
+
+	newCtx, err := ante.CheckEthGasConsume(ctx, tx, rules, evmKeeper, nil, 0, &evmParams, &feeDenom, nil)
+	require.NoError(t, err)
+	// the fees are deducted and refunded in the fee denom
+	require.Equal(t, map[common.Address]sdk.Coins{sender: sdk.NewCoins(sdk.NewInt64Coin("uatom", 105000))}, evmKeeper.deducted)
+	refundDenom, ok := evmtypes.FeeDenomFromContext(newCtx)
+	require.True(t, ok)
+	require.Equal(t, feeDenom, refundDenom)
+}
+
+func TestValidateEthBasicFeeDenom(t *testing.T) {
+	// 1 aloka is paid with 0.5 uatom
+	feeDenom := evmtypes.FeeDenom{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)}
+	evmParams := evmtypes.DefaultParams()
+	evmParams.FeeDenoms = []evmtypes.FeeDenom{feeDenom}
+	// the txs aren't signed
+	evmParams.AllowUnprotectedTxs = true
+
+	// two txs paying 21001 aloka each, i.e 10500.5 uatom rounded up per tx
+	builder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+	var msgs []sdk.Msg
+	for i := 0; i < 2; i++ {
+		to := utiltx.GenerateAddress()
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(9000),
+			Nonce:    uint64(i),
+			GasLimit: params.TxGas + 1,
+			GasPrice: big.NewInt(1),
+			Amount:   big.NewInt(0),
+			To:       &to,
+		})
+		msgs = append(msgs, msg)
+	}
+	_, err := msgs[0].(*evmtypes.MsgEthereumTx).BuildTx(builder, evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(2 * (params.TxGas + 1))
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 42002)))
+	require.NoError(t, interfaces.ValidateEthBasic(ctx, builder.GetTx(), &evmParams, nil, nil))
+	require.Error(t, interfaces.ValidateEthBasic(ctx, builder.GetTx(), &evmParams, nil, &feeDenom))
+
+	// the fee of each tx is converted like when it's deducted
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 21002)))
+	require.NoError(t, interfaces.ValidateEthBasic(ctx, builder.GetTx(), &evmParams, nil, &feeDenom))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 21001)))
+	require.Error(t, interfaces.ValidateEthBasic(ctx, builder.GetTx(), &evmParams, nil, &feeDenom))
+}
@@ -37,6 +37,7 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
+// - the fees paid in an alternative fee denomination are converted from and to the evm denom at its rate.
 func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 	return func(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coins, int64, error) {
 		if ctx.BlockHeight() == 0 {
@@ -76,6 +77,12 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 		feeCoins := feeTx.GetFee()
 		fee := feeCoins.AmountOfNoDenomValidation(denom)
 
+		// the fees paid in an alternative fee denomination are priced in evm denom at its conversion rate
+		feeDenom, altFeeDenom := params.FeeDenomOf(feeCoins)
+		if altFeeDenom {
+			fee = feeDenom.ToEVMDenom(feeCoins[0].Amount)
+		}
+
 		feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
 		baseFeeInt := sdkmath.NewIntFromBigInt(baseFee)
 
@@ -93,6 +100,9 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 				Amount: effectivePrice.Mul(sdkmath.NewIntFromUint64(gas)),
 			},
 		}
+		if altFeeDenom {
+			effectiveFee = feeDenom.ConvertFees(effectiveFee, denom)
+		}
 
 		bigPriority := effectivePrice.Sub(baseFeeInt).Quo(types.DefaultPriorityReduction)
 		priority := int64(math.MaxInt64)
@@ -37,6 +37,7 @@ type EVMKeeper interface { //nolint: revive
 	DynamicFeeEVMKeeper
 
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	GetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress) string
 	EVMBlockConfig(sdk.Context, *big.Int) (*evmkeeper.EVMBlockConfig, error)
 	
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
@@ -147,7 +147,15 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			return ctx, err
 		}
 
-		if err := interfaces.ValidateEthBasic(ctx, tx, evmParams, baseFee); err != nil {
+		// the alternative fee denomination is resolved once and the fees are converted the same way
+		// when validating the fee amount of the wrapper tx and deducting them
+		feeDenom, err := EthFeeDenom(ctx, tx, evmParams, options.EvmKeeper)
+		if err != nil {
+			ctx.Logger().Error("EthFeeDenom error", "err", err)
+			return ctx, err
+		}
+
+		if err := interfaces.ValidateEthBasic(ctx, tx, evmParams, baseFee, feeDenom); err != nil {
 			ctx.Logger().Error("ValidateEthBasic error", "err", err)
 			return ctx, err
 		}
@@ -170,7 +178,7 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		// it's safe because there's no store branching in the ante handlers.
 		accountGetter := NewCachedAccountGetter(ctx, options.AccountKeeper)
 
-		if err := VerifyEthAccount(ctx, tx, options.EvmKeeper, evmDenom, accountGetter); err != nil {
+		if err := VerifyEthAccount(ctx, tx, options.EvmKeeper, evmParams, feeDenom, accountGetter); err != nil {
 			ctx.Logger().Error("VerifyEthAccount error", "err", err)
 			return ctx, err
 		}
@@ -182,7 +190,7 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 
 		ctx, err = CheckEthGasConsume(
 			ctx, tx, rules, options.EvmKeeper,
-			baseFee, options.MaxTxGasWanted, evmDenom,
+			baseFee, options.MaxTxGasWanted, evmParams, feeDenom, options.FeegrantKeeper,
 		)
 		if err != nil {
 			ctx.Logger().Error("CheckEthGasConsume error", "err", err)
@@ -233,7 +241,6 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHandler {
 	evmParams := options.EvmKeeper.GetParams(ctx)
 	feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
-	evmDenom := evmParams.EvmDenom
 	return sdk.ChainAnteDecorators(
 		cosmosante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
 		cosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
@@ -245,7 +252,7 @@ func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHandl
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, evmDenom, &feemarketParams),
+		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, &evmParams, &feemarketParams),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
 		cosmosante.NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper, options.Cdc),
@@ -264,7 +271,6 @@ func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHandl
 func newLegacyCosmosAnteHandlerEip712(ctx sdk.Context, options HandlerOptions) sdk.AnteHandler {
 	evmParams := options.EvmKeeper.GetParams(ctx)
 	feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
-	evmDenom := evmParams.EvmDenom
 
 	return sdk.ChainAnteDecorators(
 		cosmosante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
@@ -275,7 +281,7 @@ func newLegacyCosmosAnteHandlerEip712(ctx sdk.Context, options HandlerOptions) s
 		ante.NewSetUpContextDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
-		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, evmDenom, &feemarketParams),
+		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, &evmParams, &feemarketParams),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
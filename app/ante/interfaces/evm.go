@@ -35,6 +35,7 @@ type EVMKeeper interface {
 	EVMBlockConfig(sdk.Context, *big.Int) (*evmkeeper.EVMBlockConfig, error)
 
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	GetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress) string
 
 	// For compatibility at temporary
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
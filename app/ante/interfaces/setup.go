@@ -39,8 +39,21 @@ func SetupEthContext(ctx sdk.Context) (newCtx sdk.Context, err error) {
 	return newCtx, nil
 }
 
-// ValidateEthBasic handles basic validation of tx
-func ValidateEthBasic(ctx sdk.Context, tx sdk.Tx, evmParams *evmtypes.Params, baseFee *big.Int) error {
+// EthMsgFee returns the fee of an Ethereum tx message, converted to the alternative fee denomination
+// the fees are paid in if any. The same conversion prices the fee amount of the cosmos tx wrapping the
+// messages and the fees deducted from the payers, so they only differ by the tip of the dynamic fee txs
+// not paid at the current base fee.
+func EthMsgFee(fee *big.Int, evmDenom string, feeDenom *evmtypes.FeeDenom) sdk.Coins {
+	fees := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromBigInt(fee)}}
+	if feeDenom == nil {
+		return fees
+	}
+	return feeDenom.ConvertFees(fees, evmDenom)
+}
+
+// ValidateEthBasic handles basic validation of tx, the fees are paid in the alternative fee denomination
+// if not nil
+func ValidateEthBasic(ctx sdk.Context, tx sdk.Tx, evmParams *evmtypes.Params, baseFee *big.Int, feeDenom *evmtypes.FeeDenom) error {
 	// no need to validate basic on recheck tx, call next antehandler
 	if ctx.IsReCheckTx() {
 		return nil
@@ -82,8 +95,9 @@ func ValidateEthBasic(ctx sdk.Context, tx sdk.Tx, evmParams *evmtypes.Params, ba
 		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// the fee granter sponsors the fees of the eth txs through its feegrant allowance to the sender
+	if authInfo.Fee.Payer != "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	sigs := protoTx.Signatures
@@ -125,7 +139,7 @@ func ValidateEthBasic(ctx sdk.Context, tx sdk.Tx, evmParams *evmtypes.Params, ba
 				"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
 		}
 
-		txFee = txFee.Add(sdk.Coin{Denom: evmDenom, Amount: sdkmath.NewIntFromBigInt(msgEthTx.GetFee())})
+		txFee = txFee.Add(EthMsgFee(msgEthTx.GetFee(), evmDenom, feeDenom)...)
 	}
 
 	if !authInfo.Fee.Amount.Equal(txFee) {
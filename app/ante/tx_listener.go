@@ -2,11 +2,12 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 )
 
-type PendingTxListener func(common.Hash)
+// PendingTxListener is notified with every ethereum transaction accepted into the mempool.
+// It's an alias so the json-rpc server can register listeners without importing this package.
+type PendingTxListener = func(*evmtypes.MsgEthereumTx)
 
 type TxListenerDecorator struct {
 	pendingTxListener PendingTxListener
@@ -25,7 +26,7 @@ func (d TxListenerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate boo
 	if ctx.IsCheckTx() && !simulate && d.pendingTxListener != nil {
 		for _, msg := range tx.GetMsgs() {
 			if ethTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
-				d.pendingTxListener(common.BytesToHash([]byte(ethTx.Hash)))
+				d.pendingTxListener(ethTx)
 			}
 		}
 	}
@@ -151,6 +151,7 @@ import (
 	evmostypes "github.com/loka-network/loka/v1/types"
 	"github.com/loka-network/loka/v1/x/evm"
 	evmkeeper "github.com/loka-network/loka/v1/x/evm/keeper"
+	"github.com/loka-network/loka/v1/x/evm/precompiles"
 	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 	"github.com/loka-network/loka/v1/x/feemarket"
 	feemarketkeeper "github.com/loka-network/loka/v1/x/feemarket/keeper"
@@ -160,6 +161,10 @@ import (
 	_ "github.com/loka-network/loka/v1/client/docs/statik"
 
 	"github.com/loka-network/loka/v1/app/ante"
+	v1 "github.com/loka-network/loka/v1/app/upgrades/v1"
+	"github.com/loka-network/loka/v1/x/compliance"
+	compliancekeeper "github.com/loka-network/loka/v1/x/compliance/keeper"
+	compliancetypes "github.com/loka-network/loka/v1/x/compliance/types"
 	"github.com/loka-network/loka/v1/x/epochs"
 	epochskeeper "github.com/loka-network/loka/v1/x/epochs/keeper"
 	epochstypes "github.com/loka-network/loka/v1/x/epochs/types"
@@ -182,9 +187,12 @@ import (
 	transferkeeper "github.com/loka-network/loka/v1/x/ibc/transfer/keeper"
 
 	memiavlstore "github.com/crypto-org-chain/cronos/store"
+	"github.com/crypto-org-chain/cronos/versiondb"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethparams "github.com/ethereum/go-ethereum/params"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
-	"github.com/ethereum/go-ethereum/common"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 )
@@ -246,6 +254,7 @@ var (
 		inflation.AppModuleBasic{},
 		erc20.AppModuleBasic{},
 		epochs.AppModuleBasic{},
+		compliance.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -306,6 +315,8 @@ func StoreKeys() (
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey,
 		epochstypes.StoreKey, vestingtypes.StoreKey,
+		// loka keys
+		compliancetypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -373,6 +384,9 @@ type Evmos struct {
 	EpochsKeeper    epochskeeper.Keeper
 	VestingKeeper   vestingkeeper.Keeper
 
+	// Loka keepers
+	ComplianceKeeper compliancekeeper.Keeper
+
 	// the module manager
 	mm                 *module.Manager
 	BasicModuleManager module.BasicManager
@@ -384,6 +398,12 @@ type Evmos struct {
 	sm *module.SimulationManager
 
 	qms storetypes.RootMultiStore
+	// versionDB is the store of versiondb, nil if it's not enabled
+	versionDB versiondb.VersionStore
+	// stopCatchUp stops the secondary versiondb catching up with the primary, nil if it's not started,
+	// catchUpDone is closed when it stops.
+	stopCatchUp chan struct{}
+	catchUpDone chan struct{}
 
 	tpsCounter *tpsCounter
 }
@@ -605,7 +625,15 @@ func NewEvmos(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], okeys[evmtypes.ObjectStoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
-		nil,
+		[]evmkeeper.CustomContractFn{
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return precompiles.NewBankContract(app.BankKeeper)
+			},
+			// the keepers are created after the evm keeper, the closure reads them when the EVM is created
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return precompiles.NewICS20Contract(app.BankKeeper, app.TransferKeeper, app.Erc20Keeper)
+			},
+		},
 	)
 
 	// Create IBC Keeper
@@ -618,6 +646,13 @@ func NewEvmos(
 		authAddr,
 	)
 
+	app.ComplianceKeeper = compliancekeeper.NewKeeper(
+		keys[compliancetypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.IBCKeeper.ChannelKeeper,
+	)
+	// check the bank transfers, including the EVM value transfers, against the compliance rules
+	app.BankKeeper.AppendSendRestriction(app.ComplianceKeeper.SendRestriction)
+
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
@@ -670,6 +705,7 @@ func NewEvmos(
 		keys[erc20types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
 	)
+	app.Erc20Keeper.SetComplianceKeeper(app.ComplianceKeeper)
 
 	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
@@ -691,6 +727,14 @@ func NewEvmos(
 		),
 	)
 
+	// check the EVM value transfers against the compliance rules before they're executed
+	app.EvmKeeper = app.EvmKeeper.SetTransferChecker(app.ComplianceKeeper)
+
+	// serve the erc20 token pairs listed in the native_precompiles param by native precompiled contracts
+	app.EvmKeeper = app.EvmKeeper.SetDynamicContractsFn(
+		precompiles.NativeERC20Contracts(app.BankKeeper, app.Erc20Keeper),
+	)
+
 	// Create the rate limit keeper
 	app.RateLimitKeeper = *ratelimitkeeper.NewKeeper(
 		appCodec,
@@ -737,6 +781,7 @@ func NewEvmos(
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- Compliance Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 		 	- Airdrop Claims Middleware
@@ -746,7 +791,7 @@ func NewEvmos(
 		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> compliance.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -755,6 +800,7 @@ func NewEvmos(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = compliance.NewIBCMiddleware(app.ComplianceKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -818,6 +864,8 @@ func NewEvmos(
 			app.GetSubspace(erc20types.ModuleName)),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper),
+		// Loka app modules
+		compliance.NewAppModule(app.ComplianceKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -937,6 +985,9 @@ func NewEvmos(
 		// NOTE: feemarket module needs to be initialized before genutil module:
 		// gentx transactions use MinGasPriceDecorator.AnteHandle
 		feemarkettypes.ModuleName,
+		// NOTE: compliance module needs to be initialized before genutil module:
+		// gentx transactions transfers are checked against the compliance rules
+		compliancetypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -971,7 +1022,7 @@ func NewEvmos(
 	// wire up the versiondb's `StreamingService` and `MultiStore`.
 	if cast.ToBool(appOpts.Get("versiondb.enable")) {
 		var err error
-		app.qms, err = app.setupVersionDB(homePath, keys, tkeys, okeys)
+		app.qms, err = app.setupVersionDB(homePath, appOpts, keys, tkeys, okeys)
 		if err != nil {
 			panic(err)
 		}
@@ -1085,9 +1136,9 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64,
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
-func (app *Evmos) onPendingTx(hash common.Hash) {
+func (app *Evmos) onPendingTx(tx *evmtypes.MsgEthereumTx) {
 	for _, listener := range app.pendingTxListeners {
-		listener(hash)
+		listener(tx)
 	}
 }
 
@@ -1152,6 +1203,12 @@ func (app *Evmos) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
 }
 
+// Close stops the background routines of the app before closing the dbs.
+func (app *Evmos) Close() error {
+	app.stopCatchUpVersionDB()
+	return app.BaseApp.Close()
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *Evmos) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -1411,14 +1468,13 @@ func initParamsKeeper(
 }
 
 func (app *Evmos) setupUpgradeHandlers() {
-	// v0 upgrade handler
-	// app.UpgradeKeeper.SetUpgradeHandler(
-	// 	v0.UpgradeName,
-	// 	v0.CreateUpgradeHandler(
-	// 		app.mm, app.configurator,
-	// 		app.DistrKeeper,
-	// 	),
-	// )
+	// v1.1.0 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v1.UpgradeName,
+		v1.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
 
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
@@ -1435,8 +1491,10 @@ func (app *Evmos) setupUpgradeHandlers() {
 	var storeUpgrades *storetypes.StoreUpgrades
 
 	switch upgradeInfo.Name {
-	// case v0.UpgradeName:
-	// no store upgrades
+	case v1.UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{compliancetypes.StoreKey},
+		}
 	default:
 		// no store upgrades
 	}
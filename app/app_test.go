@@ -21,6 +21,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 
+	v1 "github.com/loka-network/loka/v1/app/upgrades/v1"
 	"github.com/loka-network/loka/v1/encoding"
 	"github.com/loka-network/loka/v1/utils"
 )
@@ -75,3 +76,12 @@ func TestEvmosExport(t *testing.T) {
 	_, err = app2.ExportAppStateAndValidators(false, []string{}, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+func TestUpgradeHandlers(t *testing.T) {
+	app := NewEvmos(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0,
+		encoding.MakeConfig(), simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome),
+		baseapp.SetChainID(utils.MainnetChainID+"-1"),
+	)
+	require.True(t, app.UpgradeKeeper.HasHandler(v1.UpgradeName))
+}
@@ -0,0 +1,6 @@
+package v1
+
+const (
+	// UpgradeName is the shared upgrade plan name for mainnet and testnet
+	UpgradeName = "v1.1.0"
+)
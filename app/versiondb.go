@@ -1,43 +1,117 @@
-//go:build rocksdb
-// +build rocksdb
-
 package app
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/crypto-org-chain/cronos/versiondb"
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"github.com/crypto-org-chain/cronos/versiondb/pebbledb"
+	"github.com/spf13/cast"
 
 	storetypes "cosmossdk.io/store/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	srvflags "github.com/loka-network/loka/v1/server/flags"
 )
 
+const (
+	// VersionDBBackendRocksDB is the default versiondb backend, it's only supported in the builds with rocksdb.
+	VersionDBBackendRocksDB = "rocksdb"
+	// VersionDBBackendPebble is the pure-Go versiondb backend.
+	VersionDBBackendPebble = "pebble"
+
+	// VersionDBCatchUpInterval is the interval of the secondary versiondb catching up with the primary one.
+	VersionDBCatchUpInterval = time.Second
+)
+
+// secondaryVersionStore is a read-only versiondb which follows the updates of the primary instance.
+type secondaryVersionStore interface {
+	TryCatchUpWithPrimary() error
+}
+
 func (app *Evmos) setupVersionDB(
 	homePath string,
+	appOpts servertypes.AppOptions,
 	keys map[string]*storetypes.KVStoreKey,
 	tkeys map[string]*storetypes.TransientStoreKey,
 	okeys map[string]*storetypes.ObjectStoreKey,
 ) (storetypes.RootMultiStore, error) {
 	dataDir := filepath.Join(homePath, "data", "versiondb")
-	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+	if dir := cast.ToString(appOpts.Get("versiondb.dir")); dir != "" {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(homePath, dir)
+		}
+		dataDir = dir
+	}
+	secondary := cast.ToBool(appOpts.Get("versiondb.secondary"))
+	if secondary && !cast.ToBool(appOpts.Get(srvflags.GRPCOnly)) {
+		return nil, errors.New("versiondb secondary mode is only supported in the query only mode, start with --grpc-only")
+	}
+	// the secondary instance keeps its own info logs locally
+	secondaryDir := filepath.Join(homePath, "data", "versiondb-secondary")
+	if secondary {
+		if err := os.MkdirAll(secondaryDir, os.ModePerm); err != nil {
+			return nil, err
+		}
+	} else if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
 		return nil, err
 	}
 
-	versionDB, err := tsrocksdb.NewStore(dataDir)
+	keepRecent := cast.ToInt64(appOpts.Get("versiondb.keep-recent"))
+
+	var (
+		versionDB versiondb.VersionStore
+		err       error
+	)
+	switch backend := cast.ToString(appOpts.Get("versiondb.backend")); backend {
+	case "", VersionDBBackendRocksDB:
+		if secondary {
+			versionDB, err = openRocksDBSecondaryVersionStore(dataDir, secondaryDir)
+		} else {
+			versionDB, err = openRocksDBVersionStore(dataDir, keepRecent)
+		}
+	case VersionDBBackendPebble:
+		if secondary {
+			err = errors.New("versiondb secondary mode is only supported by the rocksdb backend")
+		} else {
+			versionDB, err = openPebbleVersionStore(dataDir, keepRecent)
+		}
+	default:
+		err = fmt.Errorf("unknown versiondb backend: %s", backend)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	app.versionDB = versionDB
+	delegatedStoreKeys := make(map[storetypes.StoreKey]struct{})
+	for _, k := range tkeys {
+		delegatedStoreKeys[k] = struct{}{}
+	}
+	for _, k := range okeys {
+		delegatedStoreKeys[k] = struct{}{}
+	}
+	verDB := versiondb.NewMultiStore(app.CommitMultiStore(), versionDB, keys, delegatedStoreKeys)
+	app.SetQueryMultiStore(verDB)
+
+	if secondary {
+		// the query only node don't write to versiondb, it follows the node writing to it instead
+		app.stopCatchUp = make(chan struct{})
+		app.catchUpDone = make(chan struct{})
+		go app.catchUpVersionDB(versionDB.(secondaryVersionStore), app.stopCatchUp, app.catchUpDone)
+		return verDB, nil
+	}
+
 	// always listen for all keys to simplify configuration
 	exposedKeys := make([]storetypes.StoreKey, 0, len(keys))
 	for _, key := range keys {
 		exposedKeys = append(exposedKeys, key)
 	}
 
-	// see: https://github.com/crypto-org-chain/cronos/issues/1683
-	versionDB.SetSkipVersionZero(true)
-
 	app.CommitMultiStore().AddListeners(exposedKeys)
 
 	// register in app streaming manager
@@ -46,16 +120,51 @@ func (app *Evmos) setupVersionDB(
 		versiondb.NewStreamingService(versionDB),
 	)
 	app.SetStreamingManager(sm)
+	return verDB, nil
+}
 
-	delegatedStoreKeys := make(map[storetypes.StoreKey]struct{})
-	for _, k := range tkeys {
-		delegatedStoreKeys[k] = struct{}{}
+// catchUpVersionDB applies the updates of the primary versiondb periodically, it runs until `stop` is closed,
+// and closes `done` when it returns.
+func (app *Evmos) catchUpVersionDB(store secondaryVersionStore, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(VersionDBCatchUpInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := store.TryCatchUpWithPrimary(); err != nil {
+				app.Logger().Error("versiondb failed to catch up with the primary", "error", err)
+			}
+		}
 	}
-	for _, k := range okeys {
-		delegatedStoreKeys[k] = struct{}{}
+}
+
+// stopCatchUpVersionDB stops the secondary versiondb catching up with the primary and waits for it, it's a noop if
+// it's not started or already stopped.
+func (app *Evmos) stopCatchUpVersionDB() {
+	if app.stopCatchUp == nil {
+		return
 	}
+	close(app.stopCatchUp)
+	<-app.catchUpDone
+	app.stopCatchUp = nil
+}
 
-	verDB := versiondb.NewMultiStore(app.CommitMultiStore(), versionDB, keys, delegatedStoreKeys)
-	app.SetQueryMultiStore(verDB)
-	return verDB, nil
+// VersionDB returns the store of versiondb, nil if versiondb is not enabled.
+func (app *Evmos) VersionDB() versiondb.VersionStore {
+	return app.versionDB
+}
+
+func openPebbleVersionStore(dir string, keepRecent int64) (versiondb.VersionStore, error) {
+	versionDB, err := pebbledb.NewStore(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// see: https://github.com/crypto-org-chain/cronos/issues/1683
+	versionDB.SetSkipVersionZero(true)
+	versionDB.SetKeepRecent(keepRecent)
+	return versionDB, nil
 }
@@ -6,14 +6,15 @@ package app
 import (
 	"errors"
 
-	storetypes "cosmossdk.io/store/types"
+	"github.com/crypto-org-chain/cronos/versiondb"
 )
 
-func (app *Evmos) setupVersionDB(
-	homePath string,
-	keys map[string]*storetypes.KVStoreKey,
-	tkeys map[string]*storetypes.TransientStoreKey,
-	okeys map[string]*storetypes.ObjectStoreKey,
-) (storetypes.RootMultiStore, error) {
-	return nil, errors.New("versiondb is not supported in this binary")
+var errRocksDBNotSupported = errors.New("versiondb rocksdb backend is not supported in this binary, use the pebble backend instead")
+
+func openRocksDBVersionStore(string, int64) (versiondb.VersionStore, error) {
+	return nil, errRocksDBNotSupported
+}
+
+func openRocksDBSecondaryVersionStore(string, string) (versiondb.VersionStore, error) {
+	return nil, errRocksDBNotSupported
 }
@@ -0,0 +1,32 @@
+//go:build rocksdb
+// +build rocksdb
+
+package app
+
+import (
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+)
+
+func openRocksDBVersionStore(dir string, keepRecent int64) (versiondb.VersionStore, error) {
+	versionDB, err := tsrocksdb.NewStore(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// see: https://github.com/crypto-org-chain/cronos/issues/1683
+	versionDB.SetSkipVersionZero(true)
+	versionDB.SetKeepRecent(keepRecent)
+	return versionDB, nil
+}
+
+func openRocksDBSecondaryVersionStore(dir, secondaryDir string) (versiondb.VersionStore, error) {
+	versionDB, err := tsrocksdb.NewSecondaryStore(dir, secondaryDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// see: https://github.com/crypto-org-chain/cronos/issues/1683
+	versionDB.SetSkipVersionZero(true)
+	return versionDB, nil
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"testing"
+)
+
+type mockSecondaryVersionStore struct{}
+
+func (mockSecondaryVersionStore) TryCatchUpWithPrimary() error { return nil }
+
+func TestStopCatchUpVersionDB(t *testing.T) {
+	app := &Evmos{
+		stopCatchUp: make(chan struct{}),
+		catchUpDone: make(chan struct{}),
+	}
+	go app.catchUpVersionDB(mockSecondaryVersionStore{}, app.stopCatchUp, app.catchUpDone)
+
+	done := app.catchUpDone
+	app.stopCatchUpVersionDB()
+	select {
+	case <-done:
+	default:
+		t.Fatal("catch up is not stopped")
+	}
+
+	// noop if already stopped
+	app.stopCatchUpVersionDB()
+}
@@ -3,11 +3,22 @@ package main
 type VersionDBConfig struct {
 	// Enable defines if the versiondb should be enabled.
 	Enable bool `mapstructure:"enable"`
+	// Backend defines the db engine of the versiondb, "rocksdb" or "pebble".
+	Backend string `mapstructure:"backend"`
+	// Dir defines the directory of the versiondb, relative to the node home, default to "data/versiondb".
+	Dir string `mapstructure:"dir"`
+	// Secondary defines if the versiondb is opened as a read-only secondary instance following the primary one,
+	// only for the query only nodes started with --grpc-only.
+	Secondary bool `mapstructure:"secondary"`
+	// KeepRecent defines the number of versions retained before the latest one, the older versions are pruned,
+	// 0 means keeping all the versions.
+	KeepRecent int64 `mapstructure:"keep-recent"`
 }
 
 func DefaultVersionDBConfig() VersionDBConfig {
 	return VersionDBConfig{
-		Enable: false,
+		Enable:  false,
+		Backend: "rocksdb",
 	}
 }
 
@@ -15,4 +26,22 @@ var DefaultVersionDBTemplate = `
 [versiondb]
 # Enable defines if the versiondb should be enabled.
 enable = {{ .VersionDB.Enable }}
+
+# Backend defines the db engine of the versiondb, "rocksdb" or "pebble", the rocksdb backend is only supported in the
+# binaries built with rocksdb, the pebble backend is pure-Go, default to "rocksdb".
+# The existing versiondb can't be opened by another backend.
+backend = "{{ .VersionDB.Backend }}"
+
+# Dir defines the directory of the versiondb, it's relative to the node home if not absolute, so it can be placed on a
+# separate disk, default to "data/versiondb".
+dir = "{{ .VersionDB.Dir }}"
+
+# Secondary defines if the versiondb is opened as a read-only secondary instance of the one written by another node on
+# the same host, the updates of it are followed periodically, it's only supported by the rocksdb backend and the query
+# only mode started with --grpc-only, default to false.
+secondary = {{ .VersionDB.Secondary }}
+
+# KeepRecent defines the number of versions retained before the latest one, the older versions are pruned in batches
+# and the queries on them fail, default to 0 which means keeping all the versions.
+keep-recent = {{ .VersionDB.KeepRecent }}
 `
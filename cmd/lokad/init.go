@@ -45,6 +45,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 )
 
 type printInfo struct {
@@ -113,7 +115,12 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 
 			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
 			if chainID == "" {
-				chainID = fmt.Sprintf("loka_567000-%v", tmrand.Str(6))
+				chainID = fmt.Sprintf("loka_567000-%d", tmrand.Int63n(999999)+1)
+			}
+
+			evmChainID, err := evmtypes.ParseEVMChainID(chainID)
+			if err != nil {
+				return errors.Wrapf(err, "invalid chain-id %s", chainID)
 			}
 
 			// Get bip39 mnemonic
@@ -147,7 +154,15 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 				return fmt.Errorf("genesis.json file already exists: %v", genFile)
 			}
 
-			appState, err := json.MarshalIndent(mbm.DefaultGenesis(cdc), "", " ")
+			genState := mbm.DefaultGenesis(cdc)
+
+			// the EVM chain id of the genesis is the EIP155 chain id of the chain identifier
+			var evmGenState evmtypes.GenesisState
+			cdc.MustUnmarshalJSON(genState[evmtypes.ModuleName], &evmGenState)
+			evmGenState.Params.EVMChainID = evmChainID
+			genState[evmtypes.ModuleName] = cdc.MustMarshalJSON(&evmGenState)
+
+			appState, err := json.MarshalIndent(genState, "", " ")
 			if err != nil {
 				return errors.Wrap(err, "Failed to marshall default genesis state")
 			}
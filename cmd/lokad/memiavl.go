@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/crypto-org-chain/cronos/memiavl"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagConcurrency   = "concurrency"
+	flagSkipWAL       = "skip-wal"
+	flagTargetVersion = "target-version"
+)
+
+// MemIAVLCmd returns the commands to inspect the memiavl db of the node.
+func MemIAVLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memiavl",
+		Short: "Commands to inspect the memiavl db",
+	}
+	cmd.AddCommand(
+		MemIAVLVerifyCmd(),
+		MemIAVLRestoreArchiveCmd(),
+	)
+	return cmd
+}
+
+// MemIAVLVerifyCmd verifies the snapshots and the WAL of the memiavl db, it don't take the file lock of the db, so
+// it's safe to run against the db of a running node.
+func MemIAVLVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [dir]",
+		Short: "Verify the node hashes of the memiavl snapshots and replay the WAL to the latest version",
+		Long: `Verify recomputes the node hashes of each snapshot and checks them against the commit info stored in the snapshot,
+then replays the WAL on top of the earliest snapshot to the latest version, and checks the commit info at each later snapshot.
+It reports the first bad version and store, the db directory defaults to "<home>/data/memiavl.db".`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			dir := filepath.Join(serverCtx.Config.RootDir, "data", "memiavl.db")
+			if len(args) > 0 {
+				dir = args[0]
+			}
+
+			concurrency, err := cmd.Flags().GetInt(flagConcurrency)
+			if err != nil {
+				return err
+			}
+			skipWAL, err := cmd.Flags().GetBool(flagSkipWAL)
+			if err != nil {
+				return err
+			}
+
+			version, err := memiavl.Verify(cmd.Context(), dir, memiavl.VerifyOptions{
+				Logger:      serverCtx.Logger,
+				Concurrency: concurrency,
+				SkipWAL:     skipWAL,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("memiavl db verified, latest version: %d\n", version)
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagConcurrency, 0, "number of stores verified in parallel, default to the number of CPUs")
+	cmd.Flags().Bool(flagSkipWAL, false, "only verify the snapshots, skip replaying the WAL")
+	return cmd
+}
+
+// MemIAVLRestoreArchiveCmd rebuilds a historical version of the memiavl db from a snapshot and the archived WAL.
+func MemIAVLRestoreArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore-archive [snapshot-dir] [archive-dir] [output-dir]",
+		Short: "Rebuild a historical version of the memiavl db from a snapshot and the archived WAL",
+		Long: `Rebuild the memiavl db at the target version into the output directory, by replaying the change sets archived by
+the "wal-archive-dir" option on top of a memiavl snapshot directory, like "<home>/data/memiavl.db/snapshot-00000000000000001000".`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			targetVersion, err := cmd.Flags().GetInt64(flagTargetVersion)
+			if err != nil {
+				return err
+			}
+			if targetVersion <= 0 {
+				return fmt.Errorf("invalid target version: %d", targetVersion)
+			}
+
+			if err := memiavl.RestoreFromArchive(args[0], args[1], targetVersion, args[2]); err != nil {
+				return err
+			}
+
+			cmd.Printf("memiavl db restored at version %d: %s\n", targetVersion, args[2])
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagTargetVersion, 0, "the version to restore")
+	return cmd
+}
@@ -67,6 +67,8 @@ import (
 	"github.com/loka-network/loka/v1/client/debug"
 	"github.com/loka-network/loka/v1/encoding"
 	"github.com/loka-network/loka/v1/ethereum/eip712"
+	evmosrpc "github.com/loka-network/loka/v1/rpc"
+	versiondbrpc "github.com/loka-network/loka/v1/rpc/namespaces/versiondb"
 	evmosserver "github.com/loka-network/loka/v1/server"
 	servercfg "github.com/loka-network/loka/v1/server/config"
 	srvflags "github.com/loka-network/loka/v1/server/flags"
@@ -172,7 +174,14 @@ func NewRootCmd() (*cobra.Command, sdktestutil.TestEncodingConfig) {
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(a.newApp, app.DefaultNodeHome),
 		snapshot.Cmd(a.newApp),
+		MemIAVLCmd(),
 	)
+	if changeSetCmd := ChangeSetCmd(); changeSetCmd != nil {
+		rootCmd.AddCommand(changeSetCmd)
+	}
+	if versionDBCmd := VersionDBCmd(); versionDBCmd != nil {
+		rootCmd.AddCommand(versionDBCmd)
+	}
 
 	evmosserver.AddCommands(
 		rootCmd,
@@ -362,6 +371,13 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetChainID(chainID),
 	)
 
+	// serve the history queries on versiondb with the JSON-RPC server started in the same process
+	if store, ok := evmosApp.VersionDB().(versiondbrpc.Store); ok {
+		if err := evmosrpc.RegisterVersionDBAPI(store); err != nil {
+			panic(err)
+		}
+	}
+
 	return evmosApp
 }
 
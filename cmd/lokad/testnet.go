@@ -450,6 +450,10 @@ func initGenFiles(
 	clientCtx.Codec.MustUnmarshalJSON(appGenState[evmtypes.ModuleName], &evmGenState)
 
 	evmGenState.Params.EvmDenom = coinDenom
+	evmGenState.Params.EVMChainID, err = evmtypes.ParseEVMChainID(chainID)
+	if err != nil {
+		return err
+	}
 	appGenState[evmtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&evmGenState)
 
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
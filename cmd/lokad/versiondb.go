@@ -31,3 +31,16 @@ func ChangeSetCmd() *cobra.Command {
 		},
 	})
 }
+
+// VersionDBCmd returns the commands to manage the versiondb of the node.
+// NOTE: this is only included in builds with rocksdb
+func VersionDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versiondb",
+		Short: "Manage the versiondb of the node",
+	}
+	cmd.AddCommand(
+		versiondbclient.PruneVersionDBCmd(),
+	)
+	return cmd
+}
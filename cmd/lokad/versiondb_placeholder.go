@@ -13,3 +13,8 @@ import (
 func ChangeSetCmd() *cobra.Command {
 	return nil
 }
+
+// VersionDBCmd returns nil for builds without rocksdb
+func VersionDBCmd() *cobra.Command {
+	return nil
+}
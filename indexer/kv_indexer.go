@@ -35,8 +35,9 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash    = 1
+	KeyPrefixTxIndex   = 2
+	KeyPrefixLastBlock = 3
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -129,6 +130,17 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxRe
 			}
 		}
 	}
+
+	// record the progress even if the block don't contain any eth tx, the indexer could also index old blocks backward.
+	last, err := kv.LastProcessedBlock()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if height > last {
+		if err := batch.Set(LastBlockKey(), sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set last block key", height)
+		}
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -140,6 +152,20 @@ func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
 	return LoadLastBlock(kv.db)
 }
 
+// LastProcessedBlock returns the latest block number processed by the indexer, including the blocks without eth txs,
+// returns -1 if db is empty
+func (kv *KVIndexer) LastProcessedBlock() (int64, error) {
+	bz, err := kv.db.Get(LastBlockKey())
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastProcessedBlock")
+	}
+	if len(bz) == 0 {
+		// db written by older versions
+		return LoadLastBlock(kv.db)
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
 // FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
 func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
@@ -185,6 +211,11 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LastBlockKey returns the key for db entry: `-> last processed block number`
+func LastBlockKey() []byte {
+	return []byte{KeyPrefixLastBlock}
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -0,0 +1,670 @@
+package memiavl
+
+import (
+	"bufio"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alitto/pond"
+	"github.com/tidwall/wal"
+)
+
+const (
+	// ArchiveFilePrefix and ArchiveFileSuffix are the same as the change set files dumped by versiondb, the file
+	// name includes the first version in the file.
+	ArchiveFilePrefix = "block-"
+	ArchiveFileSuffix = ".zz"
+	// ArchiveUpgradesFileName is the file in the root of the archive directory which records the store upgrades.
+	ArchiveUpgradesFileName = "upgrades"
+	// ArchiveRangesFileName is the file in the root of the archive directory which records the version ranges
+	// archived.
+	ArchiveRangesFileName = "ranges"
+)
+
+// archiveWAL exports the WAL entries in range `[start, end)` into compressed change set files, one file for each
+// store in the archive directory, in the layout of versiondb's `changeset dump` command:
+//
+// ```
+// > <archive dir>
+// >   ranges
+// >   upgrades
+// >   <store>
+// >     block-<first version>.zz
+// ```
+//
+// The files are written atomically and named by the first version, so it's idempotent to archive the same range
+// again if the WAL truncation is interrupted.
+func archiveWAL(log *wal.Log, archiveDir string, start, end uint64, initialVersion uint32) error {
+	// the first index is 0 if the wal is empty
+	if start == 0 || start >= end {
+		return nil
+	}
+
+	if err := os.MkdirAll(archiveDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	firstVersion := walVersion(start, initialVersion)
+	writers := make(map[string]*archiveWriter)
+	var upgrades []byte
+	closeAll := func() error {
+		var errs []error
+		for _, w := range writers {
+			errs = append(errs, w.abort())
+		}
+		return errors.Join(errs...)
+	}
+
+	for i := start; i < end; i++ {
+		bz, err := log.Read(i)
+		if err != nil {
+			return errors.Join(fmt.Errorf("read wal failed, index: %d, %w", i, err), closeAll())
+		}
+		var entry WALEntry
+		if err := entry.Unmarshal(bz); err != nil {
+			return errors.Join(fmt.Errorf("unmarshal wal failed, index: %d, %w", i, err), closeAll())
+		}
+
+		version := walVersion(i, initialVersion)
+		if len(entry.Upgrades) > 0 {
+			bz, err := (&WALEntry{Upgrades: entry.Upgrades}).Marshal()
+			if err != nil {
+				return errors.Join(err, closeAll())
+			}
+			upgrades = appendArchiveRecord(upgrades, version, bz)
+		}
+
+		for _, cs := range entry.Changesets {
+			if len(cs.Changeset.Pairs) == 0 {
+				continue
+			}
+			w, ok := writers[cs.Name]
+			if !ok {
+				w, err = newArchiveWriter(filepath.Join(archiveDir, cs.Name), firstVersion)
+				if err != nil {
+					return errors.Join(err, closeAll())
+				}
+				writers[cs.Name] = w
+			}
+			if err := w.write(version, cs.Changeset); err != nil {
+				return errors.Join(err, closeAll())
+			}
+		}
+	}
+
+	for name, w := range writers {
+		delete(writers, name)
+		if err := w.commit(); err != nil {
+			return errors.Join(err, closeAll())
+		}
+	}
+
+	if err := updateArchiveRecords(filepath.Join(archiveDir, ArchiveUpgradesFileName), firstVersion, upgrades); err != nil {
+		return err
+	}
+	// the range is recorded in the end, after all the files are written
+	lastVersion := binary.LittleEndian.AppendUint64(nil, uint64(walVersion(end-1, initialVersion)))
+	return updateArchiveRecords(
+		filepath.Join(archiveDir, ArchiveRangesFileName), firstVersion, appendArchiveRecord(nil, firstVersion, lastVersion),
+	)
+}
+
+// updateArchiveRecords appends the records to the file, the existing records of the versions not older than the
+// first version are replaced, in case of archiving the same range again.
+func updateArchiveRecords(name string, firstVersion int64, records []byte) error {
+	var retained []byte
+	if err := readArchiveRecords(name, func(version int64, payload []byte) error {
+		if version < firstVersion {
+			retained = appendArchiveRecord(retained, version, payload)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(retained) == 0 && len(records) == 0 {
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	tmp := name + TmpSuffix
+	if err := WriteFileSync(tmp, append(retained, records...)); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// readArchiveRecords iterates the records in the file, it's ok if the file don't exist.
+func readArchiveRecords(name string, fn func(version int64, payload []byte) error) error {
+	bz, err := os.ReadFile(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for len(bz) > 0 {
+		if len(bz) < 16 {
+			return fmt.Errorf("corrupted archive file: %s", name)
+		}
+		version := int64(binary.LittleEndian.Uint64(bz))
+		size := binary.LittleEndian.Uint64(bz[8:])
+		if uint64(len(bz)-16) < size {
+			return fmt.Errorf("corrupted archive file: %s", name)
+		}
+		if err := fn(version, bz[16:16+size]); err != nil {
+			return err
+		}
+		bz = bz[16+size:]
+	}
+	return nil
+}
+
+// appendArchiveRecord appends the record with the same header as the change set files: version and payload size.
+func appendArchiveRecord(buf []byte, version int64, payload []byte) []byte {
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(version))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(len(payload)))
+	return append(buf, payload...)
+}
+
+// archiveWriter writes the change sets of a store into a compressed change set file.
+type archiveWriter struct {
+	name string
+	fp   *os.File
+	buf  *bufio.Writer
+	zw   *zlib.Writer
+}
+
+func newArchiveWriter(storeDir string, firstVersion int64) (*archiveWriter, error) {
+	if err := os.MkdirAll(storeDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	name := filepath.Join(storeDir, archiveFileName(firstVersion))
+	fp, err := os.OpenFile(name+TmpSuffix, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(fp)
+	return &archiveWriter{name: name, fp: fp, buf: buf, zw: zlib.NewWriter(buf)}, nil
+}
+
+// write writes a version of change set, see `versiondb/client.WriteChangeSet` for the format.
+func (w *archiveWriter) write(version int64, cs ChangeSet) error {
+	var size int
+	for _, pair := range cs.Pairs {
+		size += encodedSizeOfChangeSetPair(pair)
+	}
+
+	var header [16]byte
+	binary.LittleEndian.PutUint64(header[:], uint64(version))
+	binary.LittleEndian.PutUint64(header[8:], uint64(size))
+	if _, err := w.zw.Write(header[:]); err != nil {
+		return err
+	}
+
+	var buf []byte
+	for _, pair := range cs.Pairs {
+		buf = buf[:0]
+		if pair.Delete {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(pair.Key)))
+		buf = append(buf, pair.Key...)
+		if !pair.Delete {
+			buf = binary.AppendUvarint(buf, uint64(len(pair.Value)))
+			buf = append(buf, pair.Value...)
+		}
+		if _, err := w.zw.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *archiveWriter) commit() error {
+	err := w.zw.Close()
+	if err == nil {
+		err = w.buf.Flush()
+	}
+	if err == nil {
+		err = w.fp.Sync()
+	}
+	if err := errors.Join(err, w.fp.Close()); err != nil {
+		return errors.Join(err, os.Remove(w.fp.Name()))
+	}
+	return os.Rename(w.fp.Name(), w.name)
+}
+
+func (w *archiveWriter) abort() error {
+	return errors.Join(w.fp.Close(), os.Remove(w.fp.Name()))
+}
+
+func encodedSizeOfChangeSetPair(pair *KVPair) int {
+	size := 1 + uvarintSize(uint64(len(pair.Key))) + len(pair.Key)
+	if pair.Delete {
+		return size
+	}
+	return size + uvarintSize(uint64(len(pair.Value))) + len(pair.Value)
+}
+
+func uvarintSize(num uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], num)
+}
+
+func archiveFileName(firstVersion int64) string {
+	return ArchiveFilePrefix + strconv.FormatInt(firstVersion, 10) + ArchiveFileSuffix
+}
+
+// archiveFiles returns the archive files of the store sorted by the first version.
+func archiveFiles(storeDir string) ([]archiveFile, error) {
+	entries, err := os.ReadDir(storeDir)
+	if err != nil {
+		return nil, err
+	}
+	var files []archiveFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, ArchiveFilePrefix) || !strings.HasSuffix(name, ArchiveFileSuffix) {
+			continue
+		}
+		version, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, ArchiveFilePrefix), ArchiveFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, archiveFile{path: filepath.Join(storeDir, name), version: version})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].version < files[j].version
+	})
+	return files, nil
+}
+
+type archiveFile struct {
+	path    string
+	version int64
+}
+
+// archiveReader iterates the change sets of a store in the archive files, in ascending order of versions.
+type archiveReader struct {
+	name  string
+	files []archiveFile
+
+	fp     *os.File
+	zr     io.ReadCloser
+	reader *bufio.Reader
+
+	// the change set read in advance
+	version   int64
+	changeSet *ChangeSet
+}
+
+// newArchiveReader creates a reader which skips the change sets not newer than `after`.
+func newArchiveReader(storeDir, name string, after int64) (*archiveReader, error) {
+	files, err := archiveFiles(storeDir)
+	if err != nil {
+		return nil, err
+	}
+	// skip the files which only contain the older versions
+	for len(files) > 1 && files[1].version <= after+1 {
+		files = files[1:]
+	}
+	r := &archiveReader{name: name, files: files}
+	for {
+		if err := r.next(); err != nil {
+			return nil, errors.Join(err, r.Close())
+		}
+		if r.changeSet == nil || r.version > after {
+			return r, nil
+		}
+	}
+}
+
+// next reads the next change set, `changeSet` is nil at the end.
+func (r *archiveReader) next() error {
+	for {
+		if r.reader == nil {
+			if len(r.files) == 0 {
+				r.changeSet = nil
+				return nil
+			}
+			if err := r.open(r.files[0].path); err != nil {
+				return err
+			}
+			r.files = r.files[1:]
+		}
+
+		version, cs, err := readArchiveChangeSet(r.reader)
+		if errors.Is(err, io.EOF) {
+			if err := r.closeFile(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("read archive of store %s failed: %w", r.name, err)
+		}
+		if r.changeSet != nil && version <= r.version {
+			return fmt.Errorf("archive of store %s is not in order: %d after %d", r.name, version, r.version)
+		}
+		r.version = version
+		r.changeSet = cs
+		return nil
+	}
+}
+
+func (r *archiveReader) open(path string) error {
+	fp, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	zr, err := zlib.NewReader(fp)
+	if err != nil {
+		return errors.Join(err, fp.Close())
+	}
+	r.fp, r.zr, r.reader = fp, zr, bufio.NewReader(zr)
+	return nil
+}
+
+func (r *archiveReader) closeFile() error {
+	if r.fp == nil {
+		return nil
+	}
+	err := errors.Join(r.zr.Close(), r.fp.Close())
+	r.fp, r.zr, r.reader = nil, nil, nil
+	return err
+}
+
+func (r *archiveReader) Close() error {
+	return r.closeFile()
+}
+
+// readArchiveChangeSet decodes a version of change set, returns `io.EOF` at the end of the file.
+func readArchiveChangeSet(reader *bufio.Reader) (int64, *ChangeSet, error) {
+	var header [16]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return 0, nil, err
+	}
+	version := int64(binary.LittleEndian.Uint64(header[:]))
+	size := int64(binary.LittleEndian.Uint64(header[8:]))
+
+	var (
+		cs     ChangeSet
+		offset int64
+	)
+	for offset < size {
+		deletion, err := reader.ReadByte()
+		if err != nil {
+			return 0, nil, unexpectedEOF(err)
+		}
+		key, err := readUvarintBytes(reader)
+		if err != nil {
+			return 0, nil, err
+		}
+		pair := &KVPair{Delete: deletion == 1, Key: key}
+		if !pair.Delete {
+			if pair.Value, err = readUvarintBytes(reader); err != nil {
+				return 0, nil, err
+			}
+		}
+		offset += int64(encodedSizeOfChangeSetPair(pair))
+		cs.Pairs = append(cs.Pairs, pair)
+	}
+	if offset != size {
+		return 0, nil, fmt.Errorf("read beyond payload size limit, size: %d, offset: %d", size, offset)
+	}
+	return version, &cs, nil
+}
+
+func readUvarintBytes(reader *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	bz := make([]byte, n)
+	if _, err := io.ReadFull(reader, bz); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return bz, nil
+}
+
+// unexpectedEOF converts `io.EOF` in the middle of a change set to `io.ErrUnexpectedEOF`.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// RestoreFromArchive rebuilds the db at the target version in `outputDir`, by replaying the archived change sets
+// in `archiveDir` on top of the multitree snapshot in `snapshotDir`, the archive must cover all the versions between
+// them.
+func RestoreFromArchive(snapshotDir, archiveDir string, targetVersion int64, outputDir string) (returnErr error) {
+	mtree, err := LoadMultiTree(snapshotDir, false, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		returnErr = errors.Join(returnErr, mtree.Close())
+	}()
+
+	if targetVersion < mtree.Version() {
+		return fmt.Errorf("target version %d is older than the snapshot version %d", targetVersion, mtree.Version())
+	}
+	if err := replayArchive(mtree, archiveDir, targetVersion); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
+		return err
+	}
+	fileLock, err := LockFile(filepath.Join(outputDir, LockFileName))
+	if err != nil {
+		return fmt.Errorf("fail to lock db: %w", err)
+	}
+	defer func() {
+		returnErr = errors.Join(returnErr, fileLock.Unlock(), fileLock.Destroy())
+	}()
+
+	name := snapshotName(targetVersion)
+	tmpDir := filepath.Join(outputDir, name+TmpSuffix)
+	pool := pond.New(DefaultSnapshotWriterLimit, DefaultSnapshotWriterLimit*10)
+	err = mtree.WriteSnapshot(tmpDir, pool)
+	pool.StopAndWait()
+	if err != nil {
+		return err
+	}
+
+	// the output db has an empty wal, the initial version should correspond to the first wal entry
+	metadata := MultiTreeMetadata{
+		CommitInfo:     mtree.LastCommitInfo(),
+		InitialVersion: targetVersion + 1,
+	}
+	bz, err := metadata.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := WriteFileSync(filepath.Join(tmpDir, MetadataFileName), bz); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpDir, filepath.Join(outputDir, name)); err != nil {
+		return err
+	}
+	return updateCurrentSymlink(outputDir, name)
+}
+
+// archivedUntil returns the last version archived continuously from the start version, returns `startVersion - 1`
+// if the start version is not archived.
+func archivedUntil(archiveDir string, startVersion int64) (int64, error) {
+	type versionRange struct{ first, last int64 }
+	var ranges []versionRange
+	if err := readArchiveRecords(filepath.Join(archiveDir, ArchiveRangesFileName), func(version int64, payload []byte) error {
+		if len(payload) != 8 {
+			return errors.New("corrupted archive ranges file")
+		}
+		ranges = append(ranges, versionRange{version, int64(binary.LittleEndian.Uint64(payload))})
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].first < ranges[j].first
+	})
+
+	next := startVersion
+	for _, r := range ranges {
+		if r.first <= next && r.last >= next {
+			next = r.last + 1
+		}
+	}
+	return next - 1, nil
+}
+
+// LoadArchivedVersion reconstructs the multitree at a version which is not reachable from the snapshots and the WAL
+// of the db in `dir`, by replaying the archived change sets on top of the closest retained snapshot, or on top of
+// the empty trees if there's no older snapshot and the archive starts from the initial version, the versions after
+// the archive are replayed from the WAL.
+func LoadArchivedVersion(dir, archiveDir string, version int64, cacheSize int) (*MultiTree, error) {
+	if version <= 0 || version > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid version: %d", version)
+	}
+
+	var mtree *MultiTree
+	snapshotVersion, err := SeekSnapshot(dir, uint32(version))
+	if err == nil {
+		mtree, err = LoadMultiTree(filepath.Join(dir, snapshotName(snapshotVersion)), false, cacheSize)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		metadata, err := readMetadata(currentPath(dir))
+		if err != nil {
+			return nil, err
+		}
+		if metadata.InitialVersion < 0 || metadata.InitialVersion > math.MaxUint32 {
+			return nil, fmt.Errorf("invalid initial version: %d", metadata.InitialVersion)
+		}
+		mtree = NewEmptyMultiTree(uint32(metadata.InitialVersion), cacheSize)
+		mtree.SetZeroCopy(false)
+	}
+
+	if err := CatchupArchive(dir, archiveDir, mtree, version); err != nil {
+		return nil, errors.Join(err, mtree.Close())
+	}
+	return mtree, nil
+}
+
+// CatchupArchive replays the archived change sets to the multitree until the version, the versions after the archive
+// are replayed from the WAL of the db in `dir`.
+func CatchupArchive(dir, archiveDir string, mtree *MultiTree, version int64) error {
+	if mtree.Version() >= version {
+		return nil
+	}
+	until, err := archivedUntil(archiveDir, nextVersion(mtree.Version(), mtree.initialVersion))
+	if err != nil {
+		return err
+	}
+	if until > mtree.Version() {
+		if err := replayArchive(mtree, archiveDir, min(until, version)); err != nil {
+			return err
+		}
+	}
+	if mtree.Version() < version {
+		// the versions after the archive are still in the wal
+		if err := CatchupWAL(dir, mtree, version); err != nil {
+			return err
+		}
+	}
+	if mtree.Version() != version {
+		return fmt.Errorf("version %d is not available, latest: %d", version, mtree.Version())
+	}
+	return nil
+}
+
+// replayArchive applies the archived store upgrades and change sets to the multitree until the target version.
+func replayArchive(mtree *MultiTree, archiveDir string, targetVersion int64) (returnErr error) {
+	after := mtree.Version()
+	startVersion := nextVersion(after, mtree.initialVersion)
+
+	until, err := archivedUntil(archiveDir, startVersion)
+	if err != nil {
+		return err
+	}
+	if until < targetVersion {
+		return fmt.Errorf("version %d is not archived", until+1)
+	}
+
+	upgrades := make(map[int64][]*TreeNameUpgrade)
+	if err := readArchiveRecords(filepath.Join(archiveDir, ArchiveUpgradesFileName), func(version int64, payload []byte) error {
+		if version < startVersion || version > targetVersion {
+			return nil
+		}
+		var entry WALEntry
+		if err := entry.Unmarshal(payload); err != nil {
+			return err
+		}
+		upgrades[version] = entry.Upgrades
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(archiveDir)
+	if err != nil {
+		return err
+	}
+	var readers []*archiveReader
+	defer func() {
+		for _, r := range readers {
+			returnErr = errors.Join(returnErr, r.Close())
+		}
+	}()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		r, err := newArchiveReader(filepath.Join(archiveDir, entry.Name()), entry.Name(), after)
+		if err != nil {
+			return err
+		}
+		readers = append(readers, r)
+	}
+
+	for version := startVersion; version <= targetVersion; version++ {
+		var changeSets []*NamedChangeSet
+		for _, r := range readers {
+			if r.changeSet == nil || r.version != version {
+				continue
+			}
+			changeSets = append(changeSets, &NamedChangeSet{Name: r.name, Changeset: *r.changeSet})
+			if err := r.next(); err != nil {
+				return err
+			}
+		}
+		sort.Slice(changeSets, func(i, j int) bool {
+			return changeSets[i].Name < changeSets[j].Name
+		})
+
+		if err := mtree.applyWALEntry(WALEntry{Upgrades: upgrades[version], Changesets: changeSets}); err != nil {
+			return fmt.Errorf("replay archive failed, version: %d, %w", version, err)
+		}
+		if _, err := mtree.SaveVersion(false); err != nil {
+			return err
+		}
+	}
+
+	mtree.UpdateCommitInfo()
+	return nil
+}
@@ -0,0 +1,91 @@
+package memiavl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveWAL(t *testing.T) {
+	dir := t.TempDir()
+	archiveDir := filepath.Join(t.TempDir(), "archive")
+	db, err := Load(dir, Options{
+		CreateIfMissing:    true,
+		InitialStores:      []string{"test", "test2"},
+		SnapshotKeepRecent: 0,
+		WALArchiveDir:      archiveDir,
+	})
+	require.NoError(t, err)
+
+	// keep the initial snapshot to restore from
+	initialSnapshot := filepath.Join(t.TempDir(), snapshotName(0))
+	require.NoError(t, os.CopyFS(initialSnapshot, os.DirFS(filepath.Join(dir, snapshotName(0)))))
+
+	var commitInfos []*CommitInfo
+	for i, changes := range ChangeSets {
+		cs := []*NamedChangeSet{{Name: "test", Changeset: changes}}
+		if i%2 == 0 {
+			cs = append(cs, &NamedChangeSet{Name: "test2", Changeset: changes})
+		}
+		if i == 3 {
+			require.NoError(t, db.ApplyUpgrades([]*TreeNameUpgrade{{Name: "test3"}}))
+		}
+		require.NoError(t, db.ApplyChangeSets(cs))
+		_, err := db.Commit()
+		require.NoError(t, err)
+		commitInfo := *db.LastCommitInfo()
+		commitInfos = append(commitInfos, &commitInfo)
+
+		if i%3 == 0 && i > 0 {
+			// switch to the snapshot one version behind, so the wal can be truncated
+			for db.snapshotRewriteChan != nil {
+				require.NoError(t, db.checkAsyncTasks())
+			}
+			// wait for the pruning
+			db.pruneSnapshotLock.Lock()
+			db.pruneSnapshotLock.Unlock() //nolint:staticcheck
+		}
+		if i%3 == 2 {
+			require.NoError(t, db.RewriteSnapshotBackground())
+		}
+	}
+	require.NoError(t, db.Close())
+
+	// the wal is truncated behind the latest snapshot
+	earliest, err := firstSnapshotVersion(dir)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), earliest)
+	files, err := archiveFiles(filepath.Join(archiveDir, "test"))
+	require.NoError(t, err)
+	require.Equal(t, []archiveFile{
+		{path: filepath.Join(archiveDir, "test", "block-1.zz"), version: 1},
+		{path: filepath.Join(archiveDir, "test", "block-4.zz"), version: 4},
+	}, files)
+
+	for version := int64(0); version <= earliest; version++ {
+		outputDir := t.TempDir()
+		require.NoError(t, RestoreFromArchive(initialSnapshot, archiveDir, version, outputDir))
+
+		restored, err := Load(outputDir, Options{ReadOnly: true})
+		require.NoError(t, err)
+		if version > 0 {
+			require.Equal(t, commitInfos[version-1], restored.LastCommitInfo())
+		}
+		require.NoError(t, restored.Close())
+	}
+
+	// the versions still in the wal are not archived
+	require.Error(t, RestoreFromArchive(initialSnapshot, archiveDir, earliest+1, t.TempDir()))
+
+	// the versions are reconstructed from the archive and the wal without the pruned snapshots
+	for version := int64(1); version <= int64(len(commitInfos)); version++ {
+		mtree, err := LoadArchivedVersion(dir, archiveDir, version, 0)
+		require.NoError(t, err)
+		require.Equal(t, commitInfos[version-1], mtree.LastCommitInfo())
+		require.NoError(t, mtree.Close())
+	}
+	_, err = LoadArchivedVersion(dir, archiveDir, int64(len(commitInfos))+1, 0)
+	require.Error(t, err)
+}
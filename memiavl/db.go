@@ -57,6 +57,10 @@ type DB struct {
 	snapshotKeepRecent uint32
 	// block interval to take a new snapshot
 	snapshotInterval uint32
+	// the max number of consecutive incremental snapshots before a full one
+	maxDeltaSnapshots uint32
+	// the directory to archive the truncated WAL entries, empty means no archiving
+	walArchiveDir string
 	// make sure only one snapshot rewrite is running
 	pruneSnapshotLock      sync.Mutex
 	triggerStateSyncExport func(height int64)
@@ -104,6 +108,12 @@ type Options struct {
 	ZeroCopy bool
 	// CacheSize defines the cache's max entry size for each memiavl store.
 	CacheSize int
+	// MaxDeltaSnapshots defines the max number of consecutive incremental snapshots written on top of a full
+	// snapshot, which only write the nodes changed since the full snapshot, 0 means always writing full snapshots.
+	MaxDeltaSnapshots uint32
+	// WALArchiveDir if not empty, the WAL entries truncated behind the oldest retained snapshot are exported into
+	// the directory as compressed change set files compatible with versiondb, see `RestoreFromArchive`.
+	WALArchiveDir string
 	// LoadForOverwriting if true rollbacks the state, specifically the Load method will
 	// truncate the versions after the `TargetVersion`, the `TargetVersion` becomes the latest version.
 	// it do nothing if the target version is `0`.
@@ -174,7 +184,7 @@ func Load(dir string, opts Options) (*DB, error) {
 	snapshot := "current"
 	if opts.TargetVersion > 0 {
 		// find the biggest snapshot version that's less than or equal to the target version
-		snapshotVersion, err := seekSnapshot(dir, opts.TargetVersion)
+		snapshotVersion, err := SeekSnapshot(dir, opts.TargetVersion)
 		if err != nil {
 			return nil, fmt.Errorf("fail to seek snapshot: %w", err)
 		}
@@ -247,6 +257,8 @@ func Load(dir string, opts Options) (*DB, error) {
 		walChanSize:            opts.AsyncCommitBuffer,
 		snapshotKeepRecent:     opts.SnapshotKeepRecent,
 		snapshotInterval:       opts.SnapshotInterval,
+		maxDeltaSnapshots:      opts.MaxDeltaSnapshots,
+		walArchiveDir:          opts.WALArchiveDir,
 		triggerStateSyncExport: opts.TriggerStateSyncExport,
 		snapshotWriterPool:     workerPool,
 	}
@@ -503,18 +515,36 @@ func (db *DB) pruneSnapshots() {
 		}
 
 		counter := db.snapshotKeepRecent
+		// the base snapshots referenced by the retained incremental snapshots,
+		// the base is always older than the incremental snapshots and it's always a full snapshot.
+		bases := make(map[int64]struct{})
+		retain := func(version int64) error {
+			versions, err := snapshotBases(filepath.Join(db.dir, snapshotName(version)))
+			if err != nil {
+				return err
+			}
+			for _, v := range versions {
+				bases[v] = struct{}{}
+			}
+			return nil
+		}
 		if err := traverseSnapshots(db.dir, false, func(version int64) (bool, error) {
 			if version >= currentVersion {
 				// ignore any newer snapshot directories, there could be ongoning snapshot rewrite.
-				return false, nil
+				return false, retain(version)
 			}
 
 			if counter > 0 {
 				counter--
-				return false, nil
+				return false, retain(version)
 			}
 
 			name := snapshotName(version)
+			if _, ok := bases[version]; ok {
+				db.logger.Info("keep snapshot referenced by incremental snapshots", "name", name)
+				return false, nil
+			}
+
 			db.logger.Info("prune snapshot", "name", name)
 
 			if err := atomicRemoveDir(filepath.Join(db.dir, name)); err != nil {
@@ -533,7 +563,21 @@ func (db *DB) pruneSnapshots() {
 			db.logger.Error("failed to find first snapshot", "err", err)
 		}
 
-		if err := db.wal.TruncateFront(walIndex(earliestVersion+1, db.initialVersion)); err != nil {
+		truncateIndex := walIndex(earliestVersion+1, db.initialVersion)
+		if db.walArchiveDir != "" {
+			firstIndex, err := db.wal.FirstIndex()
+			if err != nil {
+				db.logger.Error("failed to read first wal index", "err", err)
+				return
+			}
+			// keep the wal entries if the archiving fails, it's retried in next pruning
+			if err := archiveWAL(db.wal, db.walArchiveDir, firstIndex, truncateIndex, db.initialVersion); err != nil {
+				db.logger.Error("failed to archive wal", "err", err, "from", walVersion(firstIndex, db.initialVersion))
+				return
+			}
+		}
+
+		if err := db.wal.TruncateFront(truncateIndex); err != nil {
 			db.logger.Error("failed to truncate wal", "err", err, "version", earliestVersion+1)
 		}
 	}()
@@ -665,6 +709,7 @@ func (db *DB) copy(cacheSize int) *DB {
 		MultiTree:          *mtree,
 		logger:             db.logger,
 		dir:                db.dir,
+		maxDeltaSnapshots:  db.maxDeltaSnapshots,
 		snapshotWriterPool: db.snapshotWriterPool,
 	}
 }
@@ -686,7 +731,7 @@ func (db *DB) RewriteSnapshotWithContext(ctx context.Context) error {
 	snapshotDir := snapshotName(db.lastCommitInfo.Version)
 	tmpDir := snapshotDir + TmpSuffix
 	path := filepath.Join(db.dir, tmpDir)
-	if err := db.MultiTree.WriteSnapshotWithContext(ctx, path, db.snapshotWriterPool); err != nil {
+	if err := db.MultiTree.WriteDeltaSnapshotWithContext(ctx, path, db.snapshotWriterPool, db.maxDeltaSnapshots); err != nil {
 		return errors.Join(err, os.RemoveAll(path))
 	}
 	if err := os.Rename(path, filepath.Join(db.dir, snapshotDir)); err != nil {
@@ -926,9 +971,9 @@ func parseVersion(name string) (int64, error) {
 	return v, nil
 }
 
-// seekSnapshot find the biggest snapshot version that's smaller than or equal to the target version,
+// SeekSnapshot find the biggest snapshot version that's smaller than or equal to the target version,
 // returns 0 if not found.
-func seekSnapshot(root string, targetVersion uint32) (int64, error) {
+func SeekSnapshot(root string, targetVersion uint32) (int64, error) {
 	var (
 		snapshotVersion int64
 		found           bool
@@ -972,6 +1017,16 @@ func walPath(root string) string {
 	return filepath.Join(root, "wal")
 }
 
+// CatchupWAL replays the write-ahead-log of the db in `dir` onto `mtree` until `endVersion`, it's used to move a
+// copy of a read-only historical version forward without reloading the snapshot files.
+func CatchupWAL(dir string, mtree *MultiTree, endVersion int64) error {
+	wal, err := OpenWAL(walPath(dir), &wal.Options{NoCopy: true, NoSync: true})
+	if err != nil {
+		return err
+	}
+	return errors.Join(mtree.CatchupWAL(wal, endVersion), wal.Close())
+}
+
 // init a empty memiavl db
 //
 // ```
@@ -0,0 +1,418 @@
+package memiavl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// SnapshotFormatDelta is the incremental snapshot format, it only contains the nodes changed since a full base
+	// snapshot, the unchanged subtrees are referenced in the files of the base snapshot.
+	SnapshotFormatDelta = 1
+
+	// FileNameDelta is the file describing the root node and the base snapshot of an incremental snapshot.
+	FileNameDelta = "delta"
+
+	// DeltaCompactionRatio defines when an incremental snapshot should be compacted into a full one, that's when
+	// the nodes of the previous incremental snapshot exceeds the number of nodes in the base snapshot divided by it.
+	DeltaCompactionRatio = 2
+
+	OffsetDeltaHeight    = 0
+	OffsetDeltaRefs      = OffsetDeltaHeight + 1
+	OffsetDeltaVersion   = OffsetDeltaHeight + 4
+	OffsetDeltaSize      = OffsetDeltaVersion + 4
+	OffsetDeltaLeft      = OffsetDeltaSize + 4
+	OffsetDeltaRight     = OffsetDeltaLeft + 4
+	OffsetDeltaKeyLen    = OffsetDeltaRight + 4
+	OffsetDeltaKeyOffset = OffsetDeltaKeyLen + 4
+	OffsetDeltaHash      = OffsetDeltaKeyOffset + 8
+	SizeDeltaNode        = OffsetDeltaHash + SizeHash
+
+	// SizeDeltaHeader seq: uint32, root ref: uint8, _padding: 3, root index: uint32, base path length: uint32
+	SizeDeltaHeader = 16
+)
+
+// nodeRef flags the kind of a node referenced by an incremental snapshot.
+type nodeRef uint8
+
+const (
+	refLeaf nodeRef = 1 << iota
+	refBase
+	// refExists is only used by the root reference to distinguish an empty tree.
+	refExists
+)
+
+// deltaNode is a branch node of an incremental snapshot, unlike `PersistedNode`, the children are referenced
+// explicitly, because the unchanged subtrees are left in the base snapshot.
+// Encoding format (all integers are encoded in little endian):
+//
+// - height     : 1
+// - refs       : 1  // low 4 bits for the left child, high 4 bits for the right child
+// - _padding   : 2
+// - version    : 4
+// - size       : 4
+// - left       : 4  // node index in the layer indicated by the refs
+// - right      : 4
+// - key len    : 4
+// - key offset : 8  // offset into the kvs file of the incremental snapshot
+// - hash       : 32
+//
+// The leaves of an incremental snapshot are stored in the same format as the full snapshot.
+type deltaNode struct {
+	snapshot *Snapshot
+	index    uint32
+}
+
+var _ Node = deltaNode{}
+
+func (node deltaNode) data() []byte {
+	offset := int(node.index) * SizeDeltaNode
+	return node.snapshot.nodes[offset : offset+SizeDeltaNode]
+}
+
+func (node deltaNode) Height() uint8 {
+	return node.data()[OffsetDeltaHeight]
+}
+
+func (node deltaNode) IsLeaf() bool {
+	return false
+}
+
+func (node deltaNode) Version() uint32 {
+	return binary.LittleEndian.Uint32(node.data()[OffsetDeltaVersion:])
+}
+
+func (node deltaNode) Size() int64 {
+	return int64(binary.LittleEndian.Uint32(node.data()[OffsetDeltaSize:]))
+}
+
+func (node deltaNode) Key() []byte {
+	data := node.data()
+	length := uint64(binary.LittleEndian.Uint32(data[OffsetDeltaKeyLen:]))
+	offset := binary.LittleEndian.Uint64(data[OffsetDeltaKeyOffset:]) + 4
+	return node.snapshot.kvs[offset : offset+length]
+}
+
+// Value returns nil for non-leaf node.
+func (node deltaNode) Value() []byte {
+	return nil
+}
+
+func (node deltaNode) Left() Node {
+	data := node.data()
+	return node.snapshot.resolveRef(nodeRef(data[OffsetDeltaRefs]&0x0f), binary.LittleEndian.Uint32(data[OffsetDeltaLeft:]))
+}
+
+func (node deltaNode) Right() Node {
+	data := node.data()
+	return node.snapshot.resolveRef(nodeRef(data[OffsetDeltaRefs]>>4), binary.LittleEndian.Uint32(data[OffsetDeltaRight:]))
+}
+
+func (node deltaNode) Hash() []byte {
+	return node.data()[OffsetDeltaHash : OffsetDeltaHash+SizeHash]
+}
+
+func (node deltaNode) SafeHash() []byte {
+	return bytes.Clone(node.Hash())
+}
+
+func (node deltaNode) Mutate(version, _ uint32) *MemNode {
+	return &MemNode{
+		height:  node.Height(),
+		size:    node.Size(),
+		version: version,
+		key:     node.Key(),
+		left:    node.Left(),
+		right:   node.Right(),
+	}
+}
+
+func (node deltaNode) Get(key []byte) ([]byte, uint32) {
+	if bytes.Compare(key, node.Key()) == -1 {
+		return node.Left().Get(key)
+	}
+	right := node.Right()
+	value, index := right.Get(key)
+	return value, index + uint32(node.Size()) - uint32(right.Size())
+}
+
+func (node deltaNode) GetByIndex(index uint32) ([]byte, []byte) {
+	left := node.Left()
+	leftSize := uint32(left.Size())
+	if index < leftSize {
+		return left.GetByIndex(index)
+	}
+	return node.Right().GetByIndex(index - leftSize)
+}
+
+// resolveRef returns the node referenced by an incremental snapshot.
+func (snapshot *Snapshot) resolveRef(ref nodeRef, index uint32) Node {
+	layer := snapshot
+	if ref&refBase != 0 {
+		layer = snapshot.base
+	}
+	if ref&refLeaf != 0 {
+		return PersistedNode{snapshot: layer, index: index, isLeaf: true}
+	}
+	if layer == snapshot {
+		return deltaNode{snapshot: snapshot, index: index}
+	}
+	return PersistedNode{snapshot: layer, index: index}
+}
+
+// IsDelta returns if the snapshot is an incremental one layered on top of a full snapshot.
+func (snapshot *Snapshot) IsDelta() bool {
+	return snapshot.base != nil
+}
+
+// deltaHeader is the content of the `delta` file.
+type deltaHeader struct {
+	// seq is the number of incremental snapshots since the base snapshot, including this one.
+	seq       uint32
+	root      nodeRef
+	rootIndex uint32
+	// basePath is the path of the base snapshot relative to the incremental snapshot directory.
+	basePath string
+}
+
+func readDeltaHeader(snapshotDir string) (deltaHeader, error) {
+	bz, err := os.ReadFile(filepath.Join(snapshotDir, FileNameDelta))
+	if err != nil {
+		return deltaHeader{}, err
+	}
+	if len(bz) < SizeDeltaHeader {
+		return deltaHeader{}, fmt.Errorf("wrong delta file size: %d", len(bz))
+	}
+	pathLen := binary.LittleEndian.Uint32(bz[12:])
+	if len(bz) != SizeDeltaHeader+int(pathLen) {
+		return deltaHeader{}, fmt.Errorf("wrong delta file size, expected: %d, found: %d", SizeDeltaHeader+int(pathLen), len(bz))
+	}
+	return deltaHeader{
+		seq:       binary.LittleEndian.Uint32(bz),
+		root:      nodeRef(bz[4]),
+		rootIndex: binary.LittleEndian.Uint32(bz[8:]),
+		basePath:  string(bz[SizeDeltaHeader:]),
+	}, nil
+}
+
+func writeDeltaHeader(snapshotDir string, header deltaHeader) error {
+	bz := make([]byte, SizeDeltaHeader+len(header.basePath))
+	binary.LittleEndian.PutUint32(bz, header.seq)
+	bz[4] = byte(header.root)
+	binary.LittleEndian.PutUint32(bz[8:], header.rootIndex)
+	binary.LittleEndian.PutUint32(bz[12:], uint32(len(header.basePath)))
+	copy(bz[SizeDeltaHeader:], header.basePath)
+	return WriteFileSync(filepath.Join(snapshotDir, FileNameDelta), bz)
+}
+
+// openDeltaSnapshot opens the base snapshot and resolves the root node of an incremental snapshot, the nodes and
+// leaves files of the snapshot itself are already mmap-ed.
+func openDeltaSnapshot(snapshotDir string, snapshot *Snapshot) error {
+	if len(snapshot.nodes)%SizeDeltaNode != 0 {
+		return fmt.Errorf("corrupted snapshot, nodes file size %d is not a multiple of %d", len(snapshot.nodes), SizeDeltaNode)
+	}
+	if len(snapshot.leaves)%SizeLeaf != 0 {
+		return fmt.Errorf("corrupted snapshot, leaves file size %d is not a multiple of %d", len(snapshot.leaves), SizeLeaf)
+	}
+
+	header, err := readDeltaHeader(snapshotDir)
+	if err != nil {
+		return err
+	}
+
+	base, err := OpenSnapshot(filepath.Join(snapshot.dir, header.basePath))
+	if err != nil {
+		return fmt.Errorf("fail to open base snapshot: %w", err)
+	}
+	if err := validateDeltaRoot(header, snapshot, base); err != nil {
+		return errors.Join(err, base.Close())
+	}
+
+	snapshot.base = base
+	snapshot.deltaSeq = header.seq
+	if header.root&refExists != 0 {
+		snapshot.root = snapshot.resolveRef(header.root, header.rootIndex)
+	}
+	return nil
+}
+
+func validateDeltaRoot(header deltaHeader, snapshot, base *Snapshot) error {
+	if base.IsDelta() {
+		return errors.New("base snapshot is incremental")
+	}
+	if header.root&refExists == 0 {
+		return nil
+	}
+
+	var count int
+	switch {
+	case header.root&refBase != 0 && header.root&refLeaf != 0:
+		count = base.leavesLen()
+	case header.root&refBase != 0:
+		count = base.nodesLen()
+	case header.root&refLeaf != 0:
+		count = snapshot.leavesLen()
+	default:
+		count = len(snapshot.nodes) / SizeDeltaNode
+	}
+	if int(header.rootIndex) >= count {
+		return fmt.Errorf("corrupted snapshot, root index %d out of range %d", header.rootIndex, count)
+	}
+	return nil
+}
+
+// deltaNodesLen returns the number of the nodes and leaves stored in the incremental snapshot itself.
+func (snapshot *Snapshot) deltaNodesLen() int {
+	return len(snapshot.nodes)/SizeDeltaNode + snapshot.leavesLen()
+}
+
+// deltaBase returns the snapshot an incremental snapshot of the tree can be based on, returns nil if a full snapshot
+// should be written instead.
+func (t *Tree) deltaBase(maxDeltas uint32) (*Snapshot, uint32) {
+	if maxDeltas == 0 || t.snapshot == nil {
+		return nil, 0
+	}
+
+	if !t.snapshot.IsDelta() {
+		if t.snapshot.IsEmpty() || t.snapshot.dir == "" {
+			return nil, 0
+		}
+		return t.snapshot, 1
+	}
+
+	base := t.snapshot.base
+	if t.snapshot.deltaSeq >= maxDeltas ||
+		t.snapshot.deltaNodesLen()*DeltaCompactionRatio > base.nodesLen()+base.leavesLen() {
+		// compact into a full snapshot
+		return nil, 0
+	}
+	return base, t.snapshot.deltaSeq + 1
+}
+
+// WriteDeltaSnapshotWithContext save the IAVL tree to a new snapshot directory, it writes an incremental snapshot on
+// top of the full snapshot the tree is loaded from if possible, the number of consecutive incremental snapshots is
+// limited by `maxDeltas`, 0 means always writing full snapshots.
+//
+// The incremental snapshot references the base snapshot with a relative path, so it must be written into the same
+// parent directory as the base snapshot.
+func (t *Tree) WriteDeltaSnapshotWithContext(ctx context.Context, snapshotDir string, maxDeltas uint32) error {
+	base, seq := t.deltaBase(maxDeltas)
+	if base == nil {
+		return t.WriteSnapshotWithContext(ctx, snapshotDir)
+	}
+
+	if err := os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
+		return err
+	}
+	realDir, err := filepath.EvalSymlinks(snapshotDir)
+	if err != nil {
+		return err
+	}
+	basePath, err := filepath.Rel(realDir, base.dir)
+	if err != nil {
+		return err
+	}
+
+	header := deltaHeader{seq: seq, basePath: basePath}
+	if err := writeSnapshotFiles(ctx, snapshotDir, t.version, SnapshotFormatDelta, func(w *snapshotWriter) (uint32, error) {
+		if t.root != nil {
+			root, index, err := w.writeDeltaRecursive(t.root, base)
+			if err != nil {
+				return 0, err
+			}
+			header.root = root | refExists
+			header.rootIndex = index
+		}
+		return w.leafCounter + w.branchCounter, nil
+	}); err != nil {
+		return err
+	}
+
+	return writeDeltaHeader(snapshotDir, header)
+}
+
+// writeDeltaRecursive writes the nodes not in the base snapshot in depth-first post-order, returns the reference to
+// the node.
+func (w *snapshotWriter) writeDeltaRecursive(node Node, base *Snapshot) (nodeRef, uint32, error) {
+	if persisted, ok := node.(PersistedNode); ok && persisted.snapshot == base {
+		ref := refBase
+		if persisted.isLeaf {
+			ref |= refLeaf
+		}
+		return ref, persisted.index, nil
+	}
+
+	if node.IsLeaf() {
+		if err := w.writeLeaf(node.Version(), node.Key(), node.Value(), node.Hash()); err != nil {
+			return 0, 0, err
+		}
+		return refLeaf, w.leafCounter - 1, nil
+	}
+
+	leftRef, left, err := w.writeDeltaRecursive(node.Left(), base)
+	if err != nil {
+		return 0, 0, err
+	}
+	rightRef, right, err := w.writeDeltaRecursive(node.Right(), base)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	key := node.Key()
+	var buf [SizeDeltaNode]byte
+	buf[OffsetDeltaHeight] = node.Height()
+	buf[OffsetDeltaRefs] = byte(leftRef) | byte(rightRef)<<4
+	binary.LittleEndian.PutUint32(buf[OffsetDeltaVersion:], node.Version())
+	binary.LittleEndian.PutUint32(buf[OffsetDeltaSize:], uint32(node.Size()))
+	binary.LittleEndian.PutUint32(buf[OffsetDeltaLeft:], left)
+	binary.LittleEndian.PutUint32(buf[OffsetDeltaRight:], right)
+	binary.LittleEndian.PutUint32(buf[OffsetDeltaKeyLen:], uint32(len(key)))
+	binary.LittleEndian.PutUint64(buf[OffsetDeltaKeyOffset:], w.kvsOffset)
+	copy(buf[OffsetDeltaHash:], node.Hash())
+
+	// the key of the branch node is stored as a key-value pair with empty value
+	if err := w.writeKeyValue(key, nil); err != nil {
+		return 0, 0, err
+	}
+	if _, err := w.nodesWriter.Write(buf[:]); err != nil {
+		return 0, 0, err
+	}
+	w.branchCounter++
+	return 0, w.branchCounter - 1, nil
+}
+
+// snapshotBases returns the versions of the base snapshots referenced by the incremental snapshots of the trees in
+// the multitree snapshot directory.
+func snapshotBases(dir string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var bases []int64
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		treeDir := filepath.Join(dir, e.Name())
+		header, err := readDeltaHeader(treeDir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// full snapshot
+				continue
+			}
+			return nil, err
+		}
+		version, err := parseVersion(filepath.Base(filepath.Dir(filepath.Join(treeDir, header.basePath))))
+		if err != nil {
+			return nil, fmt.Errorf("invalid base snapshot path %s: %w", header.basePath, err)
+		}
+		bases = append(bases, version)
+	}
+	return bases, nil
+}
@@ -0,0 +1,189 @@
+package memiavl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockDeltaChangeSets returns a large initial change set, followed by the small ones, so the incremental snapshots
+// are not compacted.
+func mockDeltaChangeSets(n int) []ChangeSet {
+	initial := ChangeSet{}
+	for i := 0; i < 200; i++ {
+		initial.Pairs = append(initial.Pairs, &KVPair{Key: []byte(fmt.Sprintf("key-%03d", i)), Value: []byte("init")})
+	}
+	changeSets := []ChangeSet{initial}
+	for i := 0; i < n; i++ {
+		changeSets = append(changeSets, ChangeSet{Pairs: []*KVPair{
+			{Key: []byte(fmt.Sprintf("key-%03d", i*7)), Value: []byte(fmt.Sprintf("value-%d", i))},
+			{Key: []byte(fmt.Sprintf("key-%03d", i*11+1)), Delete: true},
+			{Key: []byte(fmt.Sprintf("new-%d", i)), Value: []byte("new")},
+		}})
+	}
+	return changeSets
+}
+
+func TestDeltaSnapshotRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	changeSets := mockDeltaChangeSets(4)
+
+	// setup the full base snapshot
+	tree := New(0)
+	tree.ApplyChangeSet(changeSets[0])
+	_, _, err := tree.SaveVersion(true)
+	require.NoError(t, err)
+	require.NoError(t, tree.WriteDeltaSnapshotWithContext(context.Background(), filepath.Join(dir, "full"), 2))
+
+	base, err := OpenSnapshot(filepath.Join(dir, "full"))
+	require.NoError(t, err)
+	require.False(t, base.IsDelta())
+
+	current := NewFromSnapshot(base, true, 0)
+	for i := 0; i < 3; i++ {
+		snapshotDir := filepath.Join(dir, strconv.Itoa(i))
+
+		changes := changeSets[i+1]
+		tree.ApplyChangeSet(changes)
+		_, _, err := tree.SaveVersion(true)
+		require.NoError(t, err)
+		current.ApplyChangeSet(changes)
+		_, _, err = current.SaveVersion(true)
+		require.NoError(t, err)
+		require.NoError(t, current.WriteDeltaSnapshotWithContext(context.Background(), snapshotDir, 2))
+
+		snapshot, err := OpenSnapshot(snapshotDir)
+		require.NoError(t, err)
+		// the third one is compacted into a full snapshot
+		require.Equal(t, i < 2, snapshot.IsDelta())
+
+		loaded := NewFromSnapshot(snapshot, true, 0)
+		require.Equal(t, tree.Version(), loaded.Version())
+		require.Equal(t, tree.RootHash(), loaded.RootHash())
+		expItems := collectIter(tree.Iterator(nil, nil, true))
+		require.Equal(t, expItems, collectIter(loaded.Iterator(nil, nil, true)))
+		for j, item := range expItems {
+			require.Equal(t, item.value, loaded.Get(item.key))
+			key, value := loaded.GetByIndex(int64(j))
+			require.Equal(t, item.key, key)
+			require.Equal(t, item.value, value)
+		}
+		require.Nil(t, loaded.Get([]byte("non-exist")))
+
+		// verify the hashes of the nodes stored in the snapshot
+		loaded.ScanPostOrder(func(node Node) bool {
+			require.Equal(t, node.Hash(), HashNode(node))
+			return true
+		})
+
+		// export the snapshot
+		require.Equal(t, collectExport(t, tree.Export()), collectExport(t, snapshot.Export()))
+
+		// modify the tree loaded from the snapshot
+		next := changeSets[i+2]
+		loaded.ApplyChangeSet(next)
+		hash, _, err := loaded.SaveVersion(true)
+		require.NoError(t, err)
+		expTree := tree.Copy(0)
+		expTree.ApplyChangeSet(next)
+		expHash, _, err := expTree.SaveVersion(true)
+		require.NoError(t, err)
+		require.Equal(t, expHash, hash)
+
+		// continue on the snapshot just written
+		require.NoError(t, current.Close())
+		current = NewFromSnapshot(snapshot, true, 0)
+	}
+	require.NoError(t, current.Close())
+}
+
+func TestDeltaSnapshotDisabled(t *testing.T) {
+	dir := t.TempDir()
+
+	tree := New(0)
+	tree.ApplyChangeSet(ChangeSets[0])
+	_, _, err := tree.SaveVersion(true)
+	require.NoError(t, err)
+	require.NoError(t, tree.WriteSnapshot(filepath.Join(dir, "full")))
+
+	snapshot, err := OpenSnapshot(filepath.Join(dir, "full"))
+	require.NoError(t, err)
+	tree = NewFromSnapshot(snapshot, true, 0)
+	tree.ApplyChangeSet(ChangeSets[1])
+	_, _, err = tree.SaveVersion(true)
+	require.NoError(t, err)
+	require.NoError(t, tree.WriteDeltaSnapshotWithContext(context.Background(), filepath.Join(dir, "next"), 0))
+
+	_, err = os.Stat(filepath.Join(dir, "next", FileNameDelta))
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, tree.Close())
+}
+
+func TestDeltaSnapshotPrune(t *testing.T) {
+	db, err := Load(t.TempDir(), Options{
+		CreateIfMissing:    true,
+		InitialStores:      []string{"test"},
+		SnapshotKeepRecent: 0,
+		MaxDeltaSnapshots:  100,
+	})
+	require.NoError(t, err)
+
+	tree := New(0)
+	for _, changes := range mockDeltaChangeSets(4) {
+		tree.ApplyChangeSet(changes)
+		_, _, err := tree.SaveVersion(true)
+		require.NoError(t, err)
+
+		require.NoError(t, db.ApplyChangeSets([]*NamedChangeSet{{Name: "test", Changeset: changes}}))
+		_, err = db.Commit()
+		require.NoError(t, err)
+
+		require.NoError(t, db.RewriteSnapshotBackground())
+		for db.snapshotRewriteChan != nil {
+			require.NoError(t, db.checkAsyncTasks())
+		}
+		require.Equal(t, tree.RootHash(), db.lastCommitInfo.StoreInfos[0].CommitId.Hash)
+	}
+
+	db.pruneSnapshotLock.Lock()
+	// the latest snapshot and the full snapshot it's based on
+	var versions []int64
+	require.NoError(t, traverseSnapshots(db.dir, true, func(version int64) (bool, error) {
+		versions = append(versions, version)
+		return false, nil
+	}))
+	require.Len(t, versions, 2)
+	bases, err := snapshotBases(filepath.Join(db.dir, snapshotName(versions[1])))
+	require.NoError(t, err)
+	require.Equal(t, versions[:1], bases)
+	db.pruneSnapshotLock.Unlock()
+
+	require.NoError(t, db.Close())
+
+	// reload the incremental snapshot
+	db, err = Load(db.dir, Options{ReadOnly: true})
+	require.NoError(t, err)
+	require.True(t, db.TreeByName("test").snapshot.IsDelta())
+	require.Equal(t, tree.RootHash(), db.TreeByName("test").RootHash())
+	require.NoError(t, db.Close())
+}
+
+func collectExport(t *testing.T, exporter *Exporter) []*ExportNode {
+	defer exporter.Close()
+
+	var nodes []*ExportNode
+	for {
+		node, err := exporter.Next()
+		if err == ErrorExportDone {
+			break
+		}
+		require.NoError(t, err)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
@@ -366,6 +366,12 @@ func (t *MultiTree) WriteSnapshot(dir string, wp *pond.WorkerPool) error {
 }
 
 func (t *MultiTree) WriteSnapshotWithContext(ctx context.Context, dir string, wp *pond.WorkerPool) error {
+	return t.WriteDeltaSnapshotWithContext(ctx, dir, wp, 0)
+}
+
+// WriteDeltaSnapshotWithContext writes the snapshot, the trees are written as incremental snapshots on top of the
+// full snapshots they are loaded from when possible, see `Tree.WriteDeltaSnapshotWithContext`.
+func (t *MultiTree) WriteDeltaSnapshotWithContext(ctx context.Context, dir string, wp *pond.WorkerPool, maxDeltas uint32) error {
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 		return err
 	}
@@ -376,7 +382,7 @@ func (t *MultiTree) WriteSnapshotWithContext(ctx context.Context, dir string, wp
 	for _, entry := range t.trees {
 		tree, name := entry.Tree, entry.Name
 		group.Submit(func() error {
-			return tree.WriteSnapshotWithContext(ctx, filepath.Join(dir, name))
+			return tree.WriteDeltaSnapshotWithContext(ctx, filepath.Join(dir, name), maxDeltas)
 		})
 	}
 
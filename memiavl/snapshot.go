@@ -49,7 +49,14 @@ type Snapshot struct {
 	leavesLayout Leaves
 
 	// nil means empty snapshot
-	root *PersistedNode
+	root Node
+
+	// the real path of the snapshot directory, used to reference it as the base of incremental snapshots.
+	dir string
+	// base is the full snapshot an incremental snapshot is layered on.
+	base *Snapshot
+	// deltaSeq is the number of incremental snapshots since the base snapshot.
+	deltaSeq uint32
 }
 
 func NewEmptySnapshot(version uint32) *Snapshot {
@@ -75,7 +82,7 @@ func OpenSnapshot(snapshotDir string) (snapshot *Snapshot, err error) {
 		return nil, fmt.Errorf("invalid metadata file magic: %d", magic)
 	}
 	format := binary.LittleEndian.Uint32(bz[4:])
-	if format != SnapshotFormat {
+	if format != SnapshotFormat && format != SnapshotFormatDelta {
 		return nil, fmt.Errorf("unknown snapshot format: %d", format)
 	}
 	version := binary.LittleEndian.Uint32(bz[8:])
@@ -111,6 +118,36 @@ func OpenSnapshot(snapshotDir string) (snapshot *Snapshot, err error) {
 	leaves := leavesMap.Data()
 	kvs := kvsMap.Data()
 
+	dir, err := filepath.EvalSymlinks(snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	if dir, err = filepath.Abs(dir); err != nil {
+		return nil, err
+	}
+
+	if format == SnapshotFormatDelta {
+		snapshot = &Snapshot{
+			nodesMap:  nodesMap,
+			leavesMap: leavesMap,
+			kvsMap:    kvsMap,
+
+			nodes:  nodes,
+			leaves: leaves,
+			kvs:    kvs,
+
+			version: version,
+			dir:     dir,
+		}
+		if snapshot.leavesLayout, err = NewLeaves(leaves); err != nil {
+			return nil, err
+		}
+		if err = openDeltaSnapshot(snapshotDir, snapshot); err != nil {
+			return nil, err
+		}
+		return snapshot, nil
+	}
+
 	// validate nodes length
 	if len(nodes)%SizeNode != 0 {
 		return nil, fmt.Errorf("corrupted snapshot, nodes file size %d is not a multiple of %d", len(nodes), SizeNode)
@@ -146,19 +183,20 @@ func OpenSnapshot(snapshotDir string) (snapshot *Snapshot, err error) {
 		kvs:    kvs,
 
 		version: version,
+		dir:     dir,
 
 		nodesLayout:  nodesData,
 		leavesLayout: leavesData,
 	}
 
 	if nodesLen > 0 {
-		snapshot.root = &PersistedNode{
+		snapshot.root = PersistedNode{
 			snapshot: snapshot,
 			isLeaf:   false,
 			index:    uint32(nodesLen - 1),
 		}
 	} else if leavesLen > 0 {
-		snapshot.root = &PersistedNode{
+		snapshot.root = PersistedNode{
 			snapshot: snapshot,
 			isLeaf:   true,
 			index:    0,
@@ -181,6 +219,9 @@ func (snapshot *Snapshot) Close() error {
 	if snapshot.kvsMap != nil {
 		errs = append(errs, snapshot.kvsMap.Close())
 	}
+	if snapshot.base != nil {
+		errs = append(errs, snapshot.base.Close())
+	}
 
 	// reset to an empty tree
 	*snapshot = *NewEmptySnapshot(snapshot.version)
@@ -215,19 +256,27 @@ func (snapshot *Snapshot) Version() uint32 {
 	return snapshot.version
 }
 
-// RootNode returns the root node
+// RootNode returns the root node of a full snapshot
 func (snapshot *Snapshot) RootNode() PersistedNode {
 	if snapshot.IsEmpty() {
 		panic("RootNode not supported on an empty snapshot")
 	}
-	return *snapshot.root
+	if snapshot.IsDelta() {
+		panic("RootNode not supported on an incremental snapshot")
+	}
+	return snapshot.root.(PersistedNode)
+}
+
+// Root returns the root node, nil for empty snapshot
+func (snapshot *Snapshot) Root() Node {
+	return snapshot.root
 }
 
 func (snapshot *Snapshot) RootHash() []byte {
 	if snapshot.IsEmpty() {
 		return emptyHash
 	}
-	return snapshot.RootNode().Hash()
+	return snapshot.root.Hash()
 }
 
 // nodesLen returns the number of nodes in the snapshot
@@ -240,8 +289,12 @@ func (snapshot *Snapshot) leavesLen() int {
 	return len(snapshot.leaves) / SizeLeaf
 }
 
-// ScanNodes iterate over the nodes in the snapshot order (depth-first post-order, leaf nodes before branch nodes)
+// ScanNodes iterate over the nodes in the snapshot order (depth-first post-order, leaf nodes before branch nodes),
+// it's not supported on incremental snapshots.
 func (snapshot *Snapshot) ScanNodes(callback func(node PersistedNode) error) error {
+	if snapshot.IsDelta() {
+		return errors.New("ScanNodes not supported on an incremental snapshot")
+	}
 	for i := 0; i < snapshot.leavesLen(); i++ {
 		if err := callback(snapshot.Leaf(uint32(i))); err != nil {
 			return err
@@ -291,8 +344,13 @@ func (snapshot *Snapshot) LeafKeyValue(index uint32) ([]byte, []byte) {
 	return key, snapshot.kvs[offset : offset+length]
 }
 
-// Export exports the nodes from snapshot file sequentially, more efficient than a post-order traversal.
+// Export exports the nodes from snapshot file sequentially, more efficient than a post-order traversal,
+// the incremental snapshots are exported with a post-order traversal.
 func (snapshot *Snapshot) Export() *Exporter {
+	if snapshot.IsDelta() {
+		tree := &Tree{version: snapshot.version, root: snapshot.root}
+		return tree.Export()
+	}
 	return newExporter(snapshot.export)
 }
 
@@ -369,6 +427,16 @@ func writeSnapshot(
 	ctx context.Context,
 	dir string, version uint32,
 	doWrite func(*snapshotWriter) (uint32, error),
+) error {
+	return writeSnapshotFiles(ctx, dir, version, SnapshotFormat, doWrite)
+}
+
+// writeSnapshotFiles writes the nodes, leaves, kvs and metadata files of the snapshot, `doWrite` returns the number
+// of nodes written.
+func writeSnapshotFiles(
+	ctx context.Context,
+	dir string, version, format uint32,
+	doWrite func(*snapshotWriter) (uint32, error),
 ) (returnErr error) {
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 		return err
@@ -413,12 +481,12 @@ func writeSnapshot(
 	kvsWriter := bufio.NewWriter(fpKVs)
 
 	w := newSnapshotWriter(ctx, nodesWriter, leavesWriter, kvsWriter)
-	leaves, err := doWrite(w)
+	written, err := doWrite(w)
 	if err != nil {
 		return err
 	}
 
-	if leaves > 0 {
+	if written > 0 {
 		if err := nodesWriter.Flush(); err != nil {
 			return err
 		}
@@ -443,7 +511,7 @@ func writeSnapshot(
 	// write metadata
 	var metadataBuf [SizeMetadata]byte
 	binary.LittleEndian.PutUint32(metadataBuf[:], SnapshotFileMagic)
-	binary.LittleEndian.PutUint32(metadataBuf[4:], SnapshotFormat)
+	binary.LittleEndian.PutUint32(metadataBuf[4:], format)
 	binary.LittleEndian.PutUint32(metadataBuf[8:], version)
 
 	metadataFile := filepath.Join(dir, FileNameMetadata)
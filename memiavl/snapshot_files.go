@@ -0,0 +1,308 @@
+package memiavl
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/alitto/pond"
+)
+
+// SnapshotFileChunkSize is the max size of the file chunks exported by `SnapshotFileExporter`.
+const SnapshotFileChunkSize = 4 * 1024 * 1024
+
+var (
+	// ErrDeltaSnapshotExport is returned if the snapshot contains incremental tree snapshots, which depend on the files
+	// of the base snapshots.
+	ErrDeltaSnapshotExport = errors.New("incremental snapshot can't be exported as raw files")
+	// ErrDeltaSnapshotImport is returned if the files received are the ones of an incremental tree snapshot.
+	ErrDeltaSnapshotImport = errors.New("incremental snapshot can't be imported from raw files")
+)
+
+// snapshotTreeFiles are the files of a full tree snapshot, the only ones allowed in the trees of the snapshots imported.
+var snapshotTreeFiles = map[string]struct{}{
+	FileNameNodes:    {},
+	FileNameLeaves:   {},
+	FileNameKVs:      {},
+	FileNameMetadata: {},
+}
+
+// SnapshotFileChunk is a chunk of a file in a multitree snapshot, the chunks of a file are exported in order.
+type SnapshotFileChunk struct {
+	// Path is the slash-separated path relative to the snapshot directory.
+	Path string
+	Data []byte
+}
+
+type snapshotFile struct {
+	path string
+	file *os.File
+}
+
+// SnapshotFileExporter exports the raw files of a multitree snapshot, so the receiver can install them directly
+// without rebuilding the trees.
+type SnapshotFileExporter struct {
+	// the files are opened upfront, so they are still readable if the snapshot is pruned during the export.
+	files []snapshotFile
+	// the file being exported
+	current int
+	// the first chunk of each file is always exported, so empty files are created on the receiver too.
+	exported bool
+}
+
+func NewSnapshotFileExporter(dir string, version uint32) (_ *SnapshotFileExporter, returnErr error) {
+	snapshotDir := filepath.Join(dir, snapshotName(int64(version)))
+	metadata, err := readMetadata(snapshotDir)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot don't exists: height: %d, %w", version, err)
+	}
+	if metadata.CommitInfo == nil {
+		return nil, fmt.Errorf("commit info not found in snapshot: height: %d", version)
+	}
+
+	exporter := &SnapshotFileExporter{}
+	defer func() {
+		if returnErr != nil {
+			returnErr = errors.Join(returnErr, exporter.Close())
+		}
+	}()
+
+	for _, info := range metadata.CommitInfo.StoreInfos {
+		entries, err := os.ReadDir(filepath.Join(snapshotDir, info.Name))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.Name() == FileNameDelta {
+				return nil, ErrDeltaSnapshotExport
+			}
+			if err := exporter.open(snapshotDir, info.Name+"/"+entry.Name()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// the metadata file is the last one, the receiver verifies the trees against it.
+	if err := exporter.open(snapshotDir, MetadataFileName); err != nil {
+		return nil, err
+	}
+	return exporter, nil
+}
+
+func (sfe *SnapshotFileExporter) open(snapshotDir, path string) error {
+	file, err := os.Open(filepath.Join(snapshotDir, filepath.FromSlash(path)))
+	if err != nil {
+		return err
+	}
+	sfe.files = append(sfe.files, snapshotFile{path: path, file: file})
+	return nil
+}
+
+// Next returns the next `*SnapshotFileChunk`, returns `ErrorExportDone` when all the files are exported.
+func (sfe *SnapshotFileExporter) Next() (*SnapshotFileChunk, error) {
+	for sfe.current < len(sfe.files) {
+		f := sfe.files[sfe.current]
+		buf := make([]byte, SnapshotFileChunkSize)
+		n, err := io.ReadFull(f.file, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		if n == 0 && sfe.exported {
+			sfe.current++
+			sfe.exported = false
+			continue
+		}
+		sfe.exported = true
+		if n < len(buf) {
+			// reach the end of the file
+			sfe.current++
+			sfe.exported = false
+		}
+		return &SnapshotFileChunk{Path: f.path, Data: buf[:n]}, nil
+	}
+	return nil, ErrorExportDone
+}
+
+func (sfe *SnapshotFileExporter) Close() error {
+	var errs []error
+	for _, f := range sfe.files {
+		errs = append(errs, f.file.Close())
+	}
+	sfe.files = nil
+	return errors.Join(errs...)
+}
+
+// SnapshotFileImporter installs the multitree snapshot exported by `SnapshotFileExporter`, the trees are verified
+// against the commit info in the snapshot before it's installed as the current snapshot.
+type SnapshotFileImporter struct {
+	dir         string
+	snapshotDir string
+	height      int64
+	fileLock    FileLock
+
+	// the file being written
+	path string
+	file *os.File
+	// the files written, a file can't be written again after switched to another file.
+	written map[string]struct{}
+}
+
+func NewSnapshotFileImporter(dir string, height uint64) (*SnapshotFileImporter, error) {
+	if height > math.MaxUint32 {
+		return nil, fmt.Errorf("version overflows uint32: %d", height)
+	}
+
+	fileLock, err := LockFile(filepath.Join(dir, LockFileName))
+	if err != nil {
+		return nil, fmt.Errorf("fail to lock db: %w", err)
+	}
+
+	importer := &SnapshotFileImporter{
+		dir:         dir,
+		height:      int64(height),
+		snapshotDir: snapshotName(int64(height)),
+		fileLock:    fileLock,
+		written:     make(map[string]struct{}),
+	}
+	// cleanup the leftover of an interrupted restoration
+	if err := os.RemoveAll(importer.tmpDir()); err != nil {
+		return nil, errors.Join(err, importer.Close())
+	}
+	return importer, nil
+}
+
+func (sfi *SnapshotFileImporter) tmpDir() string {
+	return filepath.Join(sfi.dir, sfi.snapshotDir+TmpSuffix)
+}
+
+// Add writes the chunk to the end of the file.
+func (sfi *SnapshotFileImporter) Add(chunk *SnapshotFileChunk) error {
+	if chunk.Path != sfi.path {
+		if err := sfi.closeFile(); err != nil {
+			return err
+		}
+		if err := sfi.openFile(chunk.Path); err != nil {
+			return err
+		}
+	}
+	_, err := sfi.file.Write(chunk.Data)
+	return err
+}
+
+func (sfi *SnapshotFileImporter) openFile(path string) error {
+	// only the metadata file and the files of the trees are allowed
+	parts := strings.Split(path, "/")
+	if len(parts) > 2 || !filepath.IsLocal(filepath.FromSlash(path)) {
+		return fmt.Errorf("invalid snapshot file path: %s", path)
+	}
+	if len(parts) == 1 && path != MetadataFileName {
+		return fmt.Errorf("unexpected snapshot file: %s", path)
+	}
+	if len(parts) == 2 {
+		if parts[1] == FileNameDelta {
+			return fmt.Errorf("%w: %s", ErrDeltaSnapshotImport, path)
+		}
+		if _, ok := snapshotTreeFiles[parts[1]]; !ok {
+			return fmt.Errorf("unexpected snapshot file: %s", path)
+		}
+	}
+	if _, ok := sfi.written[path]; ok {
+		return fmt.Errorf("snapshot file is not exported continuously: %s", path)
+	}
+
+	name := filepath.Join(sfi.tmpDir(), filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(name), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	sfi.path = path
+	sfi.file = file
+	sfi.written[path] = struct{}{}
+	return nil
+}
+
+func (sfi *SnapshotFileImporter) closeFile() error {
+	if sfi.file == nil {
+		return nil
+	}
+	err := sfi.file.Sync()
+	err = errors.Join(err, sfi.file.Close())
+	sfi.file = nil
+	sfi.path = ""
+	return err
+}
+
+// Finalize verifies the snapshot received and installs it as the current snapshot.
+func (sfi *SnapshotFileImporter) Finalize(ctx context.Context) error {
+	if err := sfi.closeFile(); err != nil {
+		return err
+	}
+
+	tmpDir := sfi.tmpDir()
+	if err := checkFullSnapshots(tmpDir); err != nil {
+		return err
+	}
+	pool := pond.New(runtime.NumCPU(), runtime.NumCPU()*10)
+	commitInfo, err := verifySnapshot(ctx, tmpDir, sfi.height, pool)
+	pool.StopAndWait()
+	if err != nil {
+		return err
+	}
+
+	// initial version should correspond to the first wal entry
+	metadata := MultiTreeMetadata{
+		CommitInfo:     commitInfo,
+		InitialVersion: sfi.height + 1,
+	}
+	bz, err := metadata.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := WriteFileSync(filepath.Join(tmpDir, MetadataFileName), bz); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpDir, filepath.Join(sfi.dir, sfi.snapshotDir)); err != nil {
+		return err
+	}
+
+	return updateCurrentSymlink(sfi.dir, sfi.snapshotDir)
+}
+
+// checkFullSnapshots checks the tree snapshots are full snapshots, the metadata of an incremental snapshot is
+// rejected even without its base reference.
+func checkFullSnapshots(dir string) error {
+	names, err := treeNames(dir)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		bz, err := os.ReadFile(filepath.Join(dir, name, FileNameMetadata))
+		if err != nil {
+			return err
+		}
+		if len(bz) != SizeMetadata {
+			return fmt.Errorf("wrong metadata file size of tree %s, expected: %d, found: %d", name, SizeMetadata, len(bz))
+		}
+		if format := binary.LittleEndian.Uint32(bz[4:]); format != SnapshotFormat {
+			if format == SnapshotFormatDelta {
+				return fmt.Errorf("%w: tree %s", ErrDeltaSnapshotImport, name)
+			}
+			return fmt.Errorf("unknown snapshot format of tree %s: %d", name, format)
+		}
+	}
+	return nil
+}
+
+func (sfi *SnapshotFileImporter) Close() error {
+	return errors.Join(sfi.closeFile(), sfi.fileLock.Unlock(), sfi.fileLock.Destroy())
+}
@@ -0,0 +1,137 @@
+package memiavl
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupSnapshotFilesDB(t *testing.T, opts Options) *DB {
+	opts.CreateIfMissing = true
+	opts.InitialStores = []string{"test", "test2"}
+	opts.AsyncCommitBuffer = -1
+	db, err := Load(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	for _, changes := range ChangeSets {
+		require.NoError(t, db.ApplyChangeSets([]*NamedChangeSet{
+			{Name: "test", Changeset: changes},
+			{Name: "test2", Changeset: changes},
+		}))
+		_, err := db.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.RewriteSnapshot())
+	require.NoError(t, db.Reload())
+	return db
+}
+
+func exportSnapshotFiles(t *testing.T, db *DB) []*SnapshotFileChunk {
+	exporter, err := NewSnapshotFileExporter(db.dir, uint32(db.Version()))
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	var chunks []*SnapshotFileChunk
+	for {
+		chunk, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		}
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func importSnapshotFiles(t *testing.T, dir string, version int64, chunks []*SnapshotFileChunk) error {
+	importer, err := NewSnapshotFileImporter(dir, uint64(version))
+	require.NoError(t, err)
+	defer importer.Close()
+
+	for _, chunk := range chunks {
+		if err := importer.Add(chunk); err != nil {
+			return err
+		}
+	}
+	return importer.Finalize(context.Background())
+}
+
+func TestSnapshotFilesRoundTrip(t *testing.T) {
+	db := setupSnapshotFilesDB(t, Options{})
+	defer db.Close()
+
+	chunks := exportSnapshotFiles(t, db)
+	require.Equal(t, MetadataFileName, chunks[len(chunks)-1].Path)
+
+	restoreDir := t.TempDir()
+	require.NoError(t, importSnapshotFiles(t, restoreDir, db.Version(), chunks))
+
+	db2, err := Load(restoreDir, Options{})
+	require.NoError(t, err)
+	require.Equal(t, db.LastCommitInfo(), db2.LastCommitInfo())
+
+	// the imported db function normally
+	_, err = db2.Commit()
+	require.NoError(t, err)
+	require.NoError(t, db2.Close())
+}
+
+func TestSnapshotFilesCorrupted(t *testing.T) {
+	db := setupSnapshotFilesDB(t, Options{})
+	defer db.Close()
+
+	chunks := exportSnapshotFiles(t, db)
+	for _, chunk := range chunks {
+		if chunk.Path == "test2/"+FileNameKVs {
+			chunk.Data[len(chunk.Data)-1] ^= 0xff
+		}
+	}
+
+	err := importSnapshotFiles(t, t.TempDir(), db.Version(), chunks)
+	var verifyErr *VerifyError
+	require.True(t, errors.As(err, &verifyErr))
+	require.Equal(t, "test2", verifyErr.Store)
+
+	// invalid paths are rejected
+	for _, path := range []string{"../test/kvs", "/test/kvs", "test/kvs/extra", "unknown", "test/unknown", "test/" + LockFileName} {
+		err := importSnapshotFiles(t, t.TempDir(), db.Version(), []*SnapshotFileChunk{{Path: path}})
+		require.Error(t, err, path)
+	}
+}
+
+func TestSnapshotFilesDelta(t *testing.T) {
+	db := setupSnapshotFilesDB(t, Options{MaxDeltaSnapshots: 100})
+	defer db.Close()
+
+	require.NoError(t, db.ApplyChangeSets([]*NamedChangeSet{{Name: "test", Changeset: ChangeSets[0]}}))
+	_, err := db.Commit()
+	require.NoError(t, err)
+	require.NoError(t, db.RewriteSnapshot())
+	require.NoError(t, db.Reload())
+	require.True(t, db.TreeByName("test").snapshot.IsDelta())
+
+	_, err = NewSnapshotFileExporter(db.dir, uint32(db.Version()))
+	require.ErrorIs(t, err, ErrDeltaSnapshotExport)
+}
+
+func TestSnapshotFilesImportDelta(t *testing.T) {
+	db := setupSnapshotFilesDB(t, Options{})
+	defer db.Close()
+
+	// the files of an incremental snapshot are rejected
+	chunks := exportSnapshotFiles(t, db)
+	err := importSnapshotFiles(t, t.TempDir(), db.Version(), append(chunks[:1:1], &SnapshotFileChunk{Path: "test/" + FileNameDelta}))
+	require.ErrorIs(t, err, ErrDeltaSnapshotImport)
+
+	// the metadata of an incremental snapshot is rejected without its base reference too
+	for _, chunk := range chunks {
+		if chunk.Path == "test/"+FileNameMetadata {
+			binary.LittleEndian.PutUint32(chunk.Data[4:], SnapshotFormatDelta)
+		}
+	}
+	err = importSnapshotFiles(t, t.TempDir(), db.Version(), chunks)
+	require.ErrorIs(t, err, ErrDeltaSnapshotImport)
+}
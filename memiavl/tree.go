@@ -78,7 +78,7 @@ func NewFromSnapshot(snapshot *Snapshot, zeroCopy bool, cacheSize int) *Tree {
 	}
 
 	if !snapshot.IsEmpty() {
-		tree.root = snapshot.RootNode()
+		tree.root = snapshot.Root()
 	}
 
 	return tree
@@ -270,7 +270,7 @@ type stackEntry struct {
 
 // Export returns a snapshot of the tree which won't be corrupted by further modifications on the main tree.
 func (t *Tree) Export() *Exporter {
-	if t.snapshot != nil && t.version == t.snapshot.Version() {
+	if t.snapshot != nil && t.version == t.snapshot.Version() && !t.snapshot.IsDelta() {
 		// snapshot export algorithm is more efficient
 		return t.snapshot.Export()
 	}
@@ -0,0 +1,331 @@
+package memiavl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+
+	"github.com/alitto/pond"
+	"github.com/tidwall/wal"
+)
+
+// VerifyError reports the first corrupted version and store found by `Verify`.
+type VerifyError struct {
+	Version int64
+	// Store is empty if the error is not specific to a store.
+	Store string
+	Err   error
+}
+
+func (e *VerifyError) Error() string {
+	if e.Store == "" {
+		return fmt.Sprintf("version %d: %s", e.Version, e.Err)
+	}
+	return fmt.Sprintf("version %d, store %s: %s", e.Version, e.Store, e.Err)
+}
+
+func (e *VerifyError) Unwrap() error {
+	return e.Err
+}
+
+type VerifyOptions struct {
+	Logger Logger
+	// Concurrency defines the number of stores verified in parallel, default to the number of CPUs.
+	Concurrency int
+	// SkipWAL skips replaying the WAL, only the snapshots are verified.
+	SkipWAL bool
+}
+
+// Verify checks the integrity of the memiavl db in `dir`:
+//   - recompute the node hashes of each snapshot bottom-up, and check the root hashes and versions against the
+//     commit info stored in the snapshot.
+//   - replay the WAL on top of the earliest snapshot to the latest version, and compare the resulting commit info
+//     with the ones of the later snapshots.
+//
+// It don't take the file lock, so it can run against the directory of a live node, it returns the latest version
+// verified, and a `*VerifyError` for the first corruption found.
+func Verify(ctx context.Context, dir string, opts VerifyOptions) (int64, error) {
+	if opts.Logger == nil {
+		opts.Logger = NewNopLogger()
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = runtime.NumCPU()
+	}
+
+	var versions []int64
+	if err := traverseSnapshots(dir, true, func(version int64) (bool, error) {
+		versions = append(versions, version)
+		return false, nil
+	}); err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return 0, errors.New("no snapshot found")
+	}
+
+	pool := pond.New(opts.Concurrency, opts.Concurrency*10)
+	defer pool.StopAndWait()
+
+	commitInfos := make(map[int64]*CommitInfo, len(versions))
+	for _, version := range versions {
+		opts.Logger.Info("verify snapshot", "version", version)
+		commitInfo, err := verifySnapshot(ctx, filepath.Join(dir, snapshotName(version)), version, pool)
+		if err != nil {
+			return 0, err
+		}
+		commitInfos[commitInfo.Version] = commitInfo
+	}
+
+	latest := versions[len(versions)-1]
+	if opts.SkipWAL {
+		return latest, nil
+	}
+
+	return replayWALForVerify(ctx, dir, versions[0], commitInfos, opts.Logger)
+}
+
+// verifySnapshot verifies the stores of a multitree snapshot at the version in parallel, returns the commit info
+// stored in it.
+func verifySnapshot(ctx context.Context, dir string, version int64, pool *pond.WorkerPool) (*CommitInfo, error) {
+	metadata, err := readMetadata(dir)
+	if err != nil {
+		return nil, err
+	}
+	commitInfo := metadata.CommitInfo
+	if commitInfo == nil {
+		return nil, fmt.Errorf("commit info not found in snapshot %s", dir)
+	}
+
+	if version != commitInfo.Version {
+		return nil, &VerifyError{
+			Version: version,
+			Err:     fmt.Errorf("commit info version mismatch: %d", commitInfo.Version),
+		}
+	}
+
+	names, err := treeNames(dir)
+	if err != nil {
+		return nil, err
+	}
+	storeNames := make([]string, len(commitInfo.StoreInfos))
+	for i, info := range commitInfo.StoreInfos {
+		storeNames[i] = info.Name
+	}
+	slices.Sort(storeNames)
+	if !slices.Equal(names, storeNames) {
+		return nil, &VerifyError{
+			Version: version,
+			Err:     fmt.Errorf("stores mismatch with commit info, found: %v, expected: %v", names, storeNames),
+		}
+	}
+
+	group, ctx := pool.GroupContext(ctx)
+	errs := make([]error, len(commitInfo.StoreInfos))
+	for i, info := range commitInfo.StoreInfos {
+		group.Submit(func() error {
+			if err := verifyTreeSnapshot(ctx, filepath.Join(dir, info.Name), info.CommitId); err != nil {
+				errs[i] = &VerifyError{Version: version, Store: info.Name, Err: err}
+				return errs[i]
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		// report the first bad store in order
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	return commitInfo, nil
+}
+
+func verifyTreeSnapshot(ctx context.Context, dir string, commitID CommitID) error {
+	snapshot, err := OpenSnapshot(dir)
+	if err != nil {
+		return err
+	}
+	defer snapshot.Close()
+
+	if int64(snapshot.Version()) != commitID.Version {
+		return fmt.Errorf("snapshot version mismatch, expected: %d, found: %d", commitID.Version, snapshot.Version())
+	}
+	if err := snapshot.VerifyHashes(ctx); err != nil {
+		return err
+	}
+	if rootHash := snapshot.RootHash(); !bytes.Equal(rootHash, commitID.Hash) {
+		return fmt.Errorf("root hash mismatch, expected: %X, found: %X", commitID.Hash, rootHash)
+	}
+	return nil
+}
+
+// VerifyHashes recomputes the hashes of the nodes stored in the snapshot files bottom-up, the nodes of the base
+// snapshot are not included for incremental snapshots.
+func (snapshot *Snapshot) VerifyHashes(ctx context.Context) error {
+	check := func(i int, node Node) error {
+		if i%CancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if !VerifyHash(node) {
+			kind := "branch"
+			if node.IsLeaf() {
+				kind = "leaf"
+			}
+			return fmt.Errorf("%s node hash mismatch, version: %d, key: %X", kind, node.Version(), node.Key())
+		}
+		return nil
+	}
+
+	// the leaves are verified before the branch nodes, the branch nodes are written in post-order.
+	for i := 0; i < snapshot.leavesLen(); i++ {
+		if err := check(i, snapshot.Leaf(uint32(i))); err != nil {
+			return err
+		}
+	}
+
+	if snapshot.IsDelta() {
+		for i := 0; i < len(snapshot.nodes)/SizeDeltaNode; i++ {
+			if err := check(i, deltaNode{snapshot: snapshot, index: uint32(i)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := 0; i < snapshot.nodesLen(); i++ {
+		if err := check(i, snapshot.Node(uint32(i))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayWALForVerify replays the WAL on top of the snapshot to the latest version, and compare the commit info at
+// the versions of the later snapshots.
+func replayWALForVerify(
+	ctx context.Context, dir string, snapshotVersion int64, commitInfos map[int64]*CommitInfo, logger Logger,
+) (int64, error) {
+	mtree, err := LoadMultiTree(filepath.Join(dir, snapshotName(snapshotVersion)), true, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer mtree.Close()
+
+	// open the WAL directly, it's not safe to truncate the corrupted tail of a live node.
+	log, err := wal.Open(walPath(dir), &wal.Options{NoCopy: true, NoSync: true})
+	if err != nil {
+		return 0, fmt.Errorf("fail to open wal: %w", err)
+	}
+	defer log.Close()
+
+	firstIndex, err := log.FirstIndex()
+	if err != nil {
+		return 0, err
+	}
+	lastIndex, err := log.LastIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	startIndex := walIndex(nextVersion(mtree.Version(), mtree.initialVersion), mtree.initialVersion)
+	if lastIndex < startIndex {
+		// nothing to replay
+		return mtree.Version(), nil
+	}
+	if firstIndex > startIndex {
+		return 0, &VerifyError{
+			Version: walVersion(startIndex, mtree.initialVersion),
+			Err:     fmt.Errorf("wal is pruned, first version: %d", walVersion(firstIndex, mtree.initialVersion)),
+		}
+	}
+
+	logger.Info("replay wal", "from", walVersion(startIndex, mtree.initialVersion), "to", walVersion(lastIndex, mtree.initialVersion))
+	for i := startIndex; i <= lastIndex; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		version := walVersion(i, mtree.initialVersion)
+		bz, err := log.Read(i)
+		if err != nil {
+			return 0, &VerifyError{Version: version, Err: fmt.Errorf("read wal failed: %w", err)}
+		}
+		var entry WALEntry
+		if err := entry.Unmarshal(bz); err != nil {
+			return 0, &VerifyError{Version: version, Err: fmt.Errorf("unmarshal wal failed: %w", err)}
+		}
+		if err := mtree.applyWALEntry(entry); err != nil {
+			return 0, &VerifyError{Version: version, Err: fmt.Errorf("replay wal failed: %w", err)}
+		}
+		if _, err := mtree.SaveVersion(true); err != nil {
+			return 0, &VerifyError{Version: version, Err: err}
+		}
+
+		if expected, ok := commitInfos[version]; ok {
+			if err := compareCommitInfo(expected, mtree.LastCommitInfo()); err != nil {
+				return 0, err
+			}
+			logger.Info("verified wal against snapshot", "version", version)
+		}
+	}
+
+	return mtree.Version(), nil
+}
+
+// compareCommitInfo returns a `*VerifyError` on the first store that mismatches.
+func compareCommitInfo(expected, actual *CommitInfo) error {
+	if len(expected.StoreInfos) != len(actual.StoreInfos) {
+		return &VerifyError{
+			Version: expected.Version,
+			Err:     fmt.Errorf("number of stores mismatch, expected: %d, found: %d", len(expected.StoreInfos), len(actual.StoreInfos)),
+		}
+	}
+
+	actualInfos := make(map[string]CommitID, len(actual.StoreInfos))
+	for _, info := range actual.StoreInfos {
+		actualInfos[info.Name] = info.CommitId
+	}
+	for _, info := range expected.StoreInfos {
+		commitID, ok := actualInfos[info.Name]
+		if !ok {
+			return &VerifyError{Version: expected.Version, Store: info.Name, Err: errors.New("store not found in wal replay")}
+		}
+		if commitID.Version != info.CommitId.Version || !bytes.Equal(commitID.Hash, info.CommitId.Hash) {
+			return &VerifyError{
+				Version: expected.Version,
+				Store:   info.Name,
+				Err: fmt.Errorf(
+					"wal replay result mismatch with snapshot, expected: %d/%X, found: %d/%X",
+					info.CommitId.Version, info.CommitId.Hash, commitID.Version, commitID.Hash,
+				),
+			}
+		}
+	}
+	return nil
+}
+
+// treeNames returns the sorted names of the trees in the multitree snapshot directory.
+func treeNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,113 @@
+package memiavl
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/wal"
+)
+
+func setupVerifyDB(t *testing.T, opts Options) string {
+	dir := t.TempDir()
+	opts.CreateIfMissing = true
+	opts.InitialStores = []string{"test", "test2"}
+	db, err := Load(dir, opts)
+	require.NoError(t, err)
+
+	for i, changes := range ChangeSets {
+		require.NoError(t, db.ApplyChangeSets([]*NamedChangeSet{
+			{Name: "test", Changeset: changes},
+			{Name: "test2", Changeset: changes},
+		}))
+		_, err := db.Commit()
+		require.NoError(t, err)
+		if i%3 == 1 {
+			require.NoError(t, db.RewriteSnapshot())
+			require.NoError(t, db.Reload())
+		}
+	}
+	require.NoError(t, db.Close())
+	return dir
+}
+
+func TestVerify(t *testing.T) {
+	for _, maxDeltas := range []uint32{0, 100} {
+		dir := setupVerifyDB(t, Options{MaxDeltaSnapshots: maxDeltas})
+		version, err := Verify(context.Background(), dir, VerifyOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(len(ChangeSets)), version)
+	}
+}
+
+func TestVerifyCorruptedSnapshot(t *testing.T) {
+	dir := setupVerifyDB(t, Options{})
+
+	// flip a byte of the values stored in the latest snapshot
+	version, err := currentVersion(dir)
+	require.NoError(t, err)
+	kvsFile := filepath.Join(dir, snapshotName(version), "test2", FileNameKVs)
+	bz, err := os.ReadFile(kvsFile)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(kvsFile, bz, 0o600))
+
+	_, err = Verify(context.Background(), dir, VerifyOptions{})
+	var verifyErr *VerifyError
+	require.True(t, errors.As(err, &verifyErr))
+	require.Equal(t, version, verifyErr.Version)
+	require.Equal(t, "test2", verifyErr.Store)
+}
+
+func TestVerifyCorruptedWAL(t *testing.T) {
+	dir := setupVerifyDB(t, Options{SnapshotKeepRecent: 10})
+
+	var versions []int64
+	require.NoError(t, traverseSnapshots(dir, true, func(version int64) (bool, error) {
+		versions = append(versions, version)
+		return false, nil
+	}))
+	require.Greater(t, len(versions), 2)
+	base, expected := versions[len(versions)-2], versions[len(versions)-1]
+
+	// rewrite the wal entry following the second to last snapshot with different changes
+	log, err := wal.Open(walPath(dir), nil)
+	require.NoError(t, err)
+	index := walIndex(base+1, 0)
+	bz, err := log.Read(index)
+	require.NoError(t, err)
+	var entry WALEntry
+	require.NoError(t, entry.Unmarshal(bz))
+	entry.Changesets[1].Changeset.Pairs = append(entry.Changesets[1].Changeset.Pairs, &KVPair{
+		Key: []byte("corrupted"), Value: []byte("corrupted"),
+	})
+
+	var entries [][]byte
+	lastIndex, err := log.LastIndex()
+	require.NoError(t, err)
+	for i := index + 1; i <= lastIndex; i++ {
+		bz, err := log.Read(i)
+		require.NoError(t, err)
+		entries = append(entries, bz)
+	}
+	require.NoError(t, log.TruncateBack(index-1))
+	bz, err = entry.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, log.Write(index, bz))
+	for i, bz := range entries {
+		require.NoError(t, log.Write(index+1+uint64(i), bz))
+	}
+	require.NoError(t, log.Close())
+
+	_, err = Verify(context.Background(), dir, VerifyOptions{SkipWAL: true})
+	require.NoError(t, err)
+
+	_, err = Verify(context.Background(), dir, VerifyOptions{})
+	var verifyErr *VerifyError
+	require.True(t, errors.As(err, &verifyErr))
+	require.Equal(t, expected, verifyErr.Version)
+	require.Equal(t, entry.Changesets[1].Name, verifyErr.Store)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/loka-network/loka/v1/rpc/backend"
+	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/admin"
 	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/debug"
 	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/eth"
 	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/eth/filters"
@@ -32,6 +33,8 @@ import (
 	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/personal"
 	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/txpool"
 	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/web3"
+	"github.com/loka-network/loka/v1/rpc/namespaces/versiondb"
+	"github.com/loka-network/loka/v1/rpc/stream"
 	"github.com/loka-network/loka/v1/types"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
@@ -52,6 +55,10 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	AdminNamespace    = "admin"
+
+	// VersionDBNamespace is only available when versiondb is enabled, see `RegisterVersionDBAPI`.
+	VersionDBNamespace = "versiondb"
 
 	apiVersion = "1.0"
 )
@@ -61,6 +68,7 @@ type APICreator = func(
 	ctx *server.Context,
 	clientCtx client.Context,
 	tendermintWebsocketClient *rpcclient.WSClient,
+	rpcStream *stream.RPCStream,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
 ) []rpc.API
@@ -73,6 +81,7 @@ func init() {
 		EthNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			tmWSClient *rpcclient.WSClient,
+			rpcStream *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
 		) []rpc.API {
@@ -87,12 +96,12 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend),
+					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, rpcStream, evmBackend),
 					Public:    true,
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, *stream.RPCStream, bool, types.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -102,7 +111,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ *stream.RPCStream, _ bool, _ types.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -115,6 +124,7 @@ func init() {
 		PersonalNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
+			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
 		) []rpc.API {
@@ -128,7 +138,7 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ *stream.RPCStream, _ bool, _ types.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -141,6 +151,7 @@ func init() {
 		DebugNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
+			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
 		) []rpc.API {
@@ -157,6 +168,7 @@ func init() {
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
+			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
 		) []rpc.API {
@@ -177,6 +189,7 @@ func init() {
 func GetRPCAPIs(ctx *server.Context,
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
+	rpcStream *stream.RPCStream,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
 	selectedAPIs []string,
@@ -184,8 +197,12 @@ func GetRPCAPIs(ctx *server.Context,
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
+		if ns == AdminNamespace {
+			ctx.Logger.Error("admin namespace is only served on the IPC endpoint", "namespace", ns)
+			continue
+		}
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, rpcStream, allowUnprotectedTxs, indexer)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -194,6 +211,23 @@ func GetRPCAPIs(ctx *server.Context,
 	return apis
 }
 
+// GetAdminAPIs returns the admin APIs, they're private and must only be served on the
+// IPC endpoint. peers may be nil if the node isn't running in process.
+func GetAdminAPIs(ctx *server.Context,
+	clientCtx client.Context,
+	peers admin.PeerSwitch,
+	wsSrv WebsocketsServer,
+) []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: AdminNamespace,
+			Version:   apiVersion,
+			Service:   admin.NewPrivateAPI(ctx, clientCtx, peers, wsSrv),
+			Public:    false,
+		},
+	}
+}
+
 // RegisterAPINamespace registers a new API namespace with the API creator.
 // This function fails if the namespace is already registered.
 func RegisterAPINamespace(ns string, creator APICreator) error {
@@ -203,3 +237,24 @@ func RegisterAPINamespace(ns string, creator APICreator) error {
 	apiCreators[ns] = creator
 	return nil
 }
+
+// RegisterVersionDBAPI registers the versiondb namespace, which serves the history queries on the versiondb of the
+// node, it must be called before the JSON-RPC server starts.
+func RegisterVersionDBAPI(store versiondb.Store) error {
+	return RegisterAPINamespace(VersionDBNamespace, func(ctx *server.Context,
+		_ client.Context,
+		_ *rpcclient.WSClient,
+		_ *stream.RPCStream,
+		_ bool,
+		_ types.EVMTxIndexer,
+	) []rpc.API {
+		return []rpc.API{
+			{
+				Namespace: VersionDBNamespace,
+				Version:   apiVersion,
+				Service:   versiondb.NewAPI(ctx.Logger, store),
+				Public:    true,
+			},
+		}
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -423,3 +424,27 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 
 	return (*hexutil.Big)(result), nil
 }
+
+// GasPriceByDenom returns the current gas price in the given fee denomination, converted from the
+// gas price in evm denom at the conversion rate of the alternative fee denomination.
+func (b *Backend) GasPriceByDenom(denom string) (*hexutil.Big, error) {
+	gasPrice, err := b.GasPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if denom == res.Params.EvmDenom {
+		return gasPrice, nil
+	}
+
+	feeDenom, found := res.Params.GetFeeDenom(denom)
+	if !found {
+		return nil, fmt.Errorf("%s is not a fee denom", denom)
+	}
+	price := feeDenom.Rate.MulInt(sdkmath.NewIntFromBigInt(gasPrice.ToInt())).Ceil().TruncateInt()
+	return (*hexutil.Big)(price.BigInt()), nil
+}
@@ -267,6 +267,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
+	if payer, ok := feePayer(tx); ok {
+		receipt["feePayer"] = payer
+	}
+
 	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
@@ -280,6 +284,16 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	return receipt, nil
 }
 
+// feePayer returns the fee granter of the cosmos tx, which pays the fees of the sponsored Ethereum
+// txs it wraps.
+func feePayer(tx sdk.Tx) (common.Address, bool) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(feeTx.FeeGranter()), true
+}
+
 // GetTransactionLogs returns the transaction logs identified by hash.
 func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error) {
 	hexTx := hash.Hex()
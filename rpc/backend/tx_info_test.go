@@ -3,6 +3,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"testing"
 
 	tmlog "cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -12,11 +13,14 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/loka-network/loka/v1/encoding"
 	"github.com/loka-network/loka/v1/indexer"
 	"github.com/loka-network/loka/v1/rpc/backend/mocks"
 	rpctypes "github.com/loka-network/loka/v1/rpc/types"
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
 	evmostypes "github.com/loka-network/loka/v1/types"
 	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -668,3 +672,25 @@ func (suite *BackendTestSuite) TestGetGasUsed() {
 		})
 	}
 }
+
+func TestFeePayer(t *testing.T) {
+	granter := utiltx.GenerateAddress()
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(9000),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+		Amount:   big.NewInt(0),
+		To:       &granter,
+	})
+
+	builder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+	tx, err := msg.BuildTx(builder, evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+	_, ok := feePayer(tx)
+	require.False(t, ok)
+
+	builder.SetFeeGranter(granter.Bytes())
+	payer, ok := feePayer(builder.GetTx())
+	require.True(t, ok)
+	require.Equal(t, granter, payer)
+}
@@ -0,0 +1,1163 @@
+// Package graphql implements the EIP-1767 GraphQL interface on top of the json-rpc backend.
+// The resolvers mirror go-ethereum's, so that tooling written against geth's endpoint works
+// unchanged.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/loka-network/loka/v1/rpc/backend"
+	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/loka-network/loka/v1/rpc/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+var errBlockNotFound = errors.New("block not found")
+
+// Backend is the json-rpc backend the resolvers are built on.
+type Backend interface {
+	backend.EVMBackend
+	filters.Backend
+}
+
+// Long is a 64 bit integer accepted as a GraphQL input, either as a number or a decimal string.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// BlockNumberArgs are the arguments of the fields that take an optional block number.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// NumberOrLatest returns the requested block, or the latest one if none was given.
+func (a BlockNumberArgs) NumberOrLatest() rpctypes.BlockNumberOrHash {
+	blockNr := rpctypes.EthLatestBlockNumber
+	if a.Block != nil {
+		blockNr = rpctypes.BlockNumber(*a.Block)
+	}
+	return rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}
+}
+
+// Account represents an Ethereum account at a particular block.
+type Account struct {
+	r             *Resolver
+	address       common.Address
+	blockNrOrHash rpctypes.BlockNumberOrHash
+}
+
+func (a *Account) Address() common.Address {
+	return a.address
+}
+
+func (a *Account) Balance() (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount() (hexutil.Uint64, error) {
+	blockNr, err := a.r.backend.BlockNumberFromTendermint(a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := a.r.backend.GetTransactionCount(a.address, blockNr)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code() (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(a.address, a.blockNrOrHash)
+}
+
+func (a *Account) Storage(args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log represents an individual log message.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction() *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(args BlockNumberArgs) *Account {
+	return &Account{
+		r:             l.r,
+		address:       l.log.Address,
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (l *Log) Index() int32 {
+	return int32(l.log.Index) // #nosec G701 -- log index within a block fits
+}
+
+func (l *Log) Topics() []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data() hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple represents EIP-2930
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address() common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys() []common.Hash {
+	return at.storageKeys
+}
+
+// rpcReceipt holds the receipt fields of the json-rpc receipt used by the resolvers.
+type rpcReceipt struct {
+	Status            hexutil.Uint64  `json:"status"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	GasUsed           hexutil.Uint64  `json:"gasUsed"`
+	ContractAddress   *common.Address `json:"contractAddress"`
+	EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice"`
+}
+
+// Transaction represents an Ethereum transaction.
+// r and hash are mandatory, all others will be fetched when required.
+type Transaction struct {
+	r       *Resolver
+	hash    common.Hash
+	tx      *rpctypes.RPCTransaction
+	block   *Block
+	receipt *rpcReceipt
+}
+
+// resolve returns the json-rpc transaction, fetching it if needed. It returns nil if the
+// transaction is neither included in a block nor pending.
+func (t *Transaction) resolve() (*rpctypes.RPCTransaction, error) {
+	if t.tx != nil {
+		return t.tx, nil
+	}
+
+	tx, err := t.r.backend.GetTransactionByHash(t.hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	t.tx = tx
+	if tx.BlockHash != nil && t.block == nil {
+		t.block = &Block{r: t.r, hash: tx.BlockHash}
+	}
+	return t.tx, nil
+}
+
+// getReceipt returns the receipt of the transaction, nil if it's still pending.
+func (t *Transaction) getReceipt() (*rpcReceipt, error) {
+	if t.receipt != nil {
+		return t.receipt, nil
+	}
+	if tx, err := t.resolve(); err != nil || tx == nil || t.block == nil {
+		return nil, err
+	}
+
+	res, err := t.r.backend.GetTransactionReceipt(t.hash)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	var receipt rpcReceipt
+	if err := remarshal(res, &receipt); err != nil {
+		return nil, err
+	}
+	t.receipt = &receipt
+	return t.receipt, nil
+}
+
+func (t *Transaction) Hash() common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) Nonce() (hexutil.Uint64, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return tx.Nonce, nil
+}
+
+func (t *Transaction) Index() (*int32, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil || tx.TransactionIndex == nil {
+		return nil, err
+	}
+	index := int32(*tx.TransactionIndex) // #nosec G701 -- tx index within a block fits
+	return &index, nil
+}
+
+func (t *Transaction) From(args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       tx.From,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) To(args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil || tx.To == nil {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       *tx.To,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Value() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(tx.Value), nil
+}
+
+// GasPrice returns the gas price of the transaction, for dynamic fee transactions included in a
+// block it's the effective gas price.
+func (t *Transaction) GasPrice() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(tx.GasPrice), nil
+}
+
+func (t *Transaction) MaxFeePerGas() (*hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.GasFeeCap, nil
+}
+
+func (t *Transaction) MaxPriorityFeePerGas() (*hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.GasTipCap, nil
+}
+
+func (t *Transaction) EffectiveTip() (*hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil || t.block == nil {
+		return nil, err
+	}
+	baseFee, err := t.block.BaseFeePerGas()
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return tx.GasPrice, nil
+	}
+
+	// GasPrice is already the effective gas price, baseFee + min(tipCap, feeCap - baseFee)
+	gasPrice := bigOrZero(tx.GasPrice)
+	tip := new(big.Int).Sub(gasPrice.ToInt(), baseFee.ToInt())
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Gas() (hexutil.Uint64, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return tx.Gas, nil
+}
+
+func (t *Transaction) InputData() (hexutil.Bytes, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.Input, nil
+}
+
+func (t *Transaction) Block() (*Block, error) {
+	if _, err := t.resolve(); err != nil {
+		return nil, err
+	}
+	return t.block, nil
+}
+
+func (t *Transaction) Status() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return &receipt.Status, nil
+}
+
+func (t *Transaction) GasUsed() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return &receipt.GasUsed, nil
+}
+
+func (t *Transaction) CumulativeGasUsed() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return &receipt.CumulativeGasUsed, nil
+}
+
+func (t *Transaction) EffectiveGasPrice() (*hexutil.Big, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	if receipt.EffectiveGasPrice != nil {
+		return receipt.EffectiveGasPrice, nil
+	}
+	return t.tx.GasPrice, nil
+}
+
+func (t *Transaction) CreatedContract(args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.ContractAddress == nil {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       *receipt.ContractAddress,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Logs() (*[]*Log, error) {
+	if tx, err := t.resolve(); err != nil || tx == nil || t.block == nil {
+		return nil, err
+	}
+
+	logs, err := t.r.backend.GetTransactionLogs(t.hash)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		ret = append(ret, &Log{r: t.r, transaction: t, log: log})
+	}
+	return &ret, nil
+}
+
+func (t *Transaction) R() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(tx.R), nil
+}
+
+func (t *Transaction) S() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(tx.S), nil
+}
+
+func (t *Transaction) V() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(tx.V), nil
+}
+
+func (t *Transaction) Type() (*int32, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	txType := int32(tx.Type) // #nosec G701 -- tx types are a single byte
+	return &txType, nil
+}
+
+func (t *Transaction) AccessList() (*[]*AccessTuple, error) {
+	tx, err := t.resolve()
+	if err != nil || tx == nil || tx.Accesses == nil {
+		return nil, err
+	}
+	ret := make([]*AccessTuple, 0, len(*tx.Accesses))
+	for _, al := range *tx.Accesses {
+		ret = append(ret, &AccessTuple{address: al.Address, storageKeys: al.StorageKeys})
+	}
+	return &ret, nil
+}
+
+// rpcBlock holds the fields of the json-rpc block used by the resolvers.
+type rpcBlock struct {
+	Number           hexutil.Uint64             `json:"number"`
+	Hash             common.Hash                `json:"hash"`
+	ParentHash       common.Hash                `json:"parentHash"`
+	Nonce            ethtypes.BlockNonce        `json:"nonce"`
+	LogsBloom        ethtypes.Bloom             `json:"logsBloom"`
+	StateRoot        hexutil.Bytes              `json:"stateRoot"`
+	Miner            common.Address             `json:"miner"`
+	MixHash          common.Hash                `json:"mixHash"`
+	Difficulty       *hexutil.Big               `json:"difficulty"`
+	ExtraData        hexutil.Bytes              `json:"extraData"`
+	GasLimit         hexutil.Uint64             `json:"gasLimit"`
+	GasUsed          *hexutil.Big               `json:"gasUsed"`
+	Timestamp        hexutil.Uint64             `json:"timestamp"`
+	TransactionsRoot common.Hash                `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash                `json:"receiptsRoot"`
+	Transactions     []*rpctypes.RPCTransaction `json:"transactions"`
+	TotalDifficulty  *hexutil.Big               `json:"totalDifficulty"`
+	BaseFeePerGas    *hexutil.Big               `json:"baseFeePerGas"`
+}
+
+// Block represents an Ethereum block.
+// r and either number or hash are mandatory, the block is fetched when required.
+type Block struct {
+	r      *Resolver
+	number *rpctypes.BlockNumber
+	hash   *common.Hash
+	block  *rpcBlock
+}
+
+// resolve returns the json-rpc block, fetching it if needed. It returns nil if the block
+// doesn't exist.
+func (b *Block) resolve() (*rpcBlock, error) {
+	if b.block != nil {
+		return b.block, nil
+	}
+
+	var (
+		res map[string]interface{}
+		err error
+	)
+	switch {
+	case b.hash != nil:
+		res, err = b.r.backend.GetBlockByHash(*b.hash, true)
+	case b.number != nil:
+		res, err = b.r.backend.GetBlockByNumber(*b.number, true)
+	default:
+		res, err = b.r.backend.GetBlockByNumber(rpctypes.EthLatestBlockNumber, true)
+	}
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	var block rpcBlock
+	if err := remarshal(res, &block); err != nil {
+		return nil, err
+	}
+	b.block = &block
+	return b.block, nil
+}
+
+// mustResolve is like resolve, but fails if the block doesn't exist.
+func (b *Block) mustResolve() (*rpcBlock, error) {
+	block, err := b.resolve()
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errBlockNotFound
+	}
+	return block, nil
+}
+
+// blockNumber returns the block number for state queries against this block.
+func (b *Block) blockNumber() (rpctypes.BlockNumber, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return 0, err
+	}
+	return rpctypes.BlockNumber(block.Number), nil
+}
+
+func (b *Block) Number() (hexutil.Uint64, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return 0, err
+	}
+	return block.Number, nil
+}
+
+func (b *Block) Hash() (common.Hash, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.Hash, nil
+}
+
+func (b *Block) Parent() (*Block, error) {
+	block, err := b.mustResolve()
+	if err != nil || block.Number == 0 {
+		return nil, err
+	}
+	parent := &Block{r: b.r, hash: &block.ParentHash}
+	if res, err := parent.resolve(); err != nil || res == nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+func (b *Block) Nonce() (hexutil.Bytes, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return block.Nonce[:], nil
+}
+
+func (b *Block) TransactionsRoot() (common.Hash, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.TransactionsRoot, nil
+}
+
+func (b *Block) TransactionCount() (*int32, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return nil, err
+	}
+	count := int32(len(block.Transactions)) // #nosec G701 -- bounded by the block size
+	return &count, nil
+}
+
+func (b *Block) StateRoot() (common.Hash, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(block.StateRoot), nil
+}
+
+func (b *Block) ReceiptsRoot() (common.Hash, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.ReceiptsRoot, nil
+}
+
+func (b *Block) Miner(args BlockNumberArgs) (*Account, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       block.Miner,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (b *Block) ExtraData() (hexutil.Bytes, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return block.ExtraData, nil
+}
+
+func (b *Block) GasLimit() (hexutil.Uint64, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return 0, err
+	}
+	return block.GasLimit, nil
+}
+
+func (b *Block) GasUsed() (hexutil.Uint64, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return 0, err
+	}
+	gasUsed := bigOrZero(block.GasUsed)
+	return hexutil.Uint64(gasUsed.ToInt().Uint64()), nil
+}
+
+func (b *Block) BaseFeePerGas() (*hexutil.Big, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return nil, err
+	}
+	return block.BaseFeePerGas, nil
+}
+
+func (b *Block) Timestamp() (hexutil.Uint64, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return 0, err
+	}
+	return block.Timestamp, nil
+}
+
+func (b *Block) LogsBloom() (hexutil.Bytes, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return block.LogsBloom.Bytes(), nil
+}
+
+func (b *Block) MixHash() (common.Hash, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.MixHash, nil
+}
+
+func (b *Block) Difficulty() (hexutil.Big, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(block.Difficulty), nil
+}
+
+func (b *Block) TotalDifficulty() (hexutil.Big, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(block.TotalDifficulty), nil
+}
+
+// OmmerCount always returns zero, there're no ommers in tendermint.
+func (b *Block) OmmerCount() *int32 {
+	count := int32(0)
+	return &count
+}
+
+// Ommers always returns an empty list, there're no ommers in tendermint.
+func (b *Block) Ommers() *[]*Block {
+	ommers := []*Block{}
+	return &ommers
+}
+
+// OmmerAt always returns nil, there're no ommers in tendermint.
+func (b *Block) OmmerAt(struct{ Index int32 }) *Block {
+	return nil
+}
+
+func (b *Block) OmmerHash() common.Hash {
+	return ethtypes.EmptyUncleHash
+}
+
+func (b *Block) Transactions() (*[]*Transaction, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		ret = append(ret, &Transaction{r: b.r, hash: tx.Hash, tx: tx, block: b})
+	}
+	return &ret, nil
+}
+
+func (b *Block) TransactionAt(args struct{ Index int32 }) (*Transaction, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return nil, err
+	}
+	if args.Index < 0 || int(args.Index) >= len(block.Transactions) {
+		return nil, nil
+	}
+	tx := block.Transactions[args.Index]
+	return &Transaction{r: b.r, hash: tx.Hash, tx: tx, block: b}, nil
+}
+
+// BlockFilterCriteria encapsulates criteria passed to a `logs` accessor inside a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+	Topics    *[][]common.Hash  // restricts matches to particular event topics
+}
+
+func (b *Block) Logs(args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	block, err := b.mustResolve()
+	if err != nil {
+		return nil, err
+	}
+
+	height := int64(block.Number) // #nosec G701 -- checked for int overflow already
+	blockLogs, err := b.r.backend.GetLogsByHeight(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*ethtypes.Log
+	for _, txLogs := range blockLogs {
+		logs = append(logs, txLogs...)
+	}
+
+	var (
+		addresses []common.Address
+		topics    [][]common.Hash
+	)
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	return b.r.newLogs(filters.FilterLogs(logs, nil, nil, addresses, topics), b), nil
+}
+
+func (b *Block) Account(args struct{ Address common.Address }) (*Account, error) {
+	blockNr, err := b.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       args.Address,
+		blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+	}, nil
+}
+
+// CallData encapsulates arguments to `call` or `estimateGas`.
+// All arguments are optional.
+type CallData struct {
+	From                 *common.Address // The Ethereum address the call is from.
+	To                   *common.Address // The Ethereum address the call is to.
+	Gas                  *Long           // The amount of gas provided for the call.
+	GasPrice             *hexutil.Big    // The price of each unit of gas, in wei.
+	MaxFeePerGas         *hexutil.Big    // The max price of each unit of gas, in wei (1559).
+	MaxPriorityFeePerGas *hexutil.Big    // The max tip of each unit of gas, in wei (1559).
+	Value                *hexutil.Big    // The value sent along with the call.
+	Data                 *hexutil.Bytes  // Any data sent with the call.
+}
+
+// toTransactionArgs converts the call data to the json-rpc transaction arguments.
+func (data CallData) toTransactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 data.From,
+		To:                   data.To,
+		GasPrice:             data.GasPrice,
+		MaxFeePerGas:         data.MaxFeePerGas,
+		MaxPriorityFeePerGas: data.MaxPriorityFeePerGas,
+		Value:                data.Value,
+		Data:                 data.Data,
+	}
+	if data.Gas != nil {
+		gas := hexutil.Uint64(*data.Gas)
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult encapsulates the result of an invocation of the `call` accessor.
+type CallResult struct {
+	data    hexutil.Bytes  // The return data from the call
+	gasUsed hexutil.Uint64 // The amount of gas used
+	status  hexutil.Uint64 // The return status of the call - 0 for failure or 1 for success.
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+func (b *Block) Call(args struct{ Data CallData }) (*CallResult, error) {
+	blockNr, err := b.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+	return b.r.doCall(args.Data, blockNr)
+}
+
+func (b *Block) EstimateGas(args struct{ Data CallData }) (hexutil.Uint64, error) {
+	blockNr, err := b.blockNumber()
+	if err != nil {
+		return 0, err
+	}
+	return b.r.backend.EstimateGas(args.Data.toTransactionArgs(), &blockNr)
+}
+
+// Pending represents the pending state.
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) TransactionCount() (int32, error) {
+	txs, err := p.r.pendingTransactions()
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(txs)), nil // #nosec G701 -- bounded by the mempool size
+}
+
+func (p *Pending) Transactions() (*[]*Transaction, error) {
+	txs, err := p.r.pendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		ret = append(ret, &Transaction{r: p.r, hash: tx.Hash, tx: tx})
+	}
+	return &ret, nil
+}
+
+func (p *Pending) Account(args struct{ Address common.Address }) *Account {
+	blockNr := rpctypes.EthPendingBlockNumber
+	return &Account{
+		r:             p.r,
+		address:       args.Address,
+		blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+	}
+}
+
+func (p *Pending) Call(args struct{ Data CallData }) (*CallResult, error) {
+	return p.r.doCall(args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(args struct{ Data CallData }) (hexutil.Uint64, error) {
+	blockNr := rpctypes.EthPendingBlockNumber
+	return p.r.backend.EstimateGas(args.Data.toTransactionArgs(), &blockNr)
+}
+
+// SyncState represents the synchronisation status returned from the `syncing` accessor.
+type SyncState struct {
+	StartingBlockNr hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlockNr  hexutil.Uint64 `json:"currentBlock"`
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.StartingBlockNr
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.CurrentBlockNr
+}
+
+// HighestBlock returns the current block, the highest block of the network isn't tracked.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.CurrentBlockNr
+}
+
+// Resolver is the top-level object in the GraphQL hierarchy.
+type Resolver struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewResolver creates a new GraphQL resolver over the json-rpc backend.
+func NewResolver(logger log.Logger, backend Backend) *Resolver {
+	return &Resolver{
+		logger:  logger.With("module", "graphql"),
+		backend: backend,
+	}
+}
+
+func (r *Resolver) Block(args struct {
+	Number *Long
+	Hash   *common.Hash
+},
+) (*Block, error) {
+	block := &Block{r: r, hash: args.Hash}
+	if args.Number != nil {
+		if *args.Number < 0 {
+			return nil, fmt.Errorf("invalid block number %d", *args.Number)
+		}
+		blockNr := rpctypes.BlockNumber(*args.Number)
+		block.number = &blockNr
+	}
+
+	res, err := block.resolve()
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *Resolver) Blocks(args struct {
+	From *Long
+	To   *Long
+},
+) ([]*Block, error) {
+	var from, to int64
+	if args.From != nil {
+		from = int64(*args.From)
+	}
+	if args.To != nil {
+		to = int64(*args.To)
+	} else {
+		head, err := r.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		to = int64(head) // #nosec G701 -- checked for int overflow already
+	}
+	if from < 0 || to < from {
+		return []*Block{}, nil
+	}
+	if limit := int64(r.backend.RPCBlockRangeCap()); to-from > limit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", limit)
+	}
+
+	ret := make([]*Block, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		blockNr := rpctypes.BlockNumber(i)
+		block := &Block{r: r, number: &blockNr}
+		// stop at the first block that doesn't exist yet
+		res, err := block.resolve()
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			break
+		}
+		ret = append(ret, block)
+	}
+	return ret, nil
+}
+
+func (r *Resolver) Pending() *Pending {
+	return &Pending{r: r}
+}
+
+func (r *Resolver) Transaction(args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx := &Transaction{r: r, hash: args.Hash}
+	res, err := tx.resolve()
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// FilterCriteria encapsulates the arguments to `logs` on the root resolver object.
+type FilterCriteria struct {
+	FromBlock *Long             // beginning of the queried range, nil means latest block
+	ToBlock   *Long             // end of the range, nil means latest block
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+	Topics    *[][]common.Hash  // restricts matches to particular event topics
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	begin := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+
+	var (
+		addresses []common.Address
+		topics    [][]common.Hash
+	)
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+
+	filter := filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics)
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	return r.newLogs(logs, nil), nil
+}
+
+func (r *Resolver) GasPrice() (hexutil.Big, error) {
+	price, err := r.backend.GasPrice()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas() (hexutil.Big, error) {
+	head := r.backend.CurrentHeader()
+	if head == nil {
+		return hexutil.Big{}, errBlockNotFound
+	}
+	tipcap, err := r.backend.SuggestGasTipCap(head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tipcap), nil
+}
+
+func (r *Resolver) ChainID() (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// Syncing returns false in case the node is in sync with the network, otherwise the
+// synchronisation status.
+func (r *Resolver) Syncing() (*SyncState, error) {
+	res, err := r.backend.Syncing()
+	if err != nil {
+		return nil, err
+	}
+	if syncing, ok := res.(bool); ok && !syncing {
+		return nil, nil
+	}
+
+	var state SyncState
+	if err := remarshal(res, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (r *Resolver) SendRawTransaction(args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(args.Data)
+}
+
+// newLogs wraps the logs into resolvers, the block is nil if the logs span several blocks.
+func (r *Resolver) newLogs(logs []*ethtypes.Log, block *Block) []*Log {
+	ret := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		tx := &Transaction{r: r, hash: log.TxHash, block: block}
+		if block == nil {
+			blockHash := log.BlockHash
+			tx.block = &Block{r: r, hash: &blockHash}
+		}
+		ret = append(ret, &Log{r: r, transaction: tx, log: log})
+	}
+	return ret
+}
+
+// pendingTransactions returns the ethereum transactions in the mempool.
+func (r *Resolver) pendingTransactions() ([]*rpctypes.RPCTransaction, error) {
+	txs, err := r.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := r.backend.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rpctypes.RPCTransaction, 0, len(txs))
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			rpctx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, 0, 0, nil, chainID.ToInt())
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, rpctx)
+		}
+	}
+	return result, nil
+}
+
+// doCall executes the call at the given block, a reverted call is reported with a failure status
+// and the revert data rather than an error.
+func (r *Resolver) doCall(data CallData, blockNr rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(data.toTransactionArgs(), blockNr)
+	if err != nil {
+		var revertErr *evmtypes.RevertError
+		if !errors.As(err, &revertErr) {
+			return nil, err
+		}
+		reason, _ := revertErr.ErrorData().(string)
+		return &CallResult{data: common.FromHex(reason), status: hexutil.Uint64(ethtypes.ReceiptStatusFailed)}, nil
+	}
+
+	return &CallResult{
+		data:    res.Ret,
+		gasUsed: hexutil.Uint64(res.GasUsed),
+		status:  hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}, nil
+}
+
+// remarshal decodes the json-rpc representation of a backend response into the typed fields
+// used by the resolvers.
+func remarshal(in, out interface{}) error {
+	bz, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, out)
+}
+
+// bigOrZero dereferences the big integer, returning zero if it's nil.
+func bigOrZero(b *hexutil.Big) hexutil.Big {
+	if b == nil {
+		return hexutil.Big{}
+	}
+	return *b
+}
@@ -0,0 +1,176 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/loka-network/loka/v1/rpc/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// mockBackend overrides the backend methods used by the queries under test,
+// calling any other method panics.
+type mockBackend struct {
+	Backend
+
+	block   map[string]interface{}
+	balance *hexutil.Big
+	callErr error
+}
+
+func (m *mockBackend) ChainID() (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(567000)), nil
+}
+
+func (m *mockBackend) GetBlockByNumber(blockNum rpctypes.BlockNumber, _ bool) (map[string]interface{}, error) {
+	if blockNum > 1 {
+		return nil, nil
+	}
+	return m.block, nil
+}
+
+func (m *mockBackend) GetBalance(_ common.Address, _ rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	return m.balance, nil
+}
+
+func (m *mockBackend) DoCall(_ evmtypes.TransactionArgs, _ rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error) {
+	if m.callErr != nil {
+		return nil, m.callErr
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: []byte{0x1}, GasUsed: 21000}, nil
+}
+
+func (m *mockBackend) Syncing() (interface{}, error) {
+	return false, nil
+}
+
+func TestGraphQLQueries(t *testing.T) {
+	txHash := common.HexToHash("0xabcd")
+	blockHash := common.HexToHash("0x1234")
+	mock := &mockBackend{
+		block: map[string]interface{}{
+			"number":     hexutil.Uint64(1),
+			"hash":       hexutil.Bytes(blockHash.Bytes()),
+			"parentHash": common.Hash{},
+			"nonce":      ethtypes.BlockNonce{},
+			"logsBloom":  ethtypes.Bloom{},
+			"stateRoot":  hexutil.Bytes{},
+			"miner":      common.Address{},
+			"extraData":  "0x",
+			"gasLimit":   hexutil.Uint64(10000000),
+			"gasUsed":    (*hexutil.Big)(big.NewInt(21000)),
+			"timestamp":  hexutil.Uint64(1700000000),
+			"transactions": []interface{}{
+				&rpctypes.RPCTransaction{
+					BlockHash: &blockHash,
+					Hash:      txHash,
+					GasPrice:  (*hexutil.Big)(big.NewInt(10)),
+					Value:     (*hexutil.Big)(big.NewInt(1)),
+				},
+			},
+		},
+		balance: (*hexutil.Big)(big.NewInt(100)),
+	}
+
+	h, err := NewHandler(log.NewNopLogger(), mock)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		query   string
+		callErr error
+		expCode int
+		expData string
+	}{
+		{
+			"chain id",
+			`{ chainID }`,
+			nil,
+			http.StatusOK,
+			`{"chainID":"0x8a6d8"}`,
+		},
+		{
+			"block with transactions",
+			`{ block(number: 1) { number hash gasUsed ommerCount transactions { hash value gasPrice } } }`,
+			nil,
+			http.StatusOK,
+			`{"block":{"number":"0x1","hash":"` + blockHash.Hex() + `","gasUsed":"0x5208","ommerCount":0,"transactions":[{"hash":"` + txHash.Hex() + `","value":"0x1","gasPrice":"0xa"}]}}`,
+		},
+		{
+			"unknown block",
+			`{ block(number: 2) { number } }`,
+			nil,
+			http.StatusOK,
+			`{"block":null}`,
+		},
+		{
+			"account balance at block",
+			`{ block(number: 1) { account(address: "0x0000000000000000000000000000000000000001") { balance } } }`,
+			nil,
+			http.StatusOK,
+			`{"block":{"account":{"balance":"0x64"}}}`,
+		},
+		{
+			"pending call",
+			`{ pending { call(data: {}) { data gasUsed status } } }`,
+			nil,
+			http.StatusOK,
+			`{"pending":{"call":{"data":"0x01","gasUsed":"0x5208","status":"0x1"}}}`,
+		},
+		{
+			"reverted call",
+			`{ pending { call(data: {}) { data status } } }`,
+			evmtypes.NewExecErrorWithReason([]byte{0x2}),
+			http.StatusOK,
+			`{"pending":{"call":{"data":"0x02","status":"0x0"}}}`,
+		},
+		{
+			"not syncing",
+			`{ syncing { currentBlock } }`,
+			nil,
+			http.StatusOK,
+			`{"syncing":null}`,
+		},
+		{
+			"invalid query",
+			`{ unknown }`,
+			nil,
+			http.StatusBadRequest,
+			``,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock.callErr = tc.callErr
+
+			body, err := json.Marshal(map[string]interface{}{"query": tc.query})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+			require.Equal(t, tc.expCode, rec.Code, rec.Body.String())
+			if tc.expCode != http.StatusOK {
+				return
+			}
+
+			var res struct {
+				Data   json.RawMessage `json:"data"`
+				Errors []interface{}   `json:"errors"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Empty(t, res.Errors)
+			require.JSONEq(t, tc.expData, string(res.Data))
+		})
+	}
+}
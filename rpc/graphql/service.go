@@ -0,0 +1,49 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"cosmossdk.io/log"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// handler answers the GraphQL queries posted to the endpoint.
+type handler struct {
+	schema *graphql.Schema
+}
+
+// NewHandler returns a new `http.Handler` that will answer GraphQL queries over the
+// json-rpc backend.
+func NewHandler(logger log.Logger, backend Backend) (http.Handler, error) {
+	s, err := graphql.ParseSchema(schema, NewResolver(logger, backend))
+	if err != nil {
+		return nil, err
+	}
+	return handler{schema: s}, nil
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := h.schema.Exec(r.Context(), params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
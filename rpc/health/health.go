@@ -0,0 +1,141 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/loka-network/loka/v1/types"
+)
+
+// Client is the CometBFT client used to query the node status and its peers.
+type Client interface {
+	rpcclient.StatusClient
+	NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error)
+}
+
+// Config defines the thresholds after which the node isn't ready, zero values disable the checks.
+type Config struct {
+	MaxIndexerLag int64
+	MaxBlockAge   time.Duration
+}
+
+// progressIndexer is implemented by the indexers which track the processed blocks without eth txs, otherwise the
+// last indexed block only moves forward with eth txs and the lag grows on quiet chains.
+type progressIndexer interface {
+	LastProcessedBlock() (int64, error)
+}
+
+// Report is the JSON body of the health and readiness endpoints.
+type Report struct {
+	Ready         bool     `json:"ready"`
+	CatchingUp    bool     `json:"catchingUp"`
+	LatestHeight  int64    `json:"latestHeight"`
+	IndexedHeight *int64   `json:"indexedHeight,omitempty"`
+	BlockAge      string   `json:"blockAge"`
+	Peers         int      `json:"peers"`
+	Errors        []string `json:"errors,omitempty"`
+}
+
+// Checker serves the `GET /health` and `GET /ready` endpoints, the former reports whether the
+// node answers at all while the latter reports whether it should receive traffic.
+type Checker struct {
+	logger  log.Logger
+	client  Client
+	indexer types.EVMTxIndexer
+	cfg     Config
+	now     func() time.Time
+}
+
+// NewChecker creates the health checker, indexer may be nil if the EVM indexer is disabled.
+func NewChecker(logger log.Logger, client Client, indexer types.EVMTxIndexer, cfg Config) *Checker {
+	return &Checker{
+		logger:  logger.With("api", "health"),
+		client:  client,
+		indexer: indexer,
+		cfg:     cfg,
+		now:     time.Now,
+	}
+}
+
+// Report queries the node and evaluates the readiness thresholds.
+func (c *Checker) Report(ctx context.Context) (*Report, error) {
+	status, err := c.client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		CatchingUp:   status.SyncInfo.CatchingUp,
+		LatestHeight: status.SyncInfo.LatestBlockHeight,
+	}
+	if report.CatchingUp {
+		report.Errors = append(report.Errors, "node is catching up")
+	}
+
+	blockAge := c.now().Sub(status.SyncInfo.LatestBlockTime).Truncate(time.Millisecond)
+	report.BlockAge = blockAge.String()
+	if c.cfg.MaxBlockAge > 0 && blockAge > c.cfg.MaxBlockAge {
+		report.Errors = append(report.Errors, fmt.Sprintf("latest block is older than %s", c.cfg.MaxBlockAge))
+	}
+
+	if c.indexer != nil {
+		indexed, err := c.indexedHeight()
+		if err != nil {
+			return nil, err
+		}
+		report.IndexedHeight = &indexed
+		if lag := report.LatestHeight - indexed; c.cfg.MaxIndexerLag > 0 && lag > c.cfg.MaxIndexerLag {
+			report.Errors = append(report.Errors, fmt.Sprintf("indexer lags the chain tip by %d blocks", lag))
+		}
+	}
+
+	netInfo, err := c.client.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Peers = netInfo.NPeers
+
+	report.Ready = len(report.Errors) == 0
+	return report, nil
+}
+
+func (c *Checker) indexedHeight() (int64, error) {
+	if indexer, ok := c.indexer.(progressIndexer); ok {
+		return indexer.LastProcessedBlock()
+	}
+	return c.indexer.LastIndexedBlock()
+}
+
+// HealthHandler answers 200 as long as the node can be queried.
+func (c *Checker) HealthHandler(w http.ResponseWriter, r *http.Request) {
+	c.serve(w, r, false)
+}
+
+// ReadyHandler answers 200 only if the node is ready to serve traffic.
+func (c *Checker) ReadyHandler(w http.ResponseWriter, r *http.Request) {
+	c.serve(w, r, true)
+}
+
+func (c *Checker) serve(w http.ResponseWriter, r *http.Request, readiness bool) {
+	report, err := c.Report(r.Context())
+	if err != nil {
+		c.logger.Debug("failed to query node health", "error", err.Error())
+		report = &Report{Errors: []string{err.Error()}}
+	}
+
+	code := http.StatusOK
+	if err != nil || (readiness && !report.Ready) {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
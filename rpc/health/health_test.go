@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/require"
+
+	evmenc "github.com/loka-network/loka/v1/encoding"
+	"github.com/loka-network/loka/v1/indexer"
+	"github.com/loka-network/loka/v1/types"
+)
+
+type mockClient struct {
+	Client
+
+	status *coretypes.ResultStatus
+	err    error
+}
+
+func (m *mockClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return m.status, m.err
+}
+
+func (m *mockClient) NetInfo(context.Context) (*coretypes.ResultNetInfo, error) {
+	return &coretypes.ResultNetInfo{NPeers: 3}, nil
+}
+
+type mockIndexer struct {
+	types.EVMTxIndexer
+
+	last int64
+}
+
+func (m *mockIndexer) LastIndexedBlock() (int64, error) {
+	return m.last, nil
+}
+
+func TestEndpoints(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name       string
+		catchingUp bool
+		blockAge   time.Duration
+		indexed    int64
+		statusErr  error
+		expHealth  int
+		expReady   int
+		expErrors  int
+	}{
+		{"ready", false, time.Second, 98, nil, http.StatusOK, http.StatusOK, 0},
+		{"catching up", true, time.Second, 100, nil, http.StatusOK, http.StatusServiceUnavailable, 1},
+		{"stale block", false, 2 * time.Minute, 100, nil, http.StatusOK, http.StatusServiceUnavailable, 1},
+		{"indexer lagging", false, time.Second, 50, nil, http.StatusOK, http.StatusServiceUnavailable, 1},
+		{"all failing", true, 2 * time.Minute, 50, nil, http.StatusOK, http.StatusServiceUnavailable, 3},
+		{"node unreachable", false, 0, 100, errors.New("connection refused"), http.StatusServiceUnavailable, http.StatusServiceUnavailable, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &coretypes.ResultStatus{}
+			status.SyncInfo.CatchingUp = tc.catchingUp
+			status.SyncInfo.LatestBlockHeight = 100
+			status.SyncInfo.LatestBlockTime = now.Add(-tc.blockAge)
+
+			checker := NewChecker(
+				log.NewNopLogger(),
+				&mockClient{status: status, err: tc.statusErr},
+				&mockIndexer{last: tc.indexed},
+				Config{MaxIndexerLag: 10, MaxBlockAge: time.Minute},
+			)
+			checker.now = func() time.Time { return now }
+
+			for path, handler := range map[string]http.HandlerFunc{
+				"/health": checker.HealthHandler,
+				"/ready":  checker.ReadyHandler,
+			} {
+				rec := httptest.NewRecorder()
+				handler(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+				expCode := tc.expHealth
+				if path == "/ready" {
+					expCode = tc.expReady
+				}
+				require.Equal(t, expCode, rec.Code, path)
+
+				var report Report
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+				require.Len(t, report.Errors, tc.expErrors, report.Errors)
+				if tc.statusErr == nil {
+					require.Equal(t, tc.expErrors == 0, report.Ready)
+					require.Equal(t, int64(100), report.LatestHeight)
+					require.Equal(t, tc.indexed, *report.IndexedHeight)
+					require.Equal(t, 3, report.Peers)
+				}
+			}
+		})
+	}
+}
+
+func TestReadyWithoutEthTxs(t *testing.T) {
+	encodingConfig := evmenc.MakeConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	// a quiet chain, none of the blocks contain eth txs
+	for height := int64(1); height <= 95; height++ {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{}))
+	}
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	now := time.Unix(1700000000, 0)
+	status := &coretypes.ResultStatus{}
+	status.SyncInfo.LatestBlockHeight = 100
+	status.SyncInfo.LatestBlockTime = now.Add(-time.Second)
+	checker := NewChecker(log.NewNopLogger(), &mockClient{status: status}, idxer, Config{MaxIndexerLag: 10})
+	checker.now = func() time.Time { return now }
+
+	report, err := checker.Report(context.Background())
+	require.NoError(t, err)
+	require.True(t, report.Ready, report.Errors)
+	require.Equal(t, int64(95), *report.IndexedHeight)
+
+	// the indexer falls behind
+	status.SyncInfo.LatestBlockHeight = 200
+	report, err = checker.Report(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.Ready)
+	require.Equal(t, []string{"indexer lags the chain tip by 105 blocks"}, report.Errors)
+}
@@ -0,0 +1,255 @@
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// protocolName is the key of the CometBFT protocol in the geth-style protocols maps.
+const protocolName = "cometbft"
+
+// PeerDialer dials peers through the unsafe CometBFT RPC, it's implemented by both the local
+// and the http CometBFT clients.
+type PeerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// PeerSwitch is the CometBFT p2p switch of the node, used to disconnect peers.
+type PeerSwitch interface {
+	Peers() p2p.IPeerSet
+	StopPeerGracefully(peer p2p.Peer)
+}
+
+// WebsocketsServer is the json-rpc websocket server toggled by admin_startWS and admin_stopWS.
+type WebsocketsServer interface {
+	Address() string
+	StartAt(addr string) error
+	Stop() error
+}
+
+// NodeInfo is the node information in geth's admin_nodeInfo shape.
+type NodeInfo struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Enode string `json:"enode"`
+	IP    string `json:"ip"`
+	Ports struct {
+		Discovery int `json:"discovery"`
+		Listener  int `json:"listener"`
+	} `json:"ports"`
+	ListenAddr string                 `json:"listenAddr"`
+	Protocols  map[string]interface{} `json:"protocols"`
+}
+
+// PeerInfo is the connected peer information in geth's admin_peers shape.
+type PeerInfo struct {
+	Enode   string   `json:"enode"`
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Caps    []string `json:"caps"`
+	Network struct {
+		LocalAddress  string `json:"localAddress"`
+		RemoteAddress string `json:"remoteAddress"`
+		Inbound       bool   `json:"inbound"`
+		Trusted       bool   `json:"trusted"`
+		Static        bool   `json:"static"`
+	} `json:"network"`
+	Protocols map[string]interface{} `json:"protocols"`
+}
+
+// API is the private admin prefixed set of APIs in the geth JSON-RPC spec, backed by CometBFT.
+type API struct {
+	ctx      *server.Context
+	logger   log.Logger
+	tmClient rpcclient.Client
+	peers    PeerSwitch
+	wsServer WebsocketsServer
+}
+
+// NewPrivateAPI creates an instance of the Admin API. peers may be nil if the node isn't
+// running in process, admin_removePeer is unsupported then.
+func NewPrivateAPI(
+	ctx *server.Context,
+	clientCtx client.Context,
+	peers PeerSwitch,
+	wsServer WebsocketsServer,
+) *API {
+	return &API{
+		ctx:      ctx,
+		logger:   ctx.Logger.With("api", "admin"),
+		tmClient: clientCtx.Client.(rpcclient.Client),
+		peers:    peers,
+		wsServer: wsServer,
+	}
+}
+
+// NodeInfo retrieves all the information we know about the host node at the protocol granularity.
+func (api *API) NodeInfo() (*NodeInfo, error) {
+	api.logger.Debug("admin_nodeInfo")
+	status, err := api.tmClient.Status(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := status.NodeInfo
+	info := &NodeInfo{
+		ID:         string(nodeInfo.DefaultNodeID),
+		Name:       nodeInfo.Moniker,
+		Enode:      p2p.IDAddressString(nodeInfo.DefaultNodeID, nodeInfo.ListenAddr),
+		ListenAddr: nodeInfo.ListenAddr,
+		Protocols: map[string]interface{}{
+			protocolName: map[string]interface{}{
+				"network":           nodeInfo.Network,
+				"version":           nodeInfo.Version,
+				"protocolVersion":   nodeInfo.ProtocolVersion,
+				"latestBlockHeight": status.SyncInfo.LatestBlockHeight,
+				"latestBlockHash":   status.SyncInfo.LatestBlockHash,
+				"catchingUp":        status.SyncInfo.CatchingUp,
+			},
+		},
+	}
+
+	host, port := splitListenAddr(nodeInfo.ListenAddr)
+	info.IP = host
+	// CometBFT discovers peers over the p2p listener through PEX
+	info.Ports.Discovery = port
+	info.Ports.Listener = port
+	return info, nil
+}
+
+// Peers retrieves all the information we know about each individual peer at the
+// protocol granularity.
+func (api *API) Peers() ([]*PeerInfo, error) {
+	api.logger.Debug("admin_peers")
+	netInfo, err := api.tmClient.NetInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		nodeInfo := peer.NodeInfo
+		_, port := splitListenAddr(nodeInfo.ListenAddr)
+		remoteAddr := net.JoinHostPort(peer.RemoteIP, strconv.Itoa(port))
+
+		info := &PeerInfo{
+			Enode: p2p.IDAddressString(nodeInfo.DefaultNodeID, remoteAddr),
+			ID:    string(nodeInfo.DefaultNodeID),
+			Name:  nodeInfo.Moniker,
+			Caps: []string{
+				fmt.Sprintf("p2p/%d", nodeInfo.ProtocolVersion.P2P),
+				fmt.Sprintf("block/%d", nodeInfo.ProtocolVersion.Block),
+				fmt.Sprintf("app/%d", nodeInfo.ProtocolVersion.App),
+			},
+			Protocols: map[string]interface{}{
+				protocolName: map[string]interface{}{
+					"network":  nodeInfo.Network,
+					"version":  nodeInfo.Version,
+					"duration": peer.ConnectionStatus.Duration.String(),
+				},
+			},
+		}
+		info.Network.RemoteAddress = remoteAddr
+		info.Network.Inbound = !peer.IsOutbound
+		peers = append(peers, info)
+	}
+	return peers, nil
+}
+
+// AddPeer requests connecting to a remote node, and also maintaining the new
+// connection at all times, even reconnecting if it is lost. The url is in the
+// CometBFT `id@host:port` format.
+func (api *API) AddPeer(url string) (bool, error) {
+	api.logger.Debug("admin_addPeer", "url", url)
+	dialer, ok := api.tmClient.(PeerDialer)
+	if !ok {
+		return false, errors.New("the CometBFT client doesn't support dialing peers")
+	}
+
+	if _, err := dialer.DialPeers(context.Background(), []string{url}, true, false, false); err != nil {
+		return false, fmt.Errorf("invalid peer: %w", err)
+	}
+	return true, nil
+}
+
+// RemovePeer disconnects from a remote node if the connection exists. Persistent peers
+// of the CometBFT configuration are redialed by CometBFT.
+func (api *API) RemovePeer(url string) (bool, error) {
+	api.logger.Debug("admin_removePeer", "url", url)
+	if api.peers == nil {
+		return false, errors.New("removing peers is only supported for in-process nodes")
+	}
+
+	id, _, _ := strings.Cut(url, "@")
+	if bz, err := hex.DecodeString(id); err != nil || len(bz) != p2p.IDByteLength {
+		return false, fmt.Errorf("invalid peer id: %s", id)
+	}
+
+	peer := api.peers.Peers().Get(p2p.ID(id))
+	if peer == nil {
+		return false, nil
+	}
+	api.peers.StopPeerGracefully(peer)
+	return true, nil
+}
+
+// Datadir retrieves the current data directory the node is using.
+func (api *API) Datadir() string {
+	api.logger.Debug("admin_datadir")
+	return api.ctx.Config.RootDir
+}
+
+// StartWS starts the websocket RPC API server, host and port default to the configured ones.
+func (api *API) StartWS(host *string, port *int) (bool, error) {
+	api.logger.Debug("admin_startWS")
+	addrHost, addrPort, err := net.SplitHostPort(api.wsServer.Address())
+	if err != nil {
+		return false, err
+	}
+	if host != nil {
+		addrHost = *host
+	}
+	if port != nil {
+		addrPort = strconv.Itoa(*port)
+	}
+
+	if err := api.wsServer.StartAt(net.JoinHostPort(addrHost, addrPort)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// StopWS terminates the websocket RPC API server, closing the open connections.
+func (api *API) StopWS() (bool, error) {
+	api.logger.Debug("admin_stopWS")
+	if err := api.wsServer.Stop(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// splitListenAddr returns the host and port of a CometBFT listen address, which may have
+// a protocol prefix.
+func splitListenAddr(listenAddr string) (string, int) {
+	if _, addr, ok := strings.Cut(listenAddr, "://"); ok {
+		listenAddr = addr
+	}
+	host, portStr, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return listenAddr, 0
+	}
+	port, _ := strconv.Atoi(portStr)
+	return host, port
+}
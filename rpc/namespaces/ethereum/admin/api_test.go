@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWebsocketsServer struct {
+	addr    string
+	running bool
+}
+
+func (s *mockWebsocketsServer) Address() string {
+	return s.addr
+}
+
+func (s *mockWebsocketsServer) StartAt(addr string) error {
+	if s.running {
+		return errors.New("already running")
+	}
+	s.addr = addr
+	s.running = true
+	return nil
+}
+
+func (s *mockWebsocketsServer) Stop() error {
+	s.running = false
+	return nil
+}
+
+func TestStartStopWS(t *testing.T) {
+	wsServer := &mockWebsocketsServer{addr: "127.0.0.1:8546"}
+	api := &API{logger: log.NewNopLogger(), wsServer: wsServer}
+
+	// default to the configured address
+	ok, err := api.StartWS(nil, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "127.0.0.1:8546", wsServer.addr)
+
+	_, err = api.StartWS(nil, nil)
+	require.Error(t, err)
+
+	ok, err = api.StopWS()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, wsServer.running)
+
+	// restart on another address
+	host, port := "0.0.0.0", 8600
+	ok, err = api.StartWS(&host, &port)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "0.0.0.0:8600", wsServer.addr)
+}
+
+func TestRemovePeerNotInProcess(t *testing.T) {
+	api := &API{logger: log.NewNopLogger()}
+	_, err := api.RemovePeer("0000000000000000000000000000000000000000@127.0.0.1:26656")
+	require.Error(t, err)
+}
@@ -92,6 +92,7 @@ type EthereumAPI interface {
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
+	GasPriceByDenom(denom string) (*hexutil.Big, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
@@ -318,6 +319,12 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 	return e.backend.GasPrice()
 }
 
+// GasPriceByDenom returns the current gas price in an alternative fee denomination.
+func (e *PublicAPI) GasPriceByDenom(denom string) (*hexutil.Big, error) {
+	e.logger.Debug("eth_gasPriceByDenom", "denom", denom)
+	return e.backend.GasPriceByDenom(denom)
+}
+
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
 func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
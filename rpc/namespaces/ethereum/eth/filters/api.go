@@ -18,10 +18,12 @@ package filters
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/loka-network/loka/v1/rpc/stream"
 	"github.com/loka-network/loka/v1/rpc/types"
 
 	"cosmossdk.io/log"
@@ -35,12 +37,13 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	ethermint "github.com/loka-network/loka/v1/types"
 	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 )
 
 // FilterAPI gathers
 type FilterAPI interface {
-	NewPendingTransactionFilter() rpc.ID
+	NewPendingTransactionFilter(crit *PendingTxCriteria) rpc.ID
 	NewBlockFilter() rpc.ID
 	NewFilter(criteria filters.FilterCriteria) (rpc.ID, error)
 	GetFilterChanges(id rpc.ID) (interface{}, error)
@@ -79,6 +82,22 @@ type filter struct {
 	crit     filters.FilterCriteria
 	logs     []*ethtypes.Log
 	s        *Subscription // associated subscription in event system
+
+	// fullTx pending transaction filters collect pendingTxs instead of hashes
+	fullTx     bool
+	pendingTxs []*types.RPCTransaction
+	// cancel stops the pending transaction stream subscription
+	cancel context.CancelFunc
+}
+
+// unsubscribe releases the event system or stream subscription backing the filter.
+func (f *filter) unsubscribe(es *EventSystem) {
+	if f.cancel != nil {
+		f.cancel()
+	}
+	if f.s != nil {
+		f.s.Unsubscribe(es)
+	}
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -88,19 +107,34 @@ type PublicFilterAPI struct {
 	clientCtx client.Context
 	backend   Backend
 	events    *EventSystem
+	stream    *stream.RPCStream
+	chainID   *big.Int
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
 }
 
 // NewPublicAPI returns a new PublicFilterAPI instance.
-func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpcclient.WSClient, backend Backend) *PublicFilterAPI {
+func NewPublicAPI(
+	logger log.Logger,
+	clientCtx client.Context,
+	tmWSClient *rpcclient.WSClient,
+	rpcStream *stream.RPCStream,
+	backend Backend,
+) *PublicFilterAPI {
 	logger = logger.With("api", "filter")
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		logger.Error("failed to parse chain id, full pending transactions are unavailable", "chain-id", clientCtx.ChainID, "error", err.Error())
+	}
+
 	api := &PublicFilterAPI{
 		logger:    logger,
 		clientCtx: clientCtx,
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
 		events:    NewEventSystem(logger, tmWSClient),
+		stream:    rpcStream,
+		chainID:   chainID,
 	}
 
 	go api.timeoutLoop()
@@ -121,7 +155,7 @@ func (api *PublicFilterAPI) timeoutLoop() {
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
-				f.s.Unsubscribe(api.events)
+				f.unsubscribe(api.events)
 				delete(api.filters, id)
 			default:
 				continue
@@ -131,14 +165,16 @@ func (api *PublicFilterAPI) timeoutLoop() {
 	}
 }
 
-// NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
+// NewPendingTransactionFilter creates a filter that fetches pending transactions
 // as transactions enter the pending state.
 //
 // It is part of the filter package because this filter can be used through the
 // `eth_getFilterChanges` polling method that is also used for log filters.
+// By default the filter returns transaction hashes, the optional criteria selects
+// full transaction objects and/or filters them by sender, recipient or selector.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newPendingTransactionFilter
-func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
+func (api *PublicFilterAPI) NewPendingTransactionFilter(crit *PendingTxCriteria) rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
@@ -146,127 +182,98 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		return rpc.ID("error creating pending tx filter: max limit reached")
 	}
 
-	pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
-	if err != nil {
-		// wrap error on the ID
-		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
+	if crit == nil {
+		crit = &PendingTxCriteria{}
 	}
 
-	api.filters[pendingTxSub.ID()] = &filter{
-		typ:      filters.PendingTransactionsSubscription,
-		deadline: time.NewTimer(deadline),
-		hashes:   make([]common.Hash, 0),
-		s:        pendingTxSub,
+	if err := CheckPendingTxsSource(api.stream, *crit, api.chainID); err != nil {
+		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	go func(txsCh <-chan coretypes.ResultEvent, errCh <-chan error) {
-		defer cancelSubs()
-
-		for {
-			select {
-			case ev, ok := <-txsCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
-					api.filtersMu.Unlock()
-					return
-				}
+	ctx, cancel := context.WithCancel(context.Background())
+	filterID := rpc.NewID()
+	api.filters[filterID] = &filter{
+		typ:        filters.PendingTransactionsSubscription,
+		deadline:   time.NewTimer(deadline),
+		hashes:     make([]common.Hash, 0),
+		fullTx:     crit.FullTx,
+		pendingTxs: make([]*types.RPCTransaction, 0),
+		cancel:     cancel,
+	}
 
-				data, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
+	onPendingTxs := func(msgs []*evmtypes.MsgEthereumTx, _ int) error {
+		txs := FilterPendingTxs(msgs, *crit, api.chainID)
+		if len(txs) == 0 {
+			return nil
+		}
 
-				tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
-				if err != nil {
-					api.logger.Debug("fail to decode tx", "error", err.Error())
-					continue
-				}
+		api.filtersMu.Lock()
+		defer api.filtersMu.Unlock()
 
-				api.filtersMu.Lock()
-				if f, found := api.filters[pendingTxSub.ID()]; found {
-					for _, msg := range tx.GetMsgs() {
-						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-						if ok {
-							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
-						}
-					}
-				}
-				api.filtersMu.Unlock()
-			case <-errCh:
-				api.filtersMu.Lock()
-				delete(api.filters, pendingTxSub.ID())
-				api.filtersMu.Unlock()
+		f, found := api.filters[filterID]
+		if !found {
+			return nil
+		}
+		for _, tx := range txs {
+			switch tx := tx.(type) {
+			case common.Hash:
+				f.hashes = append(f.hashes, tx)
+			case *types.RPCTransaction:
+				f.pendingTxs = append(f.pendingTxs, tx)
 			}
 		}
-	}(pendingTxSub.eventCh, pendingTxSub.Err())
+		return nil
+	}
 
-	return pendingTxSub.ID()
+	go func() {
+		// the callback never fails, the subscription stops when the filter is uninstalled
+		_ = api.stream.PendingTxStream().Subscribe(ctx, onPendingTxs) // #nosec G703
+	}()
+
+	return filterID
 }
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
-// enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// enters the transaction pool. By default it notifies the transaction hashes, the optional
+// criteria selects full transaction objects and/or filters them by sender, recipient or selector.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, crit *PendingTxCriteria) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
-	rpcSub := notifier.CreateSubscription()
-
-	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
-	defer cancelFn()
-
-	api.events.WithContext(ctx)
-
-	pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
-	if err != nil {
-		return nil, err
+	if crit == nil {
+		crit = &PendingTxCriteria{}
 	}
 
-	go func(txsCh <-chan coretypes.ResultEvent) {
-		defer cancelSubs()
-
-		for {
-			select {
-			case ev, ok := <-txsCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
-					api.filtersMu.Unlock()
-					return
-				}
+	if err := CheckPendingTxsSource(api.stream, *crit, api.chainID); err != nil {
+		return &rpc.Subscription{}, err
+	}
 
-				data, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
+	rpcSub := notifier.CreateSubscription()
 
-				tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
-				if err != nil {
-					api.logger.Debug("fail to decode tx", "error", err.Error())
-					continue
-				}
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-rpcSub.Err():
+		case <-notifier.Closed():
+		}
+		cancel()
+	}()
 
-				for _, msg := range tx.GetMsgs() {
-					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash()) // #nosec G703
-					}
-				}
-			case <-rpcSub.Err():
-				pendingTxSub.Unsubscribe(api.events)
-				return
-			case <-notifier.Closed():
-				pendingTxSub.Unsubscribe(api.events)
-				return
-			}
+	onPendingTxs := func(msgs []*evmtypes.MsgEthereumTx, _ int) error {
+		for _, tx := range FilterPendingTxs(msgs, *crit, api.chainID) {
+			_ = notifier.Notify(rpcSub.ID, tx) // #nosec G703
 		}
-	}(pendingTxSub.eventCh)
+		return nil
+	}
 
-	return rpcSub, err
+	go func() {
+		// the callback never fails, the subscription stops when the client unsubscribes
+		_ = api.stream.PendingTxStream().Subscribe(subCtx, onPendingTxs) // #nosec G703
+	}()
+
+	return rpcSub, nil
 }
 
 // NewBlockFilter creates a filter that fetches blocks that are imported into the chain.
@@ -569,7 +576,7 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	if !found {
 		return false
 	}
-	f.s.Unsubscribe(api.events)
+	f.unsubscribe(api.events)
 	return true
 }
 
@@ -618,7 +625,8 @@ func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*et
 // GetFilterChanges returns the logs for the filter with the given id since
 // last time it was called. This can be used for polling.
 //
-// For pending transaction and block filters the result is []common.Hash.
+// For pending transaction and block filters the result is []common.Hash,
+// fullTx pending transaction filters return []RPCTransaction.
 // (pending)Log filters return []Log.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getfilterchanges
@@ -639,7 +647,16 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 	f.deadline.Reset(deadline)
 
 	switch f.typ {
-	case filters.PendingTransactionsSubscription, filters.BlocksSubscription:
+	case filters.PendingTransactionsSubscription:
+		if f.fullTx {
+			txs := f.pendingTxs
+			f.pendingTxs = make([]*types.RPCTransaction, 0)
+			return txs, nil
+		}
+		hashes := f.hashes
+		f.hashes = nil
+		return returnHashes(hashes), nil
+	case filters.BlocksSubscription:
 		hashes := f.hashes
 		f.hashes = nil
 		return returnHashes(hashes), nil
@@ -0,0 +1,135 @@
+package filters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/loka-network/loka/v1/rpc/stream"
+	"github.com/loka-network/loka/v1/rpc/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// selectorLength is the length of the 4-byte function selector prefixing the call data.
+const selectorLength = 4
+
+// PendingTxCriteria selects the pending transactions delivered to a subscription or filter,
+// and whether they're delivered as hashes or full transaction objects.
+//
+// It's decoded either from geth's boolean `fullTx` parameter, or from an object for the
+// filtered variant:
+//
+//	{"fullTx": true, "from": ["0x..."], "to": ["0x..."], "selectors": ["0xa9059cbb"]}
+//
+// Empty lists match anything, non-empty lists match any of their entries.
+type PendingTxCriteria struct {
+	FullTx    bool             `json:"fullTx"`
+	From      []common.Address `json:"from"`
+	To        []common.Address `json:"to"`
+	Selectors []hexutil.Bytes  `json:"selectors"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts a boolean or a criteria object.
+func (crit *PendingTxCriteria) UnmarshalJSON(input []byte) error {
+	var fullTx bool
+	if err := json.Unmarshal(input, &fullTx); err == nil {
+		*crit = PendingTxCriteria{FullTx: fullTx}
+		return nil
+	}
+
+	type criteria PendingTxCriteria
+	var raw criteria
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return fmt.Errorf("invalid pending transaction criteria: %w", err)
+	}
+	for _, selector := range raw.Selectors {
+		if len(selector) != selectorLength {
+			return fmt.Errorf("invalid selector %s, expect %d bytes", selector, selectorLength)
+		}
+	}
+
+	*crit = PendingTxCriteria(raw)
+	return nil
+}
+
+// Match returns true if the transaction satisfies the criteria.
+func (crit PendingTxCriteria) Match(msg *evmtypes.MsgEthereumTx) bool {
+	if len(crit.From) > 0 && !includes(crit.From, common.HexToAddress(msg.From)) {
+		return false
+	}
+
+	if len(crit.To) == 0 && len(crit.Selectors) == 0 {
+		return true
+	}
+
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return false
+	}
+
+	if len(crit.To) > 0 && (tx.To() == nil || !includes(crit.To, *tx.To())) {
+		return false
+	}
+
+	if len(crit.Selectors) > 0 {
+		data := tx.Data()
+		if len(data) < selectorLength {
+			return false
+		}
+
+		var match bool
+		for _, selector := range crit.Selectors {
+			if bytes.Equal(data[:selectorLength], selector) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckPendingTxsSource returns an error if the pending transactions selected by the criteria
+// can't be served: the rpc stream isn't running or, for full transaction objects, the chain id
+// needed to derive the senders is unknown.
+func CheckPendingTxsSource(rpcStream *stream.RPCStream, crit PendingTxCriteria, chainID *big.Int) error {
+	if rpcStream == nil {
+		return errors.New("pending transaction stream is not available")
+	}
+	if crit.FullTx && chainID == nil {
+		return errors.New("full pending transactions are not available: unknown chain id")
+	}
+	return nil
+}
+
+// FilterPendingTxs returns the pending transactions matching the given criteria, either as hashes or
+// as RPC transactions depending on crit.FullTx.
+func FilterPendingTxs(msgs []*evmtypes.MsgEthereumTx, crit PendingTxCriteria, chainID *big.Int) []interface{} {
+	var ret []interface{}
+	for _, msg := range msgs {
+		if !crit.Match(msg) {
+			continue
+		}
+
+		if !crit.FullTx {
+			ret = append(ret, common.HexToHash(msg.Hash))
+			continue
+		}
+
+		// use zero block values since it's not included in a block yet
+		rpcTx, err := types.NewTransactionFromMsg(msg, common.Hash{}, 0, 0, nil, chainID)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, rpcTx)
+	}
+	return ret
+}
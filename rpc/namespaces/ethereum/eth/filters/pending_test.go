@@ -0,0 +1,155 @@
+package filters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/rpc/stream"
+	"github.com/loka-network/loka/v1/rpc/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+func TestUnmarshalPendingTxCriteria(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		input   string
+		expCrit PendingTxCriteria
+		expPass bool
+	}{
+		{
+			"fullTx flag",
+			"true",
+			PendingTxCriteria{FullTx: true},
+			true,
+		},
+		{
+			"hashes only flag",
+			"false",
+			PendingTxCriteria{},
+			true,
+		},
+		{
+			"criteria object",
+			`{"fullTx": true, "from": ["0x0000000000000000000000000000000000000001"], "selectors": ["0xa9059cbb"]}`,
+			PendingTxCriteria{
+				FullTx:    true,
+				From:      []common.Address{common.HexToAddress("0x1")},
+				Selectors: []hexutil.Bytes{common.FromHex("0xa9059cbb")},
+			},
+			true,
+		},
+		{
+			"invalid selector length",
+			`{"selectors": ["0xa9059c"]}`,
+			PendingTxCriteria{},
+			false,
+		},
+		{
+			"invalid type",
+			`"latest"`,
+			PendingTxCriteria{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			var crit PendingTxCriteria
+			err := crit.UnmarshalJSON([]byte(tc.input))
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCrit, crit)
+		})
+	}
+}
+
+func TestFilterPendingTxs(t *testing.T) {
+	chainID := big.NewInt(567000)
+	sender := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x2")
+	transfer := common.FromHex("0xa9059cbb")
+
+	newMsg := func(to *common.Address, input []byte) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  chainID,
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+			To:       to,
+			Input:    input,
+		})
+		msg.From = sender.Hex()
+		return msg
+	}
+
+	call := newMsg(&token, append(transfer, make([]byte, 64)...))
+	create := newMsg(nil, []byte{0x60, 0x80})
+
+	testCases := []struct {
+		msg    string
+		crit   PendingTxCriteria
+		expTxs []*evmtypes.MsgEthereumTx
+	}{
+		{"no criteria", PendingTxCriteria{}, []*evmtypes.MsgEthereumTx{call, create}},
+		{"from match", PendingTxCriteria{From: []common.Address{sender}}, []*evmtypes.MsgEthereumTx{call, create}},
+		{"from mismatch", PendingTxCriteria{From: []common.Address{token}}, nil},
+		{"to match skips contract creation", PendingTxCriteria{To: []common.Address{token}}, []*evmtypes.MsgEthereumTx{call}},
+		{"selector match", PendingTxCriteria{Selectors: []hexutil.Bytes{transfer}}, []*evmtypes.MsgEthereumTx{call}},
+		{"selector mismatch", PendingTxCriteria{Selectors: []hexutil.Bytes{common.FromHex("0x095ea7b3")}}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			hashes := FilterPendingTxs([]*evmtypes.MsgEthereumTx{call, create}, tc.crit, chainID)
+			require.Len(t, hashes, len(tc.expTxs))
+			for i, msg := range tc.expTxs {
+				require.Equal(t, common.HexToHash(msg.Hash), hashes[i])
+			}
+
+			tc.crit.FullTx = true
+			txs := FilterPendingTxs([]*evmtypes.MsgEthereumTx{call, create}, tc.crit, chainID)
+			require.Len(t, txs, len(tc.expTxs))
+			for i, msg := range tc.expTxs {
+				rpcTx, ok := txs[i].(*types.RPCTransaction)
+				require.True(t, ok)
+				require.Equal(t, msg.AsTransaction().Hash(), rpcTx.Hash)
+			}
+		})
+	}
+}
+
+func TestCheckPendingTxsSource(t *testing.T) {
+	rpcStream := &stream.RPCStream{}
+	chainID := big.NewInt(567000)
+
+	testCases := []struct {
+		msg       string
+		rpcStream *stream.RPCStream
+		crit      PendingTxCriteria
+		chainID   *big.Int
+		expPass   bool
+	}{
+		{"hashes", rpcStream, PendingTxCriteria{}, chainID, true},
+		{"full txs", rpcStream, PendingTxCriteria{FullTx: true}, chainID, true},
+		{"hashes without chain id", rpcStream, PendingTxCriteria{}, nil, true},
+		{"full txs without chain id", rpcStream, PendingTxCriteria{FullTx: true}, nil, false},
+		{"no stream", nil, PendingTxCriteria{}, chainID, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := CheckPendingTxsSource(tc.rpcStream, tc.crit, tc.chainID)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package versiondb
+
+import (
+	"cosmossdk.io/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/crypto-org-chain/cronos/versiondb"
+
+	rpctypes "github.com/loka-network/loka/v1/rpc/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// KeyChange is a change of a key at a version, the value is empty if the key is deleted.
+type KeyChange struct {
+	Version hexutil.Uint64 `json:"version"`
+	Value   hexutil.Bytes  `json:"value"`
+	Deleted bool           `json:"deleted"`
+}
+
+// KeyHistoryResult is a page of the changes of a key, newest first.
+type KeyHistoryResult struct {
+	Changes []KeyChange `json:"changes"`
+	// NextVersion is the end version of the next page, nil if there's no more.
+	NextVersion *hexutil.Uint64 `json:"nextVersion"`
+}
+
+// KeyDiff is a key whose value is different between two versions, the value is nil if the key don't exist.
+type KeyDiff struct {
+	Key  hexutil.Bytes  `json:"key"`
+	From *hexutil.Bytes `json:"from"`
+	To   *hexutil.Bytes `json:"to"`
+}
+
+// DiffResult is a page of the keys changed between two versions, in ascending order.
+type DiffResult struct {
+	Diffs []KeyDiff `json:"diffs"`
+	// NextKey is the start key of the next page, nil if there's no more.
+	NextKey *hexutil.Bytes `json:"nextKey"`
+}
+
+// Store is a versiondb which supports the history queries.
+type Store interface {
+	versiondb.VersionStore
+	versiondb.HistoryStore
+}
+
+// API is the versiondb_ prefixed set of APIs, it queries the history of the keys stored in the versiondb of the node.
+type API struct {
+	logger log.Logger
+	store  Store
+}
+
+// NewAPI creates an instance of the versiondb API.
+func NewAPI(logger log.Logger, store Store) *API {
+	return &API{
+		logger: logger.With("module", "versiondb"),
+		store:  store,
+	}
+}
+
+// KeyHistory returns the changes of the key in the store within the version range `[startVersion, endVersion]`,
+// newest first, at most `limit` ones.
+func (a *API) KeyHistory(
+	storeKey string,
+	key hexutil.Bytes,
+	startVersion, endVersion rpctypes.BlockNumber,
+	limit *int,
+) (*KeyHistoryResult, error) {
+	a.logger.Debug("versiondb_keyHistory", "store", storeKey, "key", key, "start", startVersion, "end", endVersion)
+	start, err := a.resolveVersion(startVersion)
+	if err != nil {
+		return nil, err
+	}
+	end, err := a.resolveVersion(endVersion)
+	if err != nil {
+		return nil, err
+	}
+	changes, next, err := a.store.KeyHistory(storeKey, key, start, end, deref(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &KeyHistoryResult{
+		Changes: make([]KeyChange, len(changes)),
+	}
+	for i, change := range changes {
+		result.Changes[i] = KeyChange{
+			Version: hexutil.Uint64(change.Version),
+			Value:   change.Value,
+			Deleted: change.Deleted,
+		}
+	}
+	if next > 0 {
+		nextVersion := hexutil.Uint64(next)
+		result.NextVersion = &nextVersion
+	}
+	return result, nil
+}
+
+// Diff returns the keys under the prefix in the store whose values at `toVersion` are different from `fromVersion`,
+// starting from `startKey`, at most `limit` ones.
+func (a *API) Diff(
+	storeKey string,
+	prefix hexutil.Bytes,
+	fromVersion, toVersion rpctypes.BlockNumber,
+	startKey *hexutil.Bytes,
+	limit *int,
+) (*DiffResult, error) {
+	a.logger.Debug("versiondb_diff", "store", storeKey, "prefix", prefix, "from", fromVersion, "to", toVersion)
+	from, err := a.resolveVersion(fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := a.resolveVersion(toVersion)
+	if err != nil {
+		return nil, err
+	}
+	var start []byte
+	if startKey != nil {
+		start = *startKey
+	}
+	diffs, nextKey, err := a.store.Diff(storeKey, prefix, start, from, to, deref(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DiffResult{
+		Diffs: make([]KeyDiff, len(diffs)),
+	}
+	for i, diff := range diffs {
+		result.Diffs[i] = KeyDiff{
+			Key:  diff.Key,
+			From: optionalBytes(diff.From),
+			To:   optionalBytes(diff.To),
+		}
+	}
+	result.NextKey = optionalBytes(nextKey)
+	return result, nil
+}
+
+// StorageHistory returns the changes of a storage slot of the contract, see `KeyHistory`.
+func (a *API) StorageHistory(
+	address common.Address,
+	slot common.Hash,
+	startVersion, endVersion rpctypes.BlockNumber,
+	limit *int,
+) (*KeyHistoryResult, error) {
+	return a.KeyHistory(evmtypes.StoreKey, evmtypes.StateKey(address, slot.Bytes()), startVersion, endVersion, limit)
+}
+
+// StorageDiff returns the storage slots of the contract changed between two versions, the keys returned are the
+// full keys in the evm store, see `Diff`.
+func (a *API) StorageDiff(
+	address common.Address,
+	fromVersion, toVersion rpctypes.BlockNumber,
+	startKey *hexutil.Bytes,
+	limit *int,
+) (*DiffResult, error) {
+	return a.Diff(evmtypes.StoreKey, evmtypes.AddressStoragePrefix(address), fromVersion, toVersion, startKey, limit)
+}
+
+// resolveVersion converts the block number to version, the pending and latest tags are resolved to the latest
+// version, the earliest tag is resolved to the earliest version retained.
+func (a *API) resolveVersion(blockNum rpctypes.BlockNumber) (int64, error) {
+	switch {
+	case blockNum < rpctypes.EthEarliestBlockNumber:
+		return a.store.GetLatestVersion()
+	case blockNum == rpctypes.EthEarliestBlockNumber:
+		return a.store.GetEarliestVersion()
+	default:
+		return int64(blockNum), nil
+	}
+}
+
+func deref(limit *int) int {
+	if limit == nil {
+		return 0
+	}
+	return *limit
+}
+
+func optionalBytes(bz []byte) *hexutil.Bytes {
+	if bz == nil {
+		return nil
+	}
+	result := hexutil.Bytes(bz)
+	return &result
+}
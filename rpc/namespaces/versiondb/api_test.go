@@ -0,0 +1,74 @@
+package versiondb
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/crypto-org-chain/cronos/versiondb/pebbledb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/loka-network/loka/v1/rpc/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+func TestStorageHistory(t *testing.T) {
+	store, err := pebbledb.NewStore(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	slot1 := common.HexToHash("0x01")
+	slot2 := common.HexToHash("0x02")
+	setSlot := func(address common.Address, slot common.Hash, value []byte) *storetypes.StoreKVPair {
+		return &storetypes.StoreKVPair{
+			StoreKey: evmtypes.StoreKey,
+			Key:      evmtypes.StateKey(address, slot.Bytes()),
+			Value:    value,
+			Delete:   value == nil,
+		}
+	}
+	require.NoError(t, store.PutAtVersion(1, []*storetypes.StoreKVPair{
+		setSlot(address, slot1, []byte{1}),
+		setSlot(other, slot1, []byte{1}),
+	}))
+	require.NoError(t, store.PutAtVersion(2, []*storetypes.StoreKVPair{
+		setSlot(address, slot1, []byte{2}),
+		setSlot(address, slot2, []byte{1}),
+	}))
+	require.NoError(t, store.PutAtVersion(3, []*storetypes.StoreKVPair{
+		setSlot(address, slot1, nil),
+		setSlot(other, slot1, []byte{2}),
+	}))
+
+	api := NewAPI(log.NewNopLogger(), store)
+
+	limit := 2
+	result, err := api.StorageHistory(address, slot1, rpctypes.EthEarliestBlockNumber, rpctypes.EthLatestBlockNumber, &limit)
+	require.NoError(t, err)
+	require.Equal(t, []KeyChange{
+		{Version: 3, Deleted: true},
+		{Version: 2, Value: []byte{2}},
+	}, result.Changes)
+	require.NotNil(t, result.NextVersion)
+	require.Equal(t, hexutil.Uint64(1), *result.NextVersion)
+
+	result, err = api.StorageHistory(address, slot1, rpctypes.EthEarliestBlockNumber, rpctypes.BlockNumber(*result.NextVersion), &limit)
+	require.NoError(t, err)
+	require.Equal(t, []KeyChange{
+		{Version: 1, Value: []byte{1}},
+	}, result.Changes)
+	require.Nil(t, result.NextVersion)
+
+	diff, err := api.StorageDiff(address, rpctypes.BlockNumber(1), rpctypes.EthLatestBlockNumber, nil, nil)
+	require.NoError(t, err)
+	value := hexutil.Bytes{1}
+	require.Equal(t, []KeyDiff{
+		{Key: evmtypes.StateKey(address, slot1.Bytes()), From: &value},
+		{Key: evmtypes.StateKey(address, slot2.Bytes()), To: &value},
+	}, diff.Diffs)
+	require.Nil(t, diff.NextKey)
+}
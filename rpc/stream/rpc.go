@@ -62,7 +62,7 @@ type RPCStream struct {
 	logStream    *Stream[*ethtypes.Log]
 
 	// pendingTxStream is backed by check-tx ante handler
-	pendingTxStream *Stream[common.Hash]
+	pendingTxStream *Stream[*evmtypes.MsgEthereumTx]
 
 	wg               sync.WaitGroup
 	validatorAccount validatorAccountFunc
@@ -79,7 +79,7 @@ func NewRPCStreams(
 		logger:           logger,
 		txDecoder:        txDecoder,
 		validatorAccount: validatorAccount,
-		pendingTxStream:  NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
+		pendingTxStream:  NewStream[*evmtypes.MsgEthereumTx](txStreamSegmentSize, txStreamCapacity),
 	}
 }
 
@@ -128,7 +128,8 @@ func (s *RPCStream) HeaderStream() *Stream[RPCHeader] {
 	return s.headerStream
 }
 
-func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
+// PendingTxStream returns the stream of decoded ethereum transactions accepted by CheckTx.
+func (s *RPCStream) PendingTxStream() *Stream[*evmtypes.MsgEthereumTx] {
 	return s.pendingTxStream
 }
 
@@ -138,8 +139,8 @@ func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 }
 
 // ListenPendingTx is a callback passed to application to listen for pending transactions in CheckTx.
-func (s *RPCStream) ListenPendingTx(hash common.Hash) {
-	s.PendingTxStream().Add(hash)
+func (s *RPCStream) ListenPendingTx(tx *evmtypes.MsgEthereumTx) {
+	s.PendingTxStream().Add(tx)
 }
 
 func (s *RPCStream) start(
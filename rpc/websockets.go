@@ -44,13 +44,21 @@ import (
 
 	"github.com/loka-network/loka/v1/rpc/ethereum/pubsub"
 	rpcfilters "github.com/loka-network/loka/v1/rpc/namespaces/ethereum/eth/filters"
+	"github.com/loka-network/loka/v1/rpc/stream"
 	"github.com/loka-network/loka/v1/rpc/types"
 	"github.com/loka-network/loka/v1/server/config"
+	ethermint "github.com/loka-network/loka/v1/types"
 	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 )
 
 type WebsocketsServer interface {
 	Start()
+	// StartAt starts serving on the given address, it fails if the server is already running.
+	StartAt(addr string) error
+	// Stop closes the listener and the open connections, it's a no-op if the server isn't running.
+	Stop() error
+	// Address returns the address the server listens, or last listened, on.
+	Address() string
 }
 
 type SubscriptionResponseJSON struct {
@@ -88,9 +96,19 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+
+	mtx   sync.Mutex
+	srv   *http.Server
+	conns map[*wsConn]struct{}
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	rpcStream *stream.RPCStream,
+	cfg *config.Config,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -99,32 +117,82 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, rpcStream),
 		logger:   logger,
+		conns:    make(map[*wsConn]struct{}),
 	}
 }
 
 func (s *websocketsServer) Start() {
+	if err := s.StartAt(s.Address()); err != nil {
+		s.logger.Error("failed to start HTTP server for WS", "error", err.Error())
+	}
+}
+
+func (s *websocketsServer) StartAt(addr string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.srv != nil {
+		return fmt.Errorf("websocket server already running on %s", s.wsAddr)
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
 
+	/* #nosec G112 -- http functions have no support for timeouts */
+	srv := &http.Server{Handler: ws}
+	s.srv = srv
+	// record the actual address, the port may be allocated by the system
+	s.wsAddr = ln.Addr().String()
+
 	go func() {
 		var err error
-		/* #nosec G114 -- http functions have no support for timeouts */
 		if s.certFile == "" || s.keyFile == "" {
-			err = http.ListenAndServe(s.wsAddr, ws)
+			err = srv.Serve(ln)
 		} else {
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws)
+			err = srv.ServeTLS(ln, s.certFile, s.keyFile)
 		}
 
-		if err != nil {
-			if err == http.ErrServerClosed {
-				return
-			}
-
-			s.logger.Error("failed to start HTTP server for WS", "error", err.Error())
+		if err != nil && err != http.ErrServerClosed {
+			s.logger.Error("failed to serve HTTP server for WS", "error", err.Error())
 		}
 	}()
+
+	s.logger.Info("Starting websocket server", "address", s.wsAddr)
+	return nil
+}
+
+func (s *websocketsServer) Stop() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.srv == nil {
+		return nil
+	}
+
+	// hijacked websocket connections aren't tracked by the http server
+	err := s.srv.Close()
+	for conn := range s.conns {
+		_ = conn.Close() // #nosec G703
+	}
+	s.srv = nil
+	s.conns = make(map[*wsConn]struct{})
+
+	s.logger.Info("Stopped websocket server", "address", s.wsAddr)
+	return err
+}
+
+func (s *websocketsServer) Address() string {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.wsAddr
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -140,10 +208,22 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
+	c := &wsConn{
 		mux:  new(sync.Mutex),
 		conn: conn,
-	})
+	}
+
+	s.mtx.Lock()
+	s.conns[c] = struct{}{}
+	s.mtx.Unlock()
+
+	defer func() {
+		s.mtx.Lock()
+		delete(s.conns, c)
+		s.mtx.Unlock()
+	}()
+
+	s.readLoop(c)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -358,15 +438,24 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
+	stream    *stream.RPCStream
+	chainID   *big.Int
 	logger    log.Logger
 	clientCtx client.Context
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, rpcStream *stream.RPCStream) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		logger.Error("failed to parse chain id, full pending transactions are unavailable", "chain-id", clientCtx.ChainID, "error", err.Error())
+	}
+
 	return &pubSubAPI{
 		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
+		stream:    rpcStream,
+		chainID:   chainID,
 		logger:    logger,
 		clientCtx: clientCtx,
 	}
@@ -388,7 +477,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -636,62 +728,58 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	sub, unsubFn, err := api.events.SubscribePendingTxs()
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating block filter: %s")
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	var crit rpcfilters.PendingTxCriteria
+	if extra != nil {
+		// the extra param is either the fullTx flag or a criteria object
+		bz, err := json.Marshal(extra)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid pending transaction criteria")
+		}
+		if err := json.Unmarshal(bz, &crit); err != nil {
+			api.logger.Debug("invalid pending transaction criteria", "type", fmt.Sprintf("%T", extra))
+			return nil, err
+		}
 	}
 
-	go func() {
-		txsCh := sub.Event()
-		errCh := sub.Err()
-		for {
-			select {
-			case ev := <-txsCh:
-				data, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
-
-				ethTxs, err := types.RawTxToEthTx(api.clientCtx, data.Tx)
-				if err != nil {
-					// not ethereum tx
-					continue
-				}
+	if err := rpcfilters.CheckPendingTxsSource(api.stream, crit, api.chainID); err != nil {
+		return nil, err
+	}
 
-				for _, ethTx := range ethTxs {
-					// write to ws conn
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethTx.Hash,
-						},
-					}
+	ctx, cancel := context.WithCancel(context.Background())
+	onPendingTxs := func(msgs []*evmtypes.MsgEthereumTx, _ int) error {
+		for _, tx := range rpcfilters.FilterPendingTxs(msgs, crit, api.chainID) {
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       tx,
+				},
+			}
 
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing pending transaction, will drop peer", "error", err.Error())
 
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close() // #nosec G703
-							}
-						}, api.logger, "closing websocket peer sub")
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
 					}
-				}
-			case err, ok := <-errCh:
-				if !ok {
-					return
-				}
-				api.logger.Debug("dropping PendingTransactions WebSocket subscription", subID, "error", err.Error())
+				}, api.logger, "closing websocket peer sub")
+				return err
 			}
 		}
+		return nil
+	}
+
+	go func() {
+		if err := api.stream.PendingTxStream().Subscribe(ctx, onPendingTxs); err != nil {
+			api.logger.Debug("dropping PendingTransactions WebSocket subscription", "subscription-id", subID, "error", err.Error())
+		}
 	}()
 
-	return unsubFn, nil
+	return pubsub.UnsubscribeFunc(cancel), nil
 }
 
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/rpc/backend/mocks"
+)
+
+func newTestWebsocketsServer() *websocketsServer {
+	return &websocketsServer{
+		wsAddr: "127.0.0.1:0",
+		logger: log.NewNopLogger(),
+		conns:  make(map[*wsConn]struct{}),
+	}
+}
+
+func dialWebsocket(t *testing.T, addr string) *websocket.Conn {
+	t.Helper()
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.NoError(t, err)
+	return conn
+}
+
+func TestWebsocketsServerStartStop(t *testing.T) {
+	srv := newTestWebsocketsServer()
+
+	require.NoError(t, srv.StartAt("127.0.0.1:0"))
+	addr := srv.Address()
+	require.NotEqual(t, "127.0.0.1:0", addr)
+
+	// can't start twice
+	require.Error(t, srv.StartAt("127.0.0.1:0"))
+	require.Equal(t, addr, srv.Address())
+
+	conn := dialWebsocket(t, addr)
+	defer conn.Close()
+	// wait for the connection to be tracked
+	require.Eventually(t, func() bool {
+		srv.mtx.Lock()
+		defer srv.mtx.Unlock()
+		return len(srv.conns) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the open connections are closed on stop
+	require.NoError(t, srv.Stop())
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err := conn.ReadMessage()
+	require.Error(t, err)
+	_, _, err = websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.Error(t, err)
+
+	// stopping a stopped server is a no-op
+	require.NoError(t, srv.Stop())
+
+	// restart on the same address
+	require.NoError(t, srv.StartAt(addr))
+	require.Equal(t, addr, srv.Address())
+	conn = dialWebsocket(t, addr)
+	defer conn.Close()
+	require.NoError(t, srv.Stop())
+}
+
+func TestAdminNamespaceRestricted(t *testing.T) {
+	ctx := server.NewDefaultContext()
+
+	// the admin namespace is never served on the public endpoints
+	apis := GetRPCAPIs(ctx, client.Context{}, nil, nil, false, nil, []string{AdminNamespace})
+	require.Empty(t, apis)
+
+	// the admin APIs served on the IPC endpoint are private
+	clientCtx := client.Context{}.WithClient(mocks.NewClient(t))
+	apis = GetAdminAPIs(ctx, clientCtx, nil, newTestWebsocketsServer())
+	require.Len(t, apis, 1)
+	require.Equal(t, AdminNamespace, apis[0].Namespace)
+	require.False(t, apis[0].Public)
+}
@@ -18,7 +18,9 @@ package config
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path"
+	"strconv"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -87,6 +89,15 @@ const (
 	// DefaultReturnDataLimit is maximum number of bytes returned from eth_call or similar invocations
 	DefaultReturnDataLimit = 100000
 
+	// DefaultIPCPermissions is the default file mode of the JSON-RPC IPC socket, only accessible by the node's user
+	DefaultIPCPermissions = "0600"
+
+	// DefaultReadyMaxIndexerLag is the default number of blocks the EVM indexer can lag the chain tip while ready
+	DefaultReadyMaxIndexerLag int64 = 10
+
+	// DefaultReadyMaxBlockAge is the default age of the latest block after which the node isn't ready
+	DefaultReadyMaxBlockAge = time.Minute
+
 	// DefaultRosettaEnable is the default value for the parameter that defines if the Rosetta API server is enabled
 	DefaultRosettaEnable = false
 
@@ -186,6 +197,19 @@ type JSONRPCConfig struct {
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 	// ReturnDataLimit defines maximum number of bytes returned from `eth_call` or similar invocations
 	ReturnDataLimit int64 `mapstructure:"return-data-limit"`
+	// GraphQLEnable defines if the EIP-1767 GraphQL endpoint should be served on the JSON-RPC server.
+	GraphQLEnable bool `mapstructure:"graphql-enable"`
+	// IPCPath defines the unix socket the JSON-RPC server is also served on, relative paths are
+	// resolved against the node home directory. Empty disables the IPC server.
+	IPCPath string `mapstructure:"ipc-path"`
+	// IPCPermissions defines the file mode of the IPC socket, in octal notation.
+	IPCPermissions string `mapstructure:"ipc-permissions"`
+	// ReadyMaxIndexerLag defines the number of blocks the EVM indexer can lag the chain tip before
+	// the /ready endpoint reports the node as not ready, 0 disables the check.
+	ReadyMaxIndexerLag int64 `mapstructure:"ready-max-indexer-lag"`
+	// ReadyMaxBlockAge defines the age of the latest block after which the /ready endpoint reports
+	// the node as not ready, 0 disables the check.
+	ReadyMaxBlockAge time.Duration `mapstructure:"ready-max-block-age"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -304,6 +328,11 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		ReturnDataLimit:          DefaultReturnDataLimit,
+		GraphQLEnable:            false,
+		IPCPath:                  "",
+		IPCPermissions:           DefaultIPCPermissions,
+		ReadyMaxIndexerLag:       DefaultReadyMaxIndexerLag,
+		ReadyMaxBlockAge:         DefaultReadyMaxBlockAge,
 	}
 }
 
@@ -345,6 +374,20 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.ReadyMaxIndexerLag < 0 {
+		return errors.New("JSON-RPC ready max indexer lag cannot be negative")
+	}
+
+	if c.ReadyMaxBlockAge < 0 {
+		return errors.New("JSON-RPC ready max block age cannot be negative")
+	}
+
+	if c.IPCPath != "" {
+		if _, err := c.IPCFileMode(); err != nil {
+			return err
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -358,6 +401,18 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// IPCFileMode returns the file mode of the IPC socket parsed from the octal permissions.
+func (c JSONRPCConfig) IPCFileMode() (os.FileMode, error) {
+	perm, err := strconv.ParseUint(c.IPCPermissions, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid JSON-RPC IPC permissions %q: %w", c.IPCPermissions, err)
+	}
+	if perm > uint64(os.ModePerm) {
+		return 0, fmt.Errorf("invalid JSON-RPC IPC permissions %q: only permission bits are allowed", c.IPCPermissions)
+	}
+	return os.FileMode(perm), nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -439,6 +494,11 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			ReturnDataLimit:          v.GetInt64("json-rpc.return-data-limit"),
+			GraphQLEnable:            v.GetBool("json-rpc.graphql-enable"),
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
+			IPCPermissions:           v.GetString("json-rpc.ipc-permissions"),
+			ReadyMaxIndexerLag:       v.GetInt64("json-rpc.ready-max-indexer-lag"),
+			ReadyMaxBlockAge:         v.GetDuration("json-rpc.ready-max-block-age"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
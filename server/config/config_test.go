@@ -1,6 +1,7 @@
 package config
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,3 +13,37 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestIPCFileMode(t *testing.T) {
+	testCases := []struct {
+		name        string
+		permissions string
+		expMode     os.FileMode
+		expPass     bool
+	}{
+		{"default", DefaultIPCPermissions, 0o600, true},
+		{"group access", "0660", 0o660, true},
+		{"without leading zero", "640", 0o640, true},
+		{"not octal", "0800", 0, false},
+		{"not permission bits", "04600", 0, false},
+		{"empty", "", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.IPCPath = "data/lokad.ipc"
+			cfg.IPCPermissions = tc.permissions
+
+			mode, err := cfg.IPCFileMode()
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMode, mode)
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
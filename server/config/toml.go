@@ -105,6 +105,25 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# GraphQLEnable serves the EIP-1767 GraphQL endpoint at '/graphql' on the JSON-RPC server address.
+graphql-enable = {{ .JSONRPC.GraphQLEnable }}
+
+# IPCPath is the unix socket the JSON-RPC namespaces, including subscriptions, are also served on.
+# It's the private endpoint, so it also serves the admin namespace.
+# Relative paths are resolved against the node home directory, e.g. "data/lokad.ipc". Empty disables it.
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# IPCPermissions is the file mode of the IPC socket, in octal notation.
+ipc-permissions = "{{ .JSONRPC.IPCPermissions }}"
+
+# ReadyMaxIndexerLag is the number of blocks the EVM indexer can lag the chain tip before GET /ready
+# reports the node as not ready. 0 disables the check.
+ready-max-indexer-lag = {{ .JSONRPC.ReadyMaxIndexerLag }}
+
+# ReadyMaxBlockAge is the age of the latest block after which GET /ready reports the node as not ready.
+# 0 disables the check.
+ready-max-block-age = "{{ .JSONRPC.ReadyMaxBlockAge }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -71,6 +71,11 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCGraphQLEnable            = "json-rpc.graphql-enable"
+	JSONRPCIPCPath                  = "json-rpc.ipc-path"
+	JSONRPCIPCPermissions           = "json-rpc.ipc-permissions"
+	JSONRPCReadyMaxIndexerLag       = "json-rpc.ready-max-indexer-lag"
+	JSONRPCReadyMaxBlockAge         = "json-rpc.ready-max-block-age"
 )
 
 // EVM flags
@@ -16,32 +16,62 @@
 package server
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/loka-network/loka/v1/rpc"
+	"github.com/loka-network/loka/v1/rpc/backend"
+	"github.com/loka-network/loka/v1/rpc/graphql"
+	"github.com/loka-network/loka/v1/rpc/health"
+	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/admin"
+	"github.com/loka-network/loka/v1/rpc/stream"
 
 	svrcfg "github.com/loka-network/loka/v1/server/config"
 	evmostypes "github.com/loka-network/loka/v1/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 )
 
-// StartJSONRPC starts the JSON-RPC server
+// AppWithPendingTxStream is implemented by applications which notify the ethereum
+// transactions accepted by CheckTx, it feeds the pending transaction subscriptions.
+type AppWithPendingTxStream interface {
+	RegisterPendingTxListener(listener func(*evmtypes.MsgEthereumTx))
+}
+
+// StartJSONRPC starts the JSON-RPC server, peers is the p2p switch of the in-process node
+// used by the admin namespace, it may be nil.
 func StartJSONRPC(ctx *server.Context,
 	clientCtx client.Context,
 	tmRPCAddr,
 	tmEndpoint string,
 	config *svrcfg.Config,
 	indexer evmostypes.EVMTxIndexer,
+	app AppWithPendingTxStream,
+	peers admin.PeerSwitch,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
+	evtClient, ok := clientCtx.Client.(rpcclient.EventsClient)
+	if !ok {
+		return nil, nil, fmt.Errorf("client %T does not implement EventsClient", clientCtx.Client)
+	}
+
+	rpcStream := stream.NewRPCStreams(evtClient, ctx.Logger, clientCtx.TxConfig.TxDecoder(), evmtypes.NewQueryClient(clientCtx).ValidatorAccount)
+	if app != nil {
+		app.RegisterPendingTxListener(rpcStream.ListenPendingTx)
+	}
+
 	logger := ctx.Logger.With("module", "geth")
 	ethlog.Root().SetHandler(ethlog.FuncHandler(func(r *ethlog.Record) error {
 		switch r.Lvl {
@@ -60,7 +90,7 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, rpcStream, allowUnprotectedTxs, indexer, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -76,6 +106,27 @@ func StartJSONRPC(ctx *server.Context,
 	r := mux.NewRouter()
 	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
 
+	healthClient, ok := clientCtx.Client.(health.Client)
+	if !ok {
+		return nil, nil, fmt.Errorf("client %T does not implement the status and net info queries required by the health checker", clientCtx.Client)
+	}
+	checker := health.NewChecker(ctx.Logger, healthClient, indexer, health.Config{
+		MaxIndexerLag: config.JSONRPC.ReadyMaxIndexerLag,
+		MaxBlockAge:   config.JSONRPC.ReadyMaxBlockAge,
+	})
+	r.HandleFunc("/health", checker.HealthHandler).Methods("GET")
+	r.HandleFunc("/ready", checker.ReadyHandler).Methods("GET")
+
+	if config.JSONRPC.GraphQLEnable {
+		evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+		graphqlHandler, err := graphql.NewHandler(ctx.Logger, evmBackend)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create GraphQL handler: %w", err)
+		}
+		r.Handle("/graphql", graphqlHandler).Methods("POST")
+		ctx.Logger.Info("Serving GraphQL endpoint", "address", config.JSONRPC.Address, "path", "/graphql")
+	}
+
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
 		handlerWithCors = cors.AllowAll()
@@ -121,7 +172,75 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, rpcStream, config)
 	wsSrv.Start()
+	httpSrv.RegisterOnShutdown(func() {
+		if err := wsSrv.Stop(); err != nil {
+			ctx.Logger.Error("failed to close JSON WebSocket server", "error", err.Error())
+		}
+	})
+
+	if ipcPath := config.JSONRPC.IPCPath; ipcPath != "" {
+		if !filepath.IsAbs(ipcPath) {
+			ipcPath = filepath.Join(ctx.Config.RootDir, ipcPath)
+		}
+
+		// the IPC endpoint is private, so it serves the admin namespace on top of the
+		// configured ones.
+		ipcServer := ethrpc.NewServer()
+		ipcAPIs := append(rpc.GetAdminAPIs(ctx, clientCtx, peers, wsSrv), apis...)
+		for _, api := range ipcAPIs {
+			if err := ipcServer.RegisterName(api.Namespace, api.Service); err != nil {
+				ctx.Logger.Error(
+					"failed to register service in JSON RPC IPC namespace",
+					"namespace", api.Namespace,
+					"service", api.Service,
+				)
+				_ = wsSrv.Stop()
+				_ = httpSrv.Close()
+				return nil, nil, err
+			}
+		}
+
+		ipcLn, err := startJSONRPCIPC(ctx, ipcServer, ipcPath, config)
+		if err != nil {
+			ctx.Logger.Error("failed to boot JSON-RPC IPC server", "path", ipcPath, "error", err.Error())
+			_ = wsSrv.Stop()
+			_ = httpSrv.Close()
+			return nil, nil, err
+		}
+
+		// the IPC server shares the lifecycle of the http server, so it's closed
+		// with the same Shutdown call.
+		httpSrv.RegisterOnShutdown(func() {
+			if err := ipcLn.Close(); err != nil {
+				ctx.Logger.Error("failed to close JSON-RPC IPC server", "error", err.Error())
+			}
+			ipcServer.Stop()
+		})
+	}
+
 	return httpSrv, httpSrvDone, nil
 }
+
+// startJSONRPCIPC serves the JSON-RPC server on the unix socket at path, the connections
+// are full duplex so the subscriptions are supported as well.
+func startJSONRPCIPC(ctx *server.Context, rpcServer *ethrpc.Server, path string, config *svrcfg.Config) (net.Listener, error) {
+	perm, err := config.JSONRPC.IPCFileMode()
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := ListenIPC(path, perm)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ctx.Logger.Info("Starting JSON-RPC IPC server", "path", path)
+		if err := rpcServer.ServeListener(ln); err != nil && !errors.Is(err, net.ErrClosed) {
+			ctx.Logger.Error("failed to serve JSON-RPC IPC server", "error", err.Error())
+		}
+	}()
+	return ln, nil
+}
@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"io"
 	"net"
-	"net/http"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
@@ -67,6 +66,7 @@ import (
 
 	"github.com/loka-network/loka/v1/cmd/lokad/opendb"
 	"github.com/loka-network/loka/v1/indexer"
+	"github.com/loka-network/loka/v1/rpc/namespaces/ethereum/admin"
 	ethdebug "github.com/loka-network/loka/v1/rpc/namespaces/ethereum/debug"
 	"github.com/loka-network/loka/v1/server/config"
 	srvflags "github.com/loka-network/loka/v1/server/flags"
@@ -222,6 +222,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowIndexerGap, true, "Allow block gap for the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().Bool(srvflags.JSONRPCGraphQLEnable, false, "Define if the EIP-1767 GraphQL endpoint should be served on the JSON-RPC server")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the unix socket the JSON-RPC server is also served on, relative to the home directory (empty=disabled)")
+	cmd.Flags().String(srvflags.JSONRPCIPCPermissions, config.DefaultIPCPermissions, "the file mode of the JSON-RPC IPC socket, in octal notation")
+	cmd.Flags().Int64(srvflags.JSONRPCReadyMaxIndexerLag, config.DefaultReadyMaxIndexerLag, "the number of blocks the EVM indexer can lag the chain tip while the JSON-RPC server is ready (0=disabled)")
+	cmd.Flags().Duration(srvflags.JSONRPCReadyMaxBlockAge, config.DefaultReadyMaxBlockAge, "the age of the latest block after which the JSON-RPC server isn't ready (0=disabled)")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -462,21 +467,14 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	startAPIServer(ctx, svrCtx, clientCtx, g, config.Config, app, grpcSrv, metrics)
 
-	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
-	if httpSrv != nil {
-		defer func() {
-			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancelFn()
-			if err := httpSrv.Shutdown(shutdownCtx); err != nil {
-				logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-httpSrvDone:
-				}
-			}
-		}()
+	var peers admin.PeerSwitch
+	if tmNode != nil {
+		peers = tmNode.Switch()
+	}
+
+	clientCtx, err = startJSONRPCServer(ctx, svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer, app, peers)
+	if err != nil {
+		return err
 	}
 
 	// At this point it is safe to block the process if we're in query only mode as
@@ -647,8 +645,10 @@ func startAPIServer(
 	})
 }
 
-// startJSONRPCServer starts a JSON-RPC server based on the provided configuration.
+// startJSONRPCServer starts a JSON-RPC server based on the provided configuration,
+// it's shut down, along with its IPC socket, once ctx is done.
 // Parameters:
+// - ctx: The context whose cancellation shuts the server down.
 // - svrCtx: The server context containing configuration, logger, and stateful components.
 // - clientCtx: The client context, which may be updated with additional chain information.
 // - g: An errgroup.Group to manage concurrent goroutines and error handling.
@@ -656,7 +656,10 @@ func startAPIServer(
 // - genDocProvider: A function that provides the Genesis document, used to retrieve the chain ID.
 // - cmtRPCAddr: The address of the CometBFT RPC server for WebSocket connections.
 // - idxer: The EVM transaction indexer for indexing transactions.
+// - app: The application, which feeds the pending transaction subscriptions if it implements AppWithPendingTxStream.
+// - peers: The p2p switch of the in-process node used by the admin namespace, nil if it's not running in process.
 func startJSONRPCServer(
+	ctx context.Context,
 	svrCtx *server.Context,
 	clientCtx client.Context,
 	g *errgroup.Group,
@@ -664,24 +667,42 @@ func startJSONRPCServer(
 	genDocProvider node.GenesisDocProvider,
 	cmtRPCAddr string,
 	idxer evmostypes.EVMTxIndexer,
-) (ctx client.Context, httpSrv *http.Server, httpSrvDone chan struct{}, err error) {
-	ctx = clientCtx
+	app types.Application,
+	peers admin.PeerSwitch,
+) (client.Context, error) {
 	if !config.JSONRPC.Enable {
-		return
+		return clientCtx, nil
 	}
 
 	genDoc, err := genDocProvider()
 	if err != nil {
-		return ctx, httpSrv, httpSrvDone, err
+		return clientCtx, err
 	}
 
-	ctx = clientCtx.WithChainID(genDoc.ChainID)
+	logger := svrCtx.Logger
 	cmtEndpoint := "/websocket"
+	txApp, _ := app.(AppWithPendingTxStream)
 	g.Go(func() error {
-		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer)
-		return err
+		httpSrv, httpSrvDone, err := StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer, txApp, peers)
+		if err != nil {
+			return err
+		}
+
+		<-ctx.Done()
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelFn()
+		if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+			logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+		} else {
+			logger.Info("HTTP server shut down, waiting 5 sec")
+			select {
+			case <-time.Tick(5 * time.Second):
+			case <-httpSrvDone:
+			}
+		}
+		return nil
 	})
-	return
+	return clientCtx.WithChainID(genDoc.ChainID), nil
 }
 
 func startRosettaServer(
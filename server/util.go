@@ -16,8 +16,11 @@
 package server
 
 import (
+	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	// TODO update import to local pkg when rpc pkg is migrated
@@ -124,6 +127,28 @@ func MountGRPCWebServices(
 	}
 }
 
+// ListenIPC starts a net.Listener on the unix socket at the given path, creating its directory
+// and replacing any stale socket left over by a previous run. The socket file is removed
+// when the listener is closed.
+func ListenIPC(path string, perm os.FileMode) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to remove stale IPC socket %s: %w", path, err)
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, perm); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
+
 // Listen starts a net.Listener on the tcp network on the given address.
 // If there is a specified MaxOpenConnections in the config, it will also set the limitListener.
 func Listen(addr string, config *config.Config) (net.Listener, error) {
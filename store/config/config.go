@@ -2,7 +2,13 @@ package config
 
 import "github.com/crypto-org-chain/cronos/memiavl"
 
-const DefaultCacheSize = 1000
+const (
+	DefaultCacheSize           = 1000
+	DefaultHistoricalCacheSize = 8
+	// DefaultArchiveQueryConcurrency is the default max number of the concurrent historical views reconstructed
+	// from the WAL archive.
+	DefaultArchiveQueryConcurrency = 1
+)
 
 type MemIAVLConfig struct {
 	// Enable defines if the memiavl should be enabled.
@@ -21,12 +27,30 @@ type MemIAVLConfig struct {
 	SnapshotInterval uint32 `mapstructure:"snapshot-interval"`
 	// CacheSize defines the size of the cache for each memiavl store.
 	CacheSize int `mapstructure:"cache-size"`
+	// MaxDeltaSnapshots defines the max number of consecutive incremental snapshots written on top of a full
+	// snapshot, 0 means always writing full snapshots.
+	MaxDeltaSnapshots uint32 `mapstructure:"max-delta-snapshots"`
+	// HistoricalCacheSize defines the max number of the historical versions kept in memory to serve the queries
+	// at the past heights, 0 disables the cache.
+	HistoricalCacheSize int `mapstructure:"historical-cache-size"`
+	// SnapshotFormat defines the format of the state-sync snapshots created, 0 streams the tree nodes and is
+	// compatible with the nodes of the older versions, 1 streams the raw memiavl snapshot files.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
+	// WALArchiveDir defines the directory to archive the WAL entries truncated behind the oldest retained snapshot,
+	// as compressed change set files compatible with versiondb, empty means no archiving.
+	WALArchiveDir string `mapstructure:"wal-archive-dir"`
+	// ArchiveQueryConcurrency defines the max number of the concurrent reconstructions of the historical versions
+	// from the WAL archive, to serve the queries and proofs at the heights behind the retained snapshots,
+	// 0 disables the reconstructions.
+	ArchiveQueryConcurrency int `mapstructure:"archive-query-concurrency"`
 }
 
 func DefaultMemIAVLConfig() MemIAVLConfig {
 	return MemIAVLConfig{
-		CacheSize:          DefaultCacheSize,
-		SnapshotInterval:   memiavl.DefaultSnapshotInterval,
-		SnapshotKeepRecent: 1,
+		CacheSize:               DefaultCacheSize,
+		SnapshotInterval:        memiavl.DefaultSnapshotInterval,
+		SnapshotKeepRecent:      1,
+		HistoricalCacheSize:     DefaultHistoricalCacheSize,
+		ArchiveQueryConcurrency: DefaultArchiveQueryConcurrency,
 	}
 }
@@ -29,4 +29,28 @@ snapshot-interval = {{ .MemIAVL.SnapshotInterval }}
 
 # CacheSize defines the size of the cache for each memiavl store, default to 1000.
 cache-size = {{ .MemIAVL.CacheSize }}
+
+# MaxDeltaSnapshots defines the max number of consecutive incremental snapshots written on top of a full snapshot,
+# the incremental snapshots only write the nodes changed since the full snapshot, default to 0 which means always
+# writing full snapshots.
+max-delta-snapshots = {{ .MemIAVL.MaxDeltaSnapshots }}
+
+# HistoricalCacheSize defines the max number of the historical versions kept in memory to serve the queries at the
+# past heights, the missing versions are built from the nearest cached one if possible, default to 8, 0 disables it.
+historical-cache-size = {{ .MemIAVL.HistoricalCacheSize }}
+
+# SnapshotFormat defines the format of the state-sync snapshots created, 0 streams the tree nodes and is compatible
+# with the nodes of the older versions, 1 streams the raw memiavl snapshot files which are verified and installed
+# directly by the receiver, only the nodes supporting it can restore them, default to 0.
+snapshot-format = {{ .MemIAVL.SnapshotFormat }}
+
+# WALArchiveDir defines the directory to archive the WAL entries truncated behind the oldest retained snapshot, as
+# compressed change set files compatible with versiondb, a relative path is relative to the node home directory,
+# default to empty which means no archiving.
+wal-archive-dir = "{{ .MemIAVL.WALArchiveDir }}"
+
+# ArchiveQueryConcurrency defines the max number of the concurrent reconstructions of the historical versions from
+# the WAL archive, to serve the queries and proofs (e.g. eth_getProof) at the heights behind the retained snapshots,
+# the reconstructed versions are cached like the other historical versions, default to 1, 0 disables them.
+archive-query-concurrency = {{ .MemIAVL.ArchiveQueryConcurrency }}
 `
@@ -0,0 +1,360 @@
+package rootmulti
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+
+	"github.com/crypto-org-chain/cronos/memiavl"
+	"github.com/crypto-org-chain/cronos/store/memiavlstore"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// multiTree is the read interface shared by the latest db and the historical views.
+type multiTree interface {
+	TreeByName(name string) *memiavl.Tree
+	LastCommitInfo() *memiavl.CommitInfo
+}
+
+// historicalView is a read-only memiavl tree at a historical version, it's shared by the concurrent queries at the
+// same height, and released when it's evicted from the cache and no query is using it anymore.
+type historicalView struct {
+	version int64
+	tree    *memiavl.MultiTree
+
+	// db is set if the view is loaded from a snapshot, it owns the mmap-ed snapshot files.
+	db *memiavl.DB
+	// base is set if the view is derived from an older view, the snapshot files are owned by the base view.
+	base *historicalView
+	// if neither of them is set, the view is reconstructed from the WAL archive and the tree owns the snapshot files.
+
+	// refs and evicted are protected by the mutex of `historicalViews`.
+	refs    int
+	evicted bool
+	elem    *list.Element
+}
+
+// historicalViews is a bounded LRU cache of the historical views keyed by version, a missing view is built by
+// replaying the WAL on top of the nearest older view in the cache if it's not older than the snapshot a full load
+// would start from, otherwise it's loaded from the snapshot.
+type historicalViews struct {
+	dir    string
+	logger log.Logger
+
+	mtx   sync.Mutex
+	opts  memiavl.Options
+	size  int
+	views map[int64]*historicalView
+	// archiveSem caps the concurrent reconstructions from the WAL archive, nil disables them.
+	archiveSem chan struct{}
+	// the most recently used view is in the front
+	lru *list.List
+}
+
+func newHistoricalViews(dir string, logger log.Logger, size, archiveConcurrency int) *historicalViews {
+	hv := &historicalViews{
+		dir:    dir,
+		logger: logger,
+		size:   size,
+		views:  make(map[int64]*historicalView),
+		lru:    list.New(),
+	}
+	hv.setArchiveConcurrency(archiveConcurrency)
+	return hv
+}
+
+func (hv *historicalViews) setOptions(opts memiavl.Options) {
+	hv.mtx.Lock()
+	defer hv.mtx.Unlock()
+
+	// the views are shared and could be closed once released, so the returned slices must not point to the mmap-ed
+	// files.
+	opts.ZeroCopy = false
+	opts.ReadOnly = true
+	opts.CreateIfMissing = false
+	opts.LoadForOverwriting = false
+	hv.opts = opts
+}
+
+func (hv *historicalViews) setSize(size int) {
+	hv.mtx.Lock()
+	defer hv.mtx.Unlock()
+
+	hv.size = size
+	hv.evictLocked()
+}
+
+// setArchiveConcurrency sets the max number of the concurrent reconstructions from the WAL archive, 0 disables them.
+func (hv *historicalViews) setArchiveConcurrency(n int) {
+	hv.mtx.Lock()
+	defer hv.mtx.Unlock()
+
+	hv.archiveSem = nil
+	if n > 0 {
+		hv.archiveSem = make(chan struct{}, n)
+	}
+}
+
+// acquire returns the view at the version, the caller must call `release` after it's done with the view.
+func (hv *historicalViews) acquire(version int64) (*historicalView, error) {
+	if version <= 0 || version > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid historical version: %d", version)
+	}
+
+	hv.mtx.Lock()
+	if view, ok := hv.views[version]; ok {
+		view.refs++
+		hv.lru.MoveToFront(view.elem)
+		hv.mtx.Unlock()
+		telemetry.IncrCounter(1, "store", "memiavl", "historical", "hit")
+		return view, nil
+	}
+
+	// the nearest older view, it's retained until the new view is built.
+	var src *historicalView
+	for v, view := range hv.views {
+		if v < version && (src == nil || v > src.version) {
+			src = view
+		}
+	}
+	if src != nil {
+		src.refs++
+	}
+	opts := hv.opts
+	archiveSem := hv.archiveSem
+	hv.mtx.Unlock()
+
+	telemetry.IncrCounter(1, "store", "memiavl", "historical", "miss")
+
+	view, err := hv.build(version, src, opts, archiveSem)
+	if src != nil {
+		err = errors.Join(err, hv.release(src))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hv.mtx.Lock()
+	defer hv.mtx.Unlock()
+
+	if existing, ok := hv.views[version]; ok {
+		// built concurrently by another query
+		existing.refs++
+		hv.lru.MoveToFront(existing.elem)
+		view.refs--
+		return existing, view.closeLocked(hv)
+	}
+
+	hv.views[version] = view
+	view.elem = hv.lru.PushFront(view)
+	hv.evictLocked()
+	return view, nil
+}
+
+// build creates a new view at the version with a reference count of one.
+func (hv *historicalViews) build(
+	version int64, src *historicalView, opts memiavl.Options, archiveSem chan struct{},
+) (*historicalView, error) {
+	if src != nil {
+		snapshotVersion, err := memiavl.SeekSnapshot(hv.dir, uint32(version))
+		if err != nil && opts.WALArchiveDir == "" {
+			return nil, err
+		}
+		// the versions behind the retained snapshots are derived from the views reconstructed from the archive
+		if err != nil || src.version >= snapshotVersion {
+			view, err := hv.derive(version, src, opts)
+			if err == nil {
+				return view, nil
+			}
+			hv.logger.Error("fail to derive historical view, fallback to load snapshot",
+				"version", version, "base", src.version, "err", err)
+		}
+	}
+
+	opts.TargetVersion = uint32(version)
+	db, err := memiavl.Load(hv.dir, opts)
+	if err == nil && db.Version() != version {
+		err = errors.Join(
+			fmt.Errorf("version %d is not available, latest: %d", version, db.Version()),
+			db.Close(),
+		)
+	}
+	if err != nil {
+		if opts.WALArchiveDir == "" || archiveSem == nil {
+			return nil, err
+		}
+		view, archiveErr := hv.buildFromArchive(version, opts, archiveSem)
+		if archiveErr != nil {
+			return nil, errors.Join(err, archiveErr)
+		}
+		return view, nil
+	}
+	return &historicalView{version: version, tree: &db.MultiTree, db: db, refs: 1}, nil
+}
+
+// buildFromArchive reconstructs the view from the WAL archive, if the version is not reachable from the retained
+// snapshots and the WAL.
+func (hv *historicalViews) buildFromArchive(
+	version int64, opts memiavl.Options, archiveSem chan struct{},
+) (*historicalView, error) {
+	archiveSem <- struct{}{}
+	defer func() { <-archiveSem }()
+
+	telemetry.IncrCounter(1, "store", "memiavl", "historical", "archive")
+	tree, err := memiavl.LoadArchivedVersion(hv.dir, opts.WALArchiveDir, version, opts.CacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &historicalView{version: version, tree: tree, refs: 1}, nil
+}
+
+// derive creates a view by replaying the WAL on top of a copy of an older view.
+func (hv *historicalViews) derive(version int64, src *historicalView, opts memiavl.Options) (*historicalView, error) {
+	hv.mtx.Lock()
+	// `Copy` marks the in-memory nodes of the source tree copy-on-write, serialize it with the other copies.
+	tree := src.tree.Copy(opts.CacheSize)
+	base := src
+	if src.base != nil {
+		base = src.base
+	}
+	base.refs++
+	hv.mtx.Unlock()
+
+	view := &historicalView{version: version, tree: tree, base: base, refs: 1, evicted: true}
+	catchup := memiavl.CatchupWAL
+	if opts.WALArchiveDir != "" {
+		catchup = func(dir string, mtree *memiavl.MultiTree, endVersion int64) error {
+			return memiavl.CatchupArchive(dir, opts.WALArchiveDir, mtree, endVersion)
+		}
+	}
+	if err := catchup(hv.dir, tree, version); err != nil {
+		return nil, errors.Join(err, hv.release(view))
+	}
+	if tree.Version() != version {
+		return nil, errors.Join(
+			fmt.Errorf("version %d is not available, latest: %d", version, tree.Version()),
+			hv.release(view),
+		)
+	}
+	view.evicted = false
+	return view, nil
+}
+
+// release decreases the reference count of the view, the view is closed if it's evicted and not used anymore.
+func (hv *historicalViews) release(view *historicalView) error {
+	hv.mtx.Lock()
+	defer hv.mtx.Unlock()
+
+	return hv.releaseLocked(view)
+}
+
+func (hv *historicalViews) releaseLocked(view *historicalView) error {
+	if view.refs <= 0 {
+		return nil
+	}
+	view.refs--
+	if view.refs == 0 && view.evicted {
+		return view.closeLocked(hv)
+	}
+	return nil
+}
+
+// closeLocked closes the view which is already removed from the cache.
+func (view *historicalView) closeLocked(hv *historicalViews) error {
+	if view.refs > 0 || view.tree == nil {
+		return nil
+	}
+	tree := view.tree
+	view.tree = nil
+	switch {
+	case view.db != nil:
+		return view.db.Close()
+	case view.base != nil:
+		return hv.releaseLocked(view.base)
+	default:
+		return tree.Close()
+	}
+}
+
+// evictLocked removes the least recently used views until the cache fits the size.
+func (hv *historicalViews) evictLocked() {
+	for hv.lru.Len() > 0 && hv.lru.Len() > hv.size {
+		hv.removeLocked(hv.lru.Back().Value.(*historicalView))
+	}
+	telemetry.SetGauge(float32(hv.lru.Len()), "store", "memiavl", "historical", "views")
+}
+
+func (hv *historicalViews) removeLocked(view *historicalView) {
+	hv.lru.Remove(view.elem)
+	delete(hv.views, view.version)
+	view.elem = nil
+	view.evicted = true
+	if view.refs == 0 {
+		if err := view.closeLocked(hv); err != nil {
+			hv.logger.Error("fail to close historical view", "version", view.version, "err", err)
+		}
+	}
+}
+
+// purge removes all the views from the cache, the ones still in use are closed when they are released.
+func (hv *historicalViews) purge() {
+	hv.mtx.Lock()
+	defer hv.mtx.Unlock()
+
+	for hv.lru.Len() > 0 {
+		hv.removeLocked(hv.lru.Back().Value.(*historicalView))
+	}
+	telemetry.SetGauge(0, "store", "memiavl", "historical", "views")
+}
+
+// viewHandle releases the view when closed, or when it's garbage collected if the owner never closes it.
+type viewHandle struct {
+	views *historicalViews
+	view  *historicalView
+	once  sync.Once
+}
+
+var _ io.Closer = (*viewHandle)(nil)
+
+func newViewHandle(views *historicalViews, view *historicalView) *viewHandle {
+	handle := &viewHandle{views: views, view: view}
+	runtime.SetFinalizer(handle, func(h *viewHandle) {
+		if err := h.Close(); err != nil {
+			h.views.logger.Error("fail to release historical view", "version", h.view.version, "err", err)
+		}
+	})
+	return handle
+}
+
+func (h *viewHandle) Close() error {
+	var err error
+	h.once.Do(func() {
+		err = h.views.release(h.view)
+	})
+	return err
+}
+
+// historicalStore keeps the view handle reachable as long as the store or its cache wraps are in use.
+type historicalStore struct {
+	*memiavlstore.Store
+	handle *viewHandle
+}
+
+func (st *historicalStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *historicalStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
@@ -0,0 +1,170 @@
+package rootmulti
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/crypto-org-chain/cronos/memiavl"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func setupHistoricalDB(t *testing.T, versions int) string {
+	dir := t.TempDir()
+	db, err := memiavl.Load(dir, memiavl.Options{
+		CreateIfMissing: true,
+		InitialStores:   []string{"test"},
+	})
+	require.NoError(t, err)
+
+	for i := 1; i <= versions; i++ {
+		require.NoError(t, db.ApplyChangeSets([]*memiavl.NamedChangeSet{
+			{
+				Name: "test",
+				Changeset: memiavl.ChangeSet{Pairs: []*memiavl.KVPair{
+					{Key: []byte("version"), Value: []byte(strconv.Itoa(i))},
+				}},
+			},
+		}))
+		_, err := db.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.Close())
+	return dir
+}
+
+func requireViewVersion(t *testing.T, view *historicalView, version int64) {
+	require.Equal(t, version, view.tree.Version())
+	require.Equal(t, []byte(strconv.FormatInt(version, 10)), view.tree.TreeByName("test").Get([]byte("version")))
+}
+
+func TestHistoricalViews(t *testing.T) {
+	dir := setupHistoricalDB(t, 10)
+	hv := newHistoricalViews(dir, log.NewNopLogger(), 2, 0)
+	hv.setOptions(memiavl.Options{})
+
+	v3, err := hv.acquire(3)
+	require.NoError(t, err)
+	requireViewVersion(t, v3, 3)
+	require.NotNil(t, v3.db)
+
+	// derived from the cached older view
+	v5, err := hv.acquire(5)
+	require.NoError(t, err)
+	requireViewVersion(t, v5, 5)
+	require.Nil(t, v5.db)
+	require.Equal(t, v3, v5.base)
+
+	// cache hit
+	v5Again, err := hv.acquire(5)
+	require.NoError(t, err)
+	require.Equal(t, v5, v5Again)
+	require.NoError(t, hv.release(v5Again))
+
+	// derived from a derived view shares the same base
+	v7, err := hv.acquire(7)
+	require.NoError(t, err)
+	requireViewVersion(t, v7, 7)
+	require.Equal(t, v3, v7.base)
+
+	// v3 is evicted but still in use
+	require.NotContains(t, hv.views, int64(3))
+	require.True(t, v3.evicted)
+	requireViewVersion(t, v3, 3)
+	require.NoError(t, hv.release(v3))
+	// the snapshot is retained by the derived views
+	require.NotNil(t, v3.tree)
+	requireViewVersion(t, v5, 5)
+
+	require.NoError(t, hv.release(v5))
+	require.NoError(t, hv.release(v7))
+
+	hv.purge()
+	require.Empty(t, hv.views)
+	require.Nil(t, v3.tree)
+	require.Nil(t, v5.tree)
+	require.Nil(t, v7.tree)
+
+	_, err = hv.acquire(11)
+	require.Error(t, err)
+}
+
+func TestHistoricalViewsDisabled(t *testing.T) {
+	dir := setupHistoricalDB(t, 3)
+	hv := newHistoricalViews(dir, log.NewNopLogger(), 0, 0)
+	hv.setOptions(memiavl.Options{})
+
+	view, err := hv.acquire(2)
+	require.NoError(t, err)
+	requireViewVersion(t, view, 2)
+	require.Empty(t, hv.views)
+
+	require.NoError(t, hv.release(view))
+	require.Nil(t, view.tree)
+}
+
+func TestHistoricalViewsArchive(t *testing.T) {
+	dir := t.TempDir()
+	archiveDir := filepath.Join(t.TempDir(), "archive")
+	opts := memiavl.Options{
+		CreateIfMissing:    true,
+		InitialStores:      []string{"test"},
+		SnapshotInterval:   5,
+		SnapshotKeepRecent: 0,
+		WALArchiveDir:      archiveDir,
+	}
+	db, err := memiavl.Load(dir, opts)
+	require.NoError(t, err)
+
+	// commit until the initial snapshot is pruned and the wal is truncated behind the archive
+	commit := func() {
+		version := db.Version() + 1
+		require.NoError(t, db.ApplyChangeSets([]*memiavl.NamedChangeSet{
+			{
+				Name: "test",
+				Changeset: memiavl.ChangeSet{Pairs: []*memiavl.KVPair{
+					{Key: []byte("version"), Value: []byte(strconv.FormatInt(version, 10))},
+				}},
+			},
+		}))
+		_, err := db.Commit()
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		commit()
+		entries, err := os.ReadDir(filepath.Join(dir, "wal"))
+		require.NoError(t, err)
+		return len(entries) > 0 && entries[0].Name() != "00000000000000000001"
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, db.Close())
+
+	hv := newHistoricalViews(dir, log.NewNopLogger(), 2, 0)
+	hv.setOptions(memiavl.Options{WALArchiveDir: archiveDir})
+
+	// the reconstruction is disabled
+	_, err = hv.acquire(2)
+	require.Error(t, err)
+
+	hv.setArchiveConcurrency(1)
+	view, err := hv.acquire(2)
+	require.NoError(t, err)
+	requireViewVersion(t, view, 2)
+	require.Nil(t, view.db)
+	require.Nil(t, view.base)
+
+	// derived from the reconstructed view
+	v3, err := hv.acquire(3)
+	require.NoError(t, err)
+	requireViewVersion(t, v3, 3)
+	require.Equal(t, view, v3.base)
+
+	require.NoError(t, hv.release(view))
+	require.NoError(t, hv.release(v3))
+	hv.purge()
+	require.Nil(t, view.tree)
+	require.Nil(t, v3.tree)
+}
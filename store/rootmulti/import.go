@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -36,6 +37,24 @@ func (rs *Store) Restore(
 
 func (rs *Store) restore(
 	height uint64, format uint32, protoReader protoio.Reader,
+) (types.SnapshotItem, error) {
+	snapshotItem, err := readSnapshotItem(protoReader)
+	if err != nil {
+		return types.SnapshotItem{}, err
+	}
+
+	if ext := snapshotItem.GetExtension(); ext != nil && ext.Name == memiavlSnapshotExtension {
+		if ext.Format != SnapshotFileFormatV1 {
+			return types.SnapshotItem{}, cosmoserrors.Wrapf(types.ErrUnknownFormat, "memiavl snapshot file format %v", ext.Format)
+		}
+		return rs.restoreFiles(height, protoReader)
+	}
+	return rs.restoreNodes(height, snapshotItem, protoReader)
+}
+
+// restoreNodes rebuilds the trees from the nodes, starting from the item already read.
+func (rs *Store) restoreNodes(
+	height uint64, snapshotItem types.SnapshotItem, protoReader protoio.Reader,
 ) (types.SnapshotItem, error) {
 	importer, err := memiavl.NewMultiTreeImporter(rs.dir, height)
 	if err != nil {
@@ -43,17 +62,8 @@ func (rs *Store) restore(
 	}
 	defer importer.Close()
 
-	var snapshotItem types.SnapshotItem
 loop:
-	for {
-		snapshotItem = types.SnapshotItem{}
-		err := protoReader.ReadMsg(&snapshotItem)
-		if errors.Is(err, io.EOF) {
-			break
-		} else if err != nil {
-			return types.SnapshotItem{}, cosmoserrors.Wrap(err, "invalid protobuf message")
-		}
-
+	for snapshotItem.Item != nil {
 		switch item := snapshotItem.Item.(type) {
 		case *types.SnapshotItem_Store:
 			if err := importer.AddTree(item.Store.Name); err != nil {
@@ -83,6 +93,10 @@ loop:
 			// unknown element, could be an extension
 			break loop
 		}
+
+		if snapshotItem, err = readSnapshotItem(protoReader); err != nil {
+			return types.SnapshotItem{}, err
+		}
 	}
 
 	if err := importer.Finalize(); err != nil {
@@ -91,3 +105,60 @@ loop:
 
 	return snapshotItem, nil
 }
+
+// restoreFiles writes the snapshot files following the extension item, the files are verified before installed.
+func (rs *Store) restoreFiles(height uint64, protoReader protoio.Reader) (types.SnapshotItem, error) {
+	importer, err := memiavl.NewSnapshotFileImporter(rs.dir, height)
+	if err != nil {
+		return types.SnapshotItem{}, err
+	}
+	defer importer.Close()
+
+	var (
+		snapshotItem types.SnapshotItem
+		path         string
+	)
+loop:
+	for {
+		snapshotItem, err = readSnapshotItem(protoReader)
+		if err != nil {
+			return types.SnapshotItem{}, err
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *types.SnapshotItem_Store:
+			path = item.Store.Name
+		case *types.SnapshotItem_ExtensionPayload:
+			if path == "" {
+				return types.SnapshotItem{}, cosmoserrors.Wrap(sdkerrors.ErrLogic, "snapshot file chunk without path")
+			}
+			if err := importer.Add(&memiavl.SnapshotFileChunk{
+				Path: path,
+				Data: item.ExtensionPayload.Payload,
+			}); err != nil {
+				return types.SnapshotItem{}, err
+			}
+		default:
+			// end of stream, or the next extension
+			break loop
+		}
+	}
+
+	if err := importer.Finalize(context.Background()); err != nil {
+		return types.SnapshotItem{}, err
+	}
+
+	return snapshotItem, nil
+}
+
+// readSnapshotItem reads the next item, returns an empty item at the end of the stream.
+func readSnapshotItem(protoReader protoio.Reader) (types.SnapshotItem, error) {
+	var snapshotItem types.SnapshotItem
+	err := protoReader.ReadMsg(&snapshotItem)
+	if errors.Is(err, io.EOF) {
+		return types.SnapshotItem{}, nil
+	} else if err != nil {
+		return types.SnapshotItem{}, cosmoserrors.Wrap(err, "invalid protobuf message")
+	}
+	return snapshotItem, nil
+}
@@ -3,6 +3,7 @@ package rootmulti
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"math"
 
 	protoio "github.com/cosmos/gogoproto/io"
@@ -11,6 +12,27 @@ import (
 	"cosmossdk.io/store/snapshots/types"
 )
 
+const (
+	// SnapshotFormatIAVL streams the tree nodes like the IAVL stores, the receiver rebuilds the trees node by node,
+	// it's compatible with the nodes of the older versions.
+	SnapshotFormatIAVL uint32 = 0
+	// SnapshotFormatMemIAVL streams the raw files of the memiavl snapshot, the receiver verifies the files against
+	// the root hashes in the commit info and installs them directly.
+	SnapshotFormatMemIAVL uint32 = 1
+)
+
+// memiavlSnapshotExtension is the name of the extension item in front of the snapshot files, the nodes of the older
+// versions don't recognize it and fail the restoration.
+const memiavlSnapshotExtension = "memiavl"
+
+// SnapshotFileFormatV1 is the format of the payloads following the memiavl extension item: the path of each file of
+// the full memiavl snapshot followed by its chunks. It's recorded in the extension item and must be bumped whenever
+// the layout of the payloads or of the snapshot files changes, so the nodes reject the formats they don't support.
+const SnapshotFileFormatV1 uint32 = 1
+
+// SnapshotFileFormat is the format of the snapshot file payloads created.
+const SnapshotFileFormat = SnapshotFileFormatV1
+
 // Snapshot Implements interface Snapshotter
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) (returnErr error) {
 	if height > math.MaxUint32 {
@@ -18,6 +40,76 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) (returnErr
 	}
 	version := uint32(height)
 
+	switch rs.snapshotFormat {
+	case SnapshotFormatIAVL:
+		return rs.snapshotNodes(version, protoWriter)
+	case SnapshotFormatMemIAVL:
+		exporter, err := memiavl.NewSnapshotFileExporter(rs.dir, version)
+		if err != nil {
+			if errors.Is(err, memiavl.ErrDeltaSnapshotExport) ||
+				(rs.supportExportNonSnapshotVersion && errors.Is(err, fs.ErrNotExist)) {
+				rs.logger.Info("snapshot files not available, fallback to export tree nodes", "height", height, "err", err)
+				return rs.snapshotNodes(version, protoWriter)
+			}
+			return err
+		}
+		defer func() {
+			returnErr = errors.Join(returnErr, exporter.Close())
+		}()
+		return snapshotFiles(exporter, protoWriter)
+	default:
+		return fmt.Errorf("unknown snapshot format: %d", rs.snapshotFormat)
+	}
+}
+
+// snapshotFiles writes the extension item, followed by the path of each file and the chunks of it.
+func snapshotFiles(exporter *memiavl.SnapshotFileExporter, protoWriter protoio.Writer) error {
+	if err := protoWriter.WriteMsg(&types.SnapshotItem{
+		Item: &types.SnapshotItem_Extension{
+			Extension: &types.SnapshotExtensionMeta{
+				Name:   memiavlSnapshotExtension,
+				Format: SnapshotFileFormat,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	var path string
+	for {
+		chunk, err := exporter.Next()
+		if err != nil {
+			if errors.Is(err, memiavl.ErrorExportDone) {
+				return nil
+			}
+			return err
+		}
+
+		if chunk.Path != path {
+			path = chunk.Path
+			if err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Store{
+					Store: &types.SnapshotStoreItem{
+						Name: path,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if err := protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_ExtensionPayload{
+				ExtensionPayload: &types.SnapshotExtensionPayload{
+					Payload: chunk.Data,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (rs *Store) snapshotNodes(version uint32, protoWriter protoio.Writer) (returnErr error) {
 	exporter, err := memiavl.NewMultiTreeExporter(rs.dir, version, rs.supportExportNonSnapshotVersion)
 	if err != nil {
 		return err
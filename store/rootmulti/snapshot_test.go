@@ -0,0 +1,78 @@
+package rootmulti
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func setupSnapshotStore(t *testing.T, keys []*storetypes.KVStoreKey) *Store {
+	store := NewStore(t.TempDir(), log.NewNopLogger(), false, false)
+	for _, key := range keys {
+		store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	keys := []*storetypes.KVStoreKey{storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("evm")}
+
+	for _, format := range []uint32{SnapshotFormatIAVL, SnapshotFormatMemIAVL} {
+		t.Run(strconv.Itoa(int(format)), func(t *testing.T) {
+			store := setupSnapshotStore(t, keys)
+			store.SetSnapshotFormat(format)
+			for i := 0; i < 10; i++ {
+				for _, key := range keys {
+					store.GetKVStore(key).Set([]byte(strconv.Itoa(i)), []byte(key.Name()))
+				}
+				store.Commit()
+			}
+			require.NoError(t, store.db.RewriteSnapshot())
+			require.NoError(t, store.db.Reload())
+
+			var buf bytes.Buffer
+			writer := protoio.NewDelimitedWriter(&buf)
+			require.NoError(t, store.Snapshot(uint64(store.LastCommitID().Version), writer))
+			// the next extension follows the store items
+			require.NoError(t, writer.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Extension{Extension: &types.SnapshotExtensionMeta{Name: "next", Format: 1}},
+			}))
+
+			restored := setupSnapshotStore(t, keys)
+			reader := protoio.NewDelimitedReader(&buf, 64e6)
+			item, err := restored.Restore(uint64(store.LastCommitID().Version), types.CurrentFormat, reader)
+			require.NoError(t, err)
+			require.Equal(t, "next", item.GetExtension().Name)
+
+			require.Equal(t, store.LastCommitID(), restored.LastCommitID())
+			require.Equal(t, []byte("evm"), restored.GetKVStore(keys[1]).Get([]byte("9")))
+			require.NoError(t, restored.Close())
+			require.NoError(t, store.Close())
+		})
+	}
+}
+
+func TestRestoreUnknownFileFormat(t *testing.T) {
+	keys := []*storetypes.KVStoreKey{storetypes.NewKVStoreKey("bank")}
+
+	var buf bytes.Buffer
+	writer := protoio.NewDelimitedWriter(&buf)
+	require.NoError(t, writer.WriteMsg(&types.SnapshotItem{
+		Item: &types.SnapshotItem_Extension{
+			Extension: &types.SnapshotExtensionMeta{Name: memiavlSnapshotExtension, Format: SnapshotFileFormat + 1},
+		},
+	}))
+
+	// the db of the store is closed by the restoration
+	restored := setupSnapshotStore(t, keys)
+	_, err := restored.Restore(1, types.CurrentFormat, protoio.NewDelimitedReader(&buf, 64e6))
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+}
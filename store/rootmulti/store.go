@@ -10,6 +10,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/crypto-org-chain/cronos/memiavl"
 	"github.com/crypto-org-chain/cronos/store/cachemulti"
+	"github.com/crypto-org-chain/cronos/store/config"
 	"github.com/crypto-org-chain/cronos/store/memiavlstore"
 
 	"cosmossdk.io/errors"
@@ -47,10 +48,15 @@ type Store struct {
 
 	opts memiavl.Options
 
+	// historical is the cache of the read-only views used to serve the queries at the past heights.
+	historical *historicalViews
+
 	// sdk46Compact defines if the root hash is compatible with cosmos-sdk 0.46 and before.
 	sdk46Compact bool
 	// it's more efficient to export snapshot versions, we can filter out the non-snapshot versions
 	supportExportNonSnapshotVersion bool
+	// snapshotFormat defines the format of the state-sync snapshots created.
+	snapshotFormat uint32
 }
 
 func NewStore(dir string, logger log.Logger, sdk46Compact, supportExportNonSnapshotVersion bool) *Store {
@@ -64,6 +70,8 @@ func NewStore(dir string, logger log.Logger, sdk46Compact, supportExportNonSnaps
 		keysByName:   make(map[string]types.StoreKey),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		listeners:    make(map[types.StoreKey]*types.MemoryListener),
+
+		historical: newHistoricalViews(dir, logger, config.DefaultHistoricalCacheSize, config.DefaultArchiveQueryConcurrency),
 	}
 }
 
@@ -137,6 +145,7 @@ func (rs *Store) Commit() types.CommitID {
 }
 
 func (rs *Store) Close() error {
+	rs.historical.purge()
 	return rs.db.Close()
 }
 
@@ -204,13 +213,12 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	if version == 0 || (rs.lastCommitInfo != nil && version == rs.lastCommitInfo.Version) {
 		return rs.CacheMultiStore(), nil
 	}
-	opts := rs.opts
-	opts.TargetVersion = uint32(version)
-	opts.ReadOnly = true
-	db, err := memiavl.Load(rs.dir, opts)
+	view, err := rs.historical.acquire(version)
 	if err != nil {
 		return nil, err
 	}
+	// the view is released when the returned store is closed or garbage collected.
+	handle := newViewHandle(rs.historical, view)
 
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 
@@ -222,11 +230,14 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	}
 
 	// add all the iavl stores at the target version.
-	for _, tree := range db.Trees() {
-		stores[rs.keysByName[tree.Name]] = memiavlstore.New(tree.Tree, rs.logger)
+	for _, tree := range view.tree.Trees() {
+		stores[rs.keysByName[tree.Name]] = &historicalStore{
+			Store:  memiavlstore.New(tree.Tree, rs.logger),
+			handle: handle,
+		}
 	}
 
-	return cachemulti.NewStore(stores, nil, nil, nil), nil
+	return cachemulti.NewStore(stores, nil, nil, handle), nil
 }
 
 // GetStore Implements interface MultiStore
@@ -325,6 +336,9 @@ func (rs *Store) LoadVersionAndUpgrade(version int64, upgrades *types.StoreUpgra
 		return fmt.Errorf("version overflows uint32: %d", version)
 	}
 
+	// the cached historical versions could be rewritten by the upgrades or the rollback.
+	rs.historical.purge()
+
 	storesKeys := make([]types.StoreKey, 0, len(rs.storesParams))
 	for key := range rs.storesParams {
 		storesKeys = append(storesKeys, key)
@@ -459,6 +473,25 @@ func (rs *Store) SetMemIAVLOptions(opts memiavl.Options) {
 		opts.Logger = memiavl.Logger(rs.logger.With("module", "memiavl"))
 	}
 	rs.opts = opts
+	rs.historical.setOptions(opts)
+}
+
+// SetHistoricalCacheSize sets the max number of the historical versions kept in memory to serve the queries,
+// 0 disables the cache.
+func (rs *Store) SetHistoricalCacheSize(size int) {
+	rs.historical.setSize(size)
+}
+
+// SetArchiveQueryConcurrency sets the max number of the concurrent reconstructions of the historical versions from the
+// WAL archive, which serve the versions behind the retained snapshots if `WALArchiveDir` is set, 0 disables them.
+func (rs *Store) SetArchiveQueryConcurrency(n int) {
+	rs.historical.setArchiveConcurrency(n)
+}
+
+// SetSnapshotFormat sets the format of the state-sync snapshots created, the snapshots of all the formats can be
+// restored.
+func (rs *Store) SetSnapshotFormat(format uint32) {
+	rs.snapshotFormat = format
 }
 
 // RollbackToVersion delete the versions after `target` and update the latest version.
@@ -472,6 +505,8 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		return fmt.Errorf("rollback height target %d exceeds max uint32", target)
 	}
 
+	rs.historical.purge()
+
 	if rs.db != nil {
 		if err := rs.db.Close(); err != nil {
 			return err
@@ -547,14 +582,18 @@ func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 	// If the request's height is the latest height we've committed, then utilize
 	// the store's lastCommitInfo as this commit info may not be flushed to disk.
 	// Otherwise, we query for the commit info from disk.
-	db := rs.db
+	var db multiTree = rs.db
 	if version != rs.lastCommitInfo.Version {
-		var err error
-		db, err = memiavl.Load(rs.dir, memiavl.Options{TargetVersion: uint32(version), ReadOnly: true})
+		view, err := rs.historical.acquire(version)
 		if err != nil {
 			return nil, err
 		}
-		defer db.Close()
+		defer func() {
+			if err := rs.historical.release(view); err != nil {
+				rs.logger.Error("fail to release historical view", "version", version, "err", err)
+			}
+		}()
+		db = view.tree
 	}
 
 	path := req.Path
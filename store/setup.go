@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	"github.com/crypto-org-chain/cronos/memiavl"
+	"github.com/crypto-org-chain/cronos/store/config"
 	"github.com/crypto-org-chain/cronos/store/rootmulti"
 	"github.com/spf13/cast"
 
@@ -15,13 +16,18 @@ import (
 )
 
 const (
-	FlagMemIAVL             = "memiavl.enable"
-	FlagAsyncCommitBuffer   = "memiavl.async-commit-buffer"
-	FlagZeroCopy            = "memiavl.zero-copy"
-	FlagSnapshotKeepRecent  = "memiavl.snapshot-keep-recent"
-	FlagSnapshotInterval    = "memiavl.snapshot-interval"
-	FlagCacheSize           = "memiavl.cache-size"
-	FlagSnapshotWriterLimit = "memiavl.snapshot-writer-limit"
+	FlagMemIAVL                 = "memiavl.enable"
+	FlagAsyncCommitBuffer       = "memiavl.async-commit-buffer"
+	FlagZeroCopy                = "memiavl.zero-copy"
+	FlagSnapshotKeepRecent      = "memiavl.snapshot-keep-recent"
+	FlagSnapshotInterval        = "memiavl.snapshot-interval"
+	FlagCacheSize               = "memiavl.cache-size"
+	FlagSnapshotWriterLimit     = "memiavl.snapshot-writer-limit"
+	FlagHistoricalCacheSize     = "memiavl.historical-cache-size"
+	FlagMaxDeltaSnapshots       = "memiavl.max-delta-snapshots"
+	FlagSnapshotFormat          = "memiavl.snapshot-format"
+	FlagWALArchiveDir           = "memiavl.wal-archive-dir"
+	FlagArchiveQueryConcurrency = "memiavl.archive-query-concurrency"
 )
 
 // SetupMemIAVL insert the memiavl setter in front of baseapp options, so that
@@ -43,6 +49,23 @@ func SetupMemIAVL(
 			SnapshotInterval:    cast.ToUint32(appOpts.Get(FlagSnapshotInterval)),
 			CacheSize:           cacheSize,
 			SnapshotWriterLimit: cast.ToInt(appOpts.Get(FlagSnapshotWriterLimit)),
+			MaxDeltaSnapshots:   cast.ToUint32(appOpts.Get(FlagMaxDeltaSnapshots)),
+		}
+
+		if dir := cast.ToString(appOpts.Get(FlagWALArchiveDir)); dir != "" {
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(homePath, dir)
+			}
+			opts.WALArchiveDir = dir
+		}
+
+		historicalCacheSize := config.DefaultHistoricalCacheSize
+		if v := appOpts.Get(FlagHistoricalCacheSize); v != nil {
+			historicalCacheSize = cast.ToInt(v)
+		}
+		archiveQueryConcurrency := config.DefaultArchiveQueryConcurrency
+		if v := appOpts.Get(FlagArchiveQueryConcurrency); v != nil {
+			archiveQueryConcurrency = cast.ToInt(v)
 		}
 
 		if opts.ZeroCopy {
@@ -52,13 +75,22 @@ func SetupMemIAVL(
 
 		// cms must be overridden before the other options, because they may use the cms,
 		// make sure the cms aren't be overridden by the other options later on.
-		baseAppOptions = append([]func(*baseapp.BaseApp){setMemIAVL(homePath, logger, opts, sdk46Compact, supportExportNonSnapshotVersion)}, baseAppOptions...)
+		snapshotFormat := cast.ToUint32(appOpts.Get(FlagSnapshotFormat))
+
+		baseAppOptions = append([]func(*baseapp.BaseApp){setMemIAVL(homePath, logger, opts, historicalCacheSize, archiveQueryConcurrency, snapshotFormat, sdk46Compact, supportExportNonSnapshotVersion)}, baseAppOptions...)
 	}
 
 	return baseAppOptions
 }
 
-func setMemIAVL(homePath string, logger log.Logger, opts memiavl.Options, sdk46Compact, supportExportNonSnapshotVersion bool) func(*baseapp.BaseApp) {
+func setMemIAVL(
+	homePath string,
+	logger log.Logger,
+	opts memiavl.Options,
+	historicalCacheSize, archiveQueryConcurrency int,
+	snapshotFormat uint32,
+	sdk46Compact, supportExportNonSnapshotVersion bool,
+) func(*baseapp.BaseApp) {
 	return func(bapp *baseapp.BaseApp) {
 		// trigger state-sync snapshot creation by memiavl
 		opts.TriggerStateSyncExport = func(height int64) {
@@ -66,6 +98,9 @@ func setMemIAVL(homePath string, logger log.Logger, opts memiavl.Options, sdk46C
 		}
 		cms := rootmulti.NewStore(filepath.Join(homePath, "data", "memiavl.db"), logger, sdk46Compact, supportExportNonSnapshotVersion)
 		cms.SetMemIAVLOptions(opts)
+		cms.SetHistoricalCacheSize(historicalCacheSize)
+		cms.SetArchiveQueryConcurrency(archiveQueryConcurrency)
+		cms.SetSnapshotFormat(snapshotFormat)
 		bapp.SetCMS(cms)
 	}
 }
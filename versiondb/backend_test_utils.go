@@ -56,6 +56,8 @@ func Run(t *testing.T, storeCreator func() VersionStore) {
 	testBasics(t, storeCreator())
 	testIterator(t, storeCreator())
 	testHeightInFuture(t, storeCreator())
+	testPrune(t, storeCreator())
+	testHistory(t, storeCreator())
 
 	// test delete in genesis, noop
 	store := storeCreator()
@@ -65,6 +67,64 @@ func Run(t *testing.T, storeCreator func() VersionStore) {
 	require.NoError(t, err)
 }
 
+// RunSkipVersionZero tests that the entries written at version 0 are hidden once `SetSkipVersionZero(true)` is called,
+// see: https://github.com/crypto-org-chain/cronos/issues/1683
+func RunSkipVersionZero(t *testing.T, store interface {
+	VersionStore
+	SetSkipVersionZero(bool)
+},
+) {
+	t.Helper()
+	require.NoError(t, store.PutAtVersion(0, []*types.StoreKVPair{
+		{StoreKey: "evm", Key: []byte("genesis"), Value: []byte("0")},
+		{StoreKey: "evm", Key: []byte("modify-in-block1"), Value: []byte("0")},
+	}))
+	require.NoError(t, store.PutAtVersion(1, []*types.StoreKVPair{
+		{StoreKey: "evm", Key: []byte("add-in-block1"), Value: []byte("1")},
+		{StoreKey: "evm", Key: []byte("modify-in-block1"), Value: []byte("1")},
+	}))
+
+	v := int64(1)
+	value, err := store.GetAtVersion("evm", []byte("genesis"), &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte("0"), value)
+
+	store.SetSkipVersionZero(true)
+
+	value, err = store.GetAtVersion("evm", []byte("genesis"), &v)
+	require.NoError(t, err)
+	require.Empty(t, value)
+	found, err := store.HasAtVersion("evm", []byte("genesis"), &v)
+	require.NoError(t, err)
+	require.False(t, found)
+	value, err = store.GetAtVersion("evm", []byte("modify-in-block1"), &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+
+	expItems := []kvPair{
+		{[]byte("add-in-block1"), []byte("1")},
+		{[]byte("modify-in-block1"), []byte("1")},
+	}
+	it, err := store.IteratorAtVersion("evm", nil, nil, &v)
+	require.NoError(t, err)
+	require.Equal(t, expItems, consumeIterator(it))
+	it, err = store.ReverseIteratorAtVersion("evm", nil, nil, &v)
+	require.NoError(t, err)
+	require.Equal(t, reversed(expItems), consumeIterator(it))
+
+	historyStore, ok := store.(HistoryStore)
+	require.True(t, ok)
+	changes, _, err := historyStore.KeyHistory("evm", []byte("modify-in-block1"), 0, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyChange{{Version: 1, Value: []byte("1")}}, changes)
+
+	store.SetSkipVersionZero(false)
+
+	value, err = store.GetAtVersion("evm", []byte("genesis"), &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte("0"), value)
+}
+
 func testBasics(t *testing.T, store VersionStore) {
 	t.Helper()
 	var v int64
@@ -262,6 +322,188 @@ func testHeightInFuture(t *testing.T, store VersionStore) {
 	require.NoError(t, err)
 }
 
+func testPrune(t *testing.T, store VersionStore) {
+	t.Helper()
+	SetupTestDB(t, store)
+
+	earliest, err := store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), earliest)
+
+	// can't prune the versions in future
+	require.Error(t, store.Prune(5))
+
+	require.NoError(t, store.Prune(2))
+	earliest, err = store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), earliest)
+
+	// pruning older versions is a noop
+	require.NoError(t, store.Prune(1))
+	earliest, err = store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), earliest)
+
+	v := int64(1)
+	_, err = store.GetAtVersion("staking", key1, &v)
+	require.ErrorIs(t, err, ErrVersionPruned)
+	_, err = store.HasAtVersion("staking", key1, &v)
+	require.ErrorIs(t, err, ErrVersionPruned)
+	_, err = store.IteratorAtVersion("evm", nil, nil, &v)
+	require.ErrorIs(t, err, ErrVersionPruned)
+	_, err = store.ReverseIteratorAtVersion("evm", nil, nil, &v)
+	require.ErrorIs(t, err, ErrVersionPruned)
+
+	// the retained versions are not affected
+	v = 2
+	value, err := store.GetAtVersion("staking", key1, &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), value)
+	value, err = store.GetAtVersion("evm", []byte("z-genesis-only"), &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+	it, err := store.IteratorAtVersion("evm", nil, nil, &v)
+	require.NoError(t, err)
+	require.Equal(t, []kvPair{
+		{[]byte("add-in-block1"), []byte("1")},
+		{[]byte("add-in-block2"), []byte("1")},
+		{[]byte("modify-in-block2"), []byte("2")},
+		{[]byte("z-genesis-only"), []byte("2")},
+	}, consumeIterator(it))
+
+	value, err = store.GetAtVersion("evm", []byte("re-add-in-block3"), nil)
+	require.NoError(t, err)
+	require.Empty(t, value)
+}
+
+func testHistory(t *testing.T, store VersionStore) {
+	t.Helper()
+	SetupTestDB(t, store)
+
+	historyStore, ok := store.(HistoryStore)
+	require.True(t, ok)
+
+	changes, next, err := historyStore.KeyHistory("evm", []byte("re-add-in-block3"), 0, 4, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), next)
+	require.Equal(t, []KeyChange{
+		{Version: 4, Deleted: true},
+		{Version: 3, Value: []byte("2")},
+		{Version: 1, Deleted: true},
+		{Version: 0, Value: []byte("1")},
+	}, changes)
+
+	// paginated
+	changes, next, err = historyStore.KeyHistory("evm", []byte("re-add-in-block3"), 0, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), next)
+	require.Equal(t, []KeyChange{
+		{Version: 4, Deleted: true},
+		{Version: 3, Value: []byte("2")},
+	}, changes)
+	changes, next, err = historyStore.KeyHistory("evm", []byte("re-add-in-block3"), 0, next, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), next)
+	require.Equal(t, []KeyChange{
+		{Version: 1, Deleted: true},
+		{Version: 0, Value: []byte("1")},
+	}, changes)
+
+	changes, _, err = historyStore.KeyHistory("evm", []byte("re-add-in-block3"), 1, 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyChange{
+		{Version: 3, Value: []byte("2")},
+		{Version: 1, Deleted: true},
+	}, changes)
+
+	// the other keys with the same prefix are not included
+	changes, _, err = historyStore.KeyHistory("staking", key1, 0, 4, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyChange{
+		{Version: 2, Value: []byte("value2")},
+		{Version: 1, Deleted: true},
+		{Version: 0, Value: value1},
+	}, changes)
+
+	changes, _, err = historyStore.KeyHistory("evm", []byte("not-exist"), 0, 4, 0)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	diffs, nextKey, err := historyStore.Diff("evm", nil, nil, 0, 4, 0)
+	require.NoError(t, err)
+	require.Nil(t, nextKey)
+	require.Equal(t, []KeyDiff{
+		{Key: []byte("add-in-block1"), To: []byte("1")},
+		{Key: []byte("add-in-block2"), To: []byte("1")},
+		{Key: []byte("delete-in-block2"), From: []byte("1")},
+		{Key: []byte("modify-in-block2"), From: []byte("1"), To: []byte("2")},
+		{Key: []byte("re-add-in-block3"), From: []byte("1")},
+	}, diffs)
+
+	diffs, _, err = historyStore.Diff("evm", nil, nil, 1, 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyDiff{
+		{Key: []byte("add-in-block2"), To: []byte("1")},
+		{Key: []byte("delete-in-block2"), From: []byte("1")},
+		{Key: []byte("modify-in-block2"), From: []byte("1"), To: []byte("2")},
+		{Key: []byte("re-add-in-block3"), To: []byte("2")},
+	}, diffs)
+
+	// re-added and deleted again
+	diffs, _, err = historyStore.Diff("evm", nil, nil, 2, 4, 0)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	// paginated
+	diffs, nextKey, err = historyStore.Diff("evm", nil, nil, 0, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("delete-in-block2"), nextKey)
+	require.Equal(t, []KeyDiff{
+		{Key: []byte("add-in-block1"), To: []byte("1")},
+		{Key: []byte("add-in-block2"), To: []byte("1")},
+	}, diffs)
+	diffs, nextKey, err = historyStore.Diff("evm", nil, nextKey, 0, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("re-add-in-block3"), nextKey)
+	require.Equal(t, []KeyDiff{
+		{Key: []byte("delete-in-block2"), From: []byte("1")},
+		{Key: []byte("modify-in-block2"), From: []byte("1"), To: []byte("2")},
+	}, diffs)
+	diffs, nextKey, err = historyStore.Diff("evm", nil, nextKey, 0, 4, 2)
+	require.NoError(t, err)
+	require.Nil(t, nextKey)
+	require.Equal(t, []KeyDiff{
+		{Key: []byte("re-add-in-block3"), From: []byte("1")},
+	}, diffs)
+
+	// filtered by prefix
+	diffs, _, err = historyStore.Diff("evm", []byte("add-"), nil, 0, 4, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyDiff{
+		{Key: []byte("add-in-block1"), To: []byte("1")},
+		{Key: []byte("add-in-block2"), To: []byte("1")},
+	}, diffs)
+	diffs, _, err = historyStore.Diff("staking", key1, nil, 0, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyDiff{
+		{Key: key1, From: value1, To: []byte("value2")},
+	}, diffs)
+
+	_, _, err = historyStore.Diff("evm", nil, nil, 2, 2, 0)
+	require.Error(t, err)
+
+	// the pruned versions are not returned
+	require.NoError(t, store.Prune(2))
+	changes, _, err = historyStore.KeyHistory("evm", []byte("re-add-in-block3"), 0, 4, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyChange{
+		{Version: 4, Deleted: true},
+		{Version: 3, Value: []byte("2")},
+	}, changes)
+	_, _, err = historyStore.Diff("evm", nil, nil, 1, 4, 0)
+	require.ErrorIs(t, err, ErrVersionPruned)
+}
+
 func consumeIterator(it dbm.Iterator) []kvPair {
 	var result []kvPair
 	for ; it.Valid(); it.Next() {
@@ -29,6 +29,8 @@ func ChangeSetGroupCmd(opts Options) *cobra.Command {
 		RestoreAppDBCmd(opts),
 		RestoreVersionDBCmd(),
 		FixDataCmd(opts.DefaultStores),
+		KeyHistoryCmd(),
+		KeyDiffCmd(),
 	)
 	return cmd
 }
@@ -27,4 +27,7 @@ const (
 	flagInitialVersion   = "initial-version"
 	flagSDK64Compact     = "sdk64-compact"
 	flagIAVLVersion      = "iavl-version"
+	flagKeepRecent       = "keep-recent"
+	flagLimit            = "limit"
+	flagStartKey         = "start-key"
 )
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"github.com/spf13/cobra"
+)
+
+func KeyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history versiondb-path store key",
+		Short: "Print the changes of a key in versiondb within a version range, newest first",
+		Long: `Print the changes of a key in versiondb within a version range, newest first, the key is hex encoded.
+At most limit changes are printed, if there are more, the end version of the next page is printed at last.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+			startVersion, err := cmd.Flags().GetInt64(flagStartVersion)
+			if err != nil {
+				return err
+			}
+			endVersion, err := cmd.Flags().GetInt64(flagEndVersion)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			db, cfHandle, err := tsrocksdb.OpenVersionDBForReadOnly(args[0], false)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			versionDB := tsrocksdb.NewStoreWithDB(db, cfHandle)
+
+			if endVersion <= 0 {
+				if endVersion, err = versionDB.GetLatestVersion(); err != nil {
+					return err
+				}
+			}
+
+			changes, nextVersion, err := versionDB.KeyHistory(args[1], key, startVersion, endVersion, limit)
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				js, err := json.Marshal(change)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(js))
+			}
+			if nextVersion > 0 {
+				fmt.Printf("next end version: %d\n", nextVersion)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagStartVersion, 0, "Start of the version range, inclusive")
+	cmd.Flags().Int64(flagEndVersion, 0, "End of the version range, inclusive, 0 means the latest version")
+	cmd.Flags().Int(flagLimit, 0, "Maximum number of changes to print, default to 100, capped at 1000")
+	return cmd
+}
+
+func KeyDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff versiondb-path store prefix",
+		Short: "Print the keys under a prefix in versiondb whose values are different between two versions",
+		Long: `Print the keys under a prefix in versiondb whose values at the end version are different from the start
+version, along with the values at both versions, the prefix is hex encoded and could be empty.
+At most limit keys are printed, if there are more, the start key of the next page is printed at last.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+			startKey, err := getHexFlag(cmd, flagStartKey)
+			if err != nil {
+				return err
+			}
+			startVersion, err := cmd.Flags().GetInt64(flagStartVersion)
+			if err != nil {
+				return err
+			}
+			endVersion, err := cmd.Flags().GetInt64(flagEndVersion)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			db, cfHandle, err := tsrocksdb.OpenVersionDBForReadOnly(args[0], false)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			versionDB := tsrocksdb.NewStoreWithDB(db, cfHandle)
+
+			if endVersion <= 0 {
+				if endVersion, err = versionDB.GetLatestVersion(); err != nil {
+					return err
+				}
+			}
+
+			diffs, nextKey, err := versionDB.Diff(args[1], prefix, startKey, startVersion, endVersion, limit)
+			if err != nil {
+				return err
+			}
+			for _, diff := range diffs {
+				js, err := json.Marshal(diff)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(js))
+			}
+			if nextKey != nil {
+				fmt.Printf("next start key: %X\n", nextKey)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagStartVersion, 0, "The version to compare with")
+	cmd.Flags().Int64(flagEndVersion, 0, "The version to compare, 0 means the latest version")
+	cmd.Flags().String(flagStartKey, "", "Hex encoded key to start with, for pagination")
+	cmd.Flags().Int(flagLimit, 0, "Maximum number of keys to print, default to 100, capped at 1000")
+	return cmd
+}
+
+func getHexFlag(cmd *cobra.Command, name string) ([]byte, error) {
+	s, err := cmd.Flags().GetString(name)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	return hex.DecodeString(s)
+}
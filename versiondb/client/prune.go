@@ -0,0 +1,60 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"github.com/spf13/cobra"
+)
+
+func PruneVersionDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune versiondb-path",
+		Short: "Prune the old versions in versiondb, and compact the db to reclaim the disk space",
+		Long: `Prune the old versions in versiondb, either the versions older than the target version, or the versions out of
+the retention window of the latest version, the queries on the pruned versions fail afterwards.
+The node must be stopped before running it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keepRecent, err := cmd.Flags().GetInt64(flagKeepRecent)
+			if err != nil {
+				return err
+			}
+			targetVersion, err := cmd.Flags().GetInt64(flagTargetVersion)
+			if err != nil {
+				return err
+			}
+			if (keepRecent > 0) == (targetVersion > 0) {
+				return errors.New("exactly one of --keep-recent and --target-version must be specified")
+			}
+
+			versionDB, err := tsrocksdb.NewStore(args[0])
+			if err != nil {
+				return err
+			}
+
+			if keepRecent > 0 {
+				latestVersion, err := versionDB.GetLatestVersion()
+				if err != nil {
+					return err
+				}
+				targetVersion = latestVersion - keepRecent
+			}
+
+			if err := versionDB.Prune(targetVersion); err != nil {
+				return err
+			}
+			earliestVersion, err := versionDB.GetEarliestVersion()
+			if err != nil {
+				return err
+			}
+			fmt.Println("earliest version", earliestVersion)
+
+			return versionDB.Compact()
+		},
+	}
+	cmd.Flags().Int64(flagKeepRecent, 0, "Number of versions retained before the latest one")
+	cmd.Flags().Int64(flagTargetVersion, 0, "Prune the versions older than the target version")
+	return cmd
+}
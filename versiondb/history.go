@@ -0,0 +1,45 @@
+package versiondb
+
+const (
+	// DefaultHistoryLimit is the page size of the history queries if not specified.
+	DefaultHistoryLimit = 100
+	// MaxHistoryLimit is the maximum page size of the history queries.
+	MaxHistoryLimit = 1000
+)
+
+// KeyChange is a change of a key at a version, `Value` is nil if the key is deleted.
+type KeyChange struct {
+	Version int64  `json:"version"`
+	Value   []byte `json:"value"`
+	Deleted bool   `json:"deleted"`
+}
+
+// KeyDiff is a key whose value is different between two versions, the value is nil if the key don't exist at the
+// version.
+type KeyDiff struct {
+	Key  []byte `json:"key"`
+	From []byte `json:"from"`
+	To   []byte `json:"to"`
+}
+
+// HistoryStore is implemented by the version stores which can iterate over all the versions of the keys.
+// The results are paginated, at most `limit` ones are returned in a page, see `HistoryLimit`.
+type HistoryStore interface {
+	// KeyHistory returns the changes of the key within the version range `[startVersion, endVersion]`,
+	// newest first, the start version is capped by the earliest version retained.
+	// The returned version is the end version of the next page, or 0 if there's no more.
+	KeyHistory(storeKey string, key []byte, startVersion, endVersion int64, limit int) ([]KeyChange, int64, error)
+
+	// Diff returns the keys under the prefix whose values at `toVersion` are different from `fromVersion`,
+	// in ascending order, starting from `startKey` if not nil.
+	// The returned key is the start key of the next page, or nil if there's no more.
+	Diff(storeKey string, prefix, startKey []byte, fromVersion, toVersion int64, limit int) ([]KeyDiff, []byte, error)
+}
+
+// HistoryLimit returns the actual page size of the history queries, it's bounded by `MaxHistoryLimit`.
+func HistoryLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultHistoryLimit
+	}
+	return min(limit, MaxHistoryLimit)
+}
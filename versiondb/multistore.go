@@ -74,6 +74,13 @@ func (s *MultiStore) CacheMultiStore() types.CacheMultiStore {
 
 // CacheMultiStoreWithVersion implements `MultiStore` interface
 func (s *MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	earliest, err := s.versionDB.GetEarliestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version < earliest {
+		return nil, fmt.Errorf("%w: %d, earliest version: %d", ErrVersionPruned, version, earliest)
+	}
 	return s.cacheMultiStore(&version), nil
 }
 
@@ -99,6 +106,11 @@ func (s *MultiStore) GetKVStore(storeKey types.StoreKey) types.KVStore {
 	return s.GetStore(storeKey).(types.KVStore)
 }
 
+// GetObjKVStore implements `MultiStore` interface, the object stores are delegated to the parent.
+func (s *MultiStore) GetObjKVStore(storeKey types.StoreKey) types.ObjKVStore {
+	return s.GetStore(storeKey).(types.ObjKVStore)
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (s *MultiStore) SetTracer(w io.Writer) types.MultiStore {
@@ -0,0 +1,82 @@
+package pebbledb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	VersionSize = 8
+
+	escapeByte     = 0x00
+	escapedZero    = 0xff
+	terminatorByte = 0x01
+)
+
+var errInvalidKey = errors.New("invalid versioned key")
+
+// encodeKeyPrefix escapes the zero bytes in the key and appends a terminator, so the encoded keys sort in the same
+// order as the raw keys, and no encoded key is a prefix of another one:
+//
+// ```
+// 0x00 -> 0x00 0xff
+// terminator: 0x00 0x01
+// ```
+func encodeKeyPrefix(key []byte) []byte {
+	buf := make([]byte, 0, len(key)+2+VersionSize)
+	for _, b := range key {
+		if b == escapeByte {
+			buf = append(buf, escapeByte, escapedZero)
+		} else {
+			buf = append(buf, b)
+		}
+	}
+	return append(buf, escapeByte, terminatorByte)
+}
+
+// encodeKey appends the inverted version in big endian to the encoded key, so the newer versions of the same key
+// sort first, and a seek finds the newest version not newer than the target version.
+func encodeKey(key []byte, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(encodeKeyPrefix(key), ^version)
+}
+
+// nextKeyPrefix returns the smallest encoded key after all the versions of the key.
+func nextKeyPrefix(key []byte) []byte {
+	prefix := encodeKeyPrefix(key)
+	prefix[len(prefix)-1]++
+	return prefix
+}
+
+// decodeKey decodes the raw key and the version from the encoded key.
+func decodeKey(bz []byte) ([]byte, uint64, error) {
+	if len(bz) < 2+VersionSize {
+		return nil, 0, fmt.Errorf("%w: %X", errInvalidKey, bz)
+	}
+	version := ^binary.BigEndian.Uint64(bz[len(bz)-VersionSize:])
+	bz = bz[:len(bz)-VersionSize]
+
+	key := make([]byte, 0, len(bz)-2)
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != escapeByte {
+			key = append(key, bz[i])
+			continue
+		}
+		if i+1 >= len(bz) {
+			return nil, 0, fmt.Errorf("%w: %X", errInvalidKey, bz)
+		}
+		switch bz[i+1] {
+		case escapedZero:
+			key = append(key, escapeByte)
+			i++
+		case terminatorByte:
+			if i+2 != len(bz) {
+				return nil, 0, fmt.Errorf("%w: %X", errInvalidKey, bz)
+			}
+			return key, version, nil
+		default:
+			return nil, 0, fmt.Errorf("%w: %X", errInvalidKey, bz)
+		}
+	}
+	return nil, 0, fmt.Errorf("%w: %X", errInvalidKey, bz)
+}
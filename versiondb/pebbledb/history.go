@@ -0,0 +1,159 @@
+package pebbledb
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/crypto-org-chain/cronos/versiondb"
+)
+
+var _ versiondb.HistoryStore = (*Store)(nil)
+
+// KeyHistory implements HistoryStore interface
+func (s *Store) KeyHistory(storeKey string, key []byte, startVersion, endVersion int64, limit int) ([]versiondb.KeyChange, int64, error) {
+	if len(key) == 0 {
+		return nil, 0, errKeyEmpty
+	}
+	startVersion = max(startVersion, s.earliestVersion.Load())
+	if endVersion < startVersion {
+		return nil, 0, nil
+	}
+	limit = versiondb.HistoryLimit(limit)
+
+	// the versions of the key are sorted newest first
+	fullKey := prependStoreKey(storeKey, key)
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: encodeKey(fullKey, uint64(endVersion)),
+		UpperBound: nextKeyPrefix(fullKey),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer iter.Close()
+
+	var changes []versiondb.KeyChange
+	for iter.First(); iter.Valid(); iter.Next() {
+		_, version, err := decodeKey(iter.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+		if int64(version) < startVersion || (s.skipVersionZero && version == 0) {
+			break
+		}
+		if len(changes) == limit {
+			return changes, int64(version), nil
+		}
+		value, ok, err := iterValue(iter)
+		if err != nil {
+			return nil, 0, err
+		}
+		changes = append(changes, versiondb.KeyChange{
+			Version: int64(version),
+			Value:   value,
+			Deleted: !ok,
+		})
+	}
+	return changes, 0, iter.Error()
+}
+
+// Diff implements HistoryStore interface, it compares the newest versions not newer than `toVersion` with the ones
+// not newer than `fromVersion` of each key.
+func (s *Store) Diff(storeKey string, prefix, startKey []byte, fromVersion, toVersion int64, limit int) ([]versiondb.KeyDiff, []byte, error) {
+	if fromVersion >= toVersion {
+		return nil, nil, fmt.Errorf("invalid version range: %d, %d", fromVersion, toVersion)
+	}
+	if err := s.checkVersion(&fromVersion); err != nil {
+		return nil, nil, err
+	}
+	limit = versiondb.HistoryLimit(limit)
+
+	storePfx := storePrefix(storeKey)
+	start := append(bytes.Clone(storePfx), prefix...)
+	end := cpIncr(start)
+	if startKey != nil {
+		if key := append(bytes.Clone(storePfx), startKey...); bytes.Compare(key, start) > 0 {
+			start = key
+		}
+	}
+
+	opts := &pebble.IterOptions{LowerBound: encodeKeyPrefix(start)}
+	if end != nil {
+		opts.UpperBound = encodeKeyPrefix(end)
+	}
+	iter, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var diffs []versiondb.KeyDiff
+	for ok := iter.First(); ok; {
+		fullKey, version, err := decodeKey(iter.Key())
+		if err != nil {
+			return nil, nil, err
+		}
+		if version > uint64(toVersion) {
+			// seek to the newest version not newer than `toVersion`, which may belong to the next key
+			ok = iter.SeekGE(encodeKey(fullKey, uint64(toVersion)))
+			continue
+		}
+		if version > uint64(fromVersion) {
+			diff, changed, err := diffKey(iter, fullKey, uint64(fromVersion))
+			if err != nil {
+				return nil, nil, err
+			}
+			if changed {
+				diff.Key = fullKey[len(storePfx):]
+				if len(diffs) == limit {
+					return diffs, diff.Key, nil
+				}
+				diffs = append(diffs, diff)
+			}
+		}
+		ok = iter.SeekGE(nextKeyPrefix(fullKey))
+	}
+	return diffs, nil, iter.Error()
+}
+
+// diffKey compares the value at the current position with the newest version of the key not newer than the version,
+// the iterator is moved.
+func diffKey(iter *pebble.Iterator, fullKey []byte, version uint64) (versiondb.KeyDiff, bool, error) {
+	var diff versiondb.KeyDiff
+	toValue, toExists, err := iterValue(iter)
+	if err != nil {
+		return diff, false, err
+	}
+	diff.To = toValue
+
+	var fromExists bool
+	if iter.SeekGE(encodeKey(fullKey, version)) {
+		key, _, err := decodeKey(iter.Key())
+		if err != nil {
+			return diff, false, err
+		}
+		if bytes.Equal(key, fullKey) {
+			diff.From, fromExists, err = iterValue(iter)
+			if err != nil {
+				return diff, false, err
+			}
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return diff, false, err
+	}
+	return diff, fromExists != toExists || !bytes.Equal(diff.From, diff.To), nil
+}
+
+// iterValue decodes the value at the current position, returns false if it's a deletion.
+func iterValue(iter *pebble.Iterator) ([]byte, bool, error) {
+	bz, err := iter.ValueAndErr()
+	if err != nil {
+		return nil, false, err
+	}
+	value, ok, err := decodeValue(bz)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return bytes.Clone(value), true, nil
+}
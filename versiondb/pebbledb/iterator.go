@@ -0,0 +1,194 @@
+package pebbledb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/crypto-org-chain/cronos/versiondb"
+)
+
+// pebbleIterator iterates the newest versions of the keys not newer than the target version,
+// it seeks over the older versions and the deleted keys.
+type pebbleIterator struct {
+	source             *pebble.Iterator
+	prefix, start, end []byte
+	version            uint64
+	isReverse          bool
+	isInvalid          bool
+	err                error
+
+	// see: https://github.com/crypto-org-chain/cronos/issues/1683
+	skipVersionZero bool
+
+	// the current entry
+	key, value []byte
+	timestamp  uint64
+}
+
+var _ versiondb.Iterator = (*pebbleIterator)(nil)
+
+func newPebbleIterator(source *pebble.Iterator, prefix, start, end []byte, version uint64, isReverse, skipVersionZero bool) *pebbleIterator {
+	it := &pebbleIterator{
+		source:          source,
+		prefix:          prefix,
+		start:           start,
+		end:             end,
+		version:         version,
+		isReverse:       isReverse,
+		skipVersionZero: skipVersionZero,
+	}
+	if isReverse {
+		it.seekReverse(source.Last())
+	} else {
+		it.seekForward(source.First())
+	}
+	return it
+}
+
+// seekForward moves to the first visible entry at or after the current position.
+func (itr *pebbleIterator) seekForward(ok bool) {
+	for ok {
+		key, version, err := decodeKey(itr.source.Key())
+		if err != nil {
+			itr.fail(err)
+			return
+		}
+		if version > itr.version {
+			// skip the versions newer than the target version
+			ok = itr.source.SeekGE(encodeKey(key, itr.version))
+			continue
+		}
+		found, err := itr.setEntry(key, version)
+		if err != nil {
+			itr.fail(err)
+			return
+		}
+		if found {
+			return
+		}
+		// the key is deleted at the target version, skip the older versions
+		ok = itr.source.SeekGE(nextKeyPrefix(key))
+	}
+	itr.isInvalid = true
+}
+
+// seekReverse moves to the last visible entry at or before the current position.
+func (itr *pebbleIterator) seekReverse(ok bool) {
+	for ok {
+		key, _, err := decodeKey(itr.source.Key())
+		if err != nil {
+			itr.fail(err)
+			return
+		}
+		// the source is positioned at the oldest version of the key, seek to the newest version not newer than the
+		// target version.
+		if itr.source.SeekGE(encodeKey(key, itr.version)) {
+			current, version, err := decodeKey(itr.source.Key())
+			if err != nil {
+				itr.fail(err)
+				return
+			}
+			if bytes.Equal(current, key) {
+				found, err := itr.setEntry(key, version)
+				if err != nil {
+					itr.fail(err)
+					return
+				}
+				if found {
+					return
+				}
+			}
+		}
+		ok = itr.source.SeekLT(encodeKeyPrefix(key))
+	}
+	itr.isInvalid = true
+}
+
+// setEntry reads the entry at the current position, returns false if it's a deletion or a skipped version 0 entry.
+func (itr *pebbleIterator) setEntry(key []byte, version uint64) (bool, error) {
+	if itr.skipVersionZero && version == 0 {
+		return false, nil
+	}
+	value, err := itr.source.ValueAndErr()
+	if err != nil {
+		return false, err
+	}
+	value, ok, err := decodeValue(value)
+	if err != nil || !ok {
+		return false, err
+	}
+	itr.key = key
+	itr.value = bytes.Clone(value)
+	itr.timestamp = version
+	return true, nil
+}
+
+func (itr *pebbleIterator) fail(err error) {
+	itr.err = err
+	itr.isInvalid = true
+}
+
+// Domain implements Iterator.
+func (itr *pebbleIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *pebbleIterator) Valid() bool {
+	if itr.isInvalid {
+		return false
+	}
+	if err := itr.source.Error(); err != nil {
+		itr.fail(err)
+		return false
+	}
+	return true
+}
+
+// Timestamp implements Iterator, it's encoded the same as the rocksdb timestamp.
+func (itr *pebbleIterator) Timestamp() []byte {
+	itr.assertIsValid()
+	return binary.LittleEndian.AppendUint64(nil, itr.timestamp)
+}
+
+// Key implements Iterator.
+func (itr *pebbleIterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.key[len(itr.prefix):]
+}
+
+// Value implements Iterator.
+func (itr *pebbleIterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+// Next implements Iterator.
+func (itr *pebbleIterator) Next() {
+	itr.assertIsValid()
+	if itr.isReverse {
+		itr.seekReverse(itr.source.SeekLT(encodeKeyPrefix(itr.key)))
+	} else {
+		itr.seekForward(itr.source.SeekGE(nextKeyPrefix(itr.key)))
+	}
+}
+
+// Error implements Iterator.
+func (itr *pebbleIterator) Error() error {
+	if itr.err != nil {
+		return itr.err
+	}
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *pebbleIterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *pebbleIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
@@ -0,0 +1,523 @@
+package pebbledb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/crypto-org-chain/cronos/versiondb"
+
+	"cosmossdk.io/store/types"
+)
+
+const (
+	StorePrefixTpl     = "s/k:%s/"
+	latestVersionKey   = "s/latest"
+	earliestVersionKey = "s/earliest"
+
+	ImportCommitBatchSize = 10000
+
+	// PruneInterval is the number of versions accumulated beyond the retention window before pruning them.
+	PruneInterval = 100
+
+	valueTypeDelete byte = 0
+	valueTypeSet    byte = 1
+)
+
+var (
+	errKeyEmpty = errors.New("key cannot be empty")
+
+	// the versioned keys are all under the common prefix of the stores
+	dataPrefix = []byte("s/k:")
+
+	_ versiondb.VersionStore = (*Store)(nil)
+)
+
+// Store implements `VersionStore` with pebble, a pure-Go engine, the versions of a key are stored as separate
+// entries, with the inverted version appended to the key, see `encodeKey`.
+// The values are prefixed with a type byte to represent the deletions.
+type Store struct {
+	db *pebble.DB
+
+	// see: https://github.com/crypto-org-chain/cronos/issues/1683
+	skipVersionZero bool
+
+	// keepRecent is the number of versions retained before the latest one, 0 means no pruning.
+	keepRecent      int64
+	earliestVersion atomic.Int64
+
+	// pruneMtx serializes the prunings, the background pruning holds it until it finishes.
+	pruneMtx sync.Mutex
+	// mtx protects the states of the background pruning,
+	// pruneDone is closed when the background pruning finishes, pruneErr is set before that.
+	mtx       sync.Mutex
+	pruneDone chan struct{}
+	pruneErr  error
+}
+
+func NewStore(dir string) (*Store, error) {
+	db, err := pebble.Open(dir, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return NewStoreWithDB(db)
+}
+
+func NewStoreWithDB(db *pebble.DB) (*Store, error) {
+	store := &Store{db: db}
+	bz, err := store.getMetadata(earliestVersionKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) > 0 {
+		store.earliestVersion.Store(int64(binary.LittleEndian.Uint64(bz)))
+	}
+	return store, nil
+}
+
+// SetSkipVersionZero hides the entries written at version 0, to be consistent with the rocksdb backend.
+func (s *Store) SetSkipVersionZero(skip bool) {
+	s.skipVersionZero = skip
+}
+
+// SetKeepRecent sets the number of versions retained before the latest one, the older versions are pruned
+// in background when writing new versions, 0 disables the pruning.
+func (s *Store) SetKeepRecent(keepRecent int64) {
+	s.keepRecent = keepRecent
+}
+
+func (s *Store) SetLatestVersion(version int64) error {
+	var ts [VersionSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(version))
+	return s.db.Set([]byte(latestVersionKey), ts[:], pebble.Sync)
+}
+
+// PutAtVersion implements VersionStore interface
+func (s *Store) PutAtVersion(version int64, changeSet []*types.StoreKVPair) error {
+	var ts [VersionSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(version))
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set([]byte(latestVersionKey), ts[:], nil); err != nil {
+		return err
+	}
+	for _, pair := range changeSet {
+		key := encodeKey(prependStoreKey(pair.StoreKey, pair.Key), uint64(version))
+		if err := batch.Set(key, encodeValue(pair.Value, pair.Delete), nil); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Commit(pebble.Sync); err != nil {
+		return err
+	}
+	return s.pruneIfApplicable(version)
+}
+
+// GetAtVersion implements VersionStore interface
+func (s *Store) GetAtVersion(storeKey string, key []byte, version *int64) ([]byte, error) {
+	value, _, err := s.getAtVersion(storeKey, key, version)
+	return value, err
+}
+
+// HasAtVersion implements VersionStore interface
+func (s *Store) HasAtVersion(storeKey string, key []byte, version *int64) (bool, error) {
+	_, found, err := s.getAtVersion(storeKey, key, version)
+	return found, err
+}
+
+func (s *Store) getAtVersion(storeKey string, key []byte, version *int64) ([]byte, bool, error) {
+	if err := s.checkVersion(version); err != nil {
+		return nil, false, err
+	}
+
+	fullKey := prependStoreKey(storeKey, key)
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: encodeKey(fullKey, targetVersion(version)),
+		UpperBound: nextKeyPrefix(fullKey),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	if !iter.First() {
+		return nil, false, iter.Error()
+	}
+	if s.skipVersionZero {
+		if _, ts, err := decodeKey(iter.Key()); err != nil || ts == 0 {
+			return nil, false, err
+		}
+	}
+	bz, err := iter.ValueAndErr()
+	if err != nil {
+		return nil, false, err
+	}
+	value, ok, err := decodeValue(bz)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return bytes.Clone(value), true, nil
+}
+
+// GetLatestVersion implements VersionStore interface, returns 0 if the db is empty.
+func (s *Store) GetLatestVersion() (int64, error) {
+	bz, err := s.getMetadata(latestVersionKey)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	return int64(binary.LittleEndian.Uint64(bz)), nil
+}
+
+// GetEarliestVersion implements VersionStore interface
+func (s *Store) GetEarliestVersion() (int64, error) {
+	return s.earliestVersion.Load(), nil
+}
+
+// IteratorAtVersion implements VersionStore interface
+func (s *Store) IteratorAtVersion(storeKey string, start, end []byte, version *int64) (versiondb.Iterator, error) {
+	return s.iteratorAtVersion(storeKey, start, end, version, false)
+}
+
+// ReverseIteratorAtVersion implements VersionStore interface
+func (s *Store) ReverseIteratorAtVersion(storeKey string, start, end []byte, version *int64) (versiondb.Iterator, error) {
+	return s.iteratorAtVersion(storeKey, start, end, version, true)
+}
+
+func (s *Store) iteratorAtVersion(storeKey string, start, end []byte, version *int64, reverse bool) (versiondb.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+	if err := s.checkVersion(version); err != nil {
+		return nil, err
+	}
+
+	prefix := storePrefix(storeKey)
+	start, end = iterateWithPrefix(prefix, start, end)
+
+	opts := &pebble.IterOptions{LowerBound: encodeKeyPrefix(start)}
+	if end != nil {
+		opts.UpperBound = encodeKeyPrefix(end)
+	}
+	itr, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, err
+	}
+	return newPebbleIterator(itr, prefix, start, end, targetVersion(version), reverse, s.skipVersionZero), nil
+}
+
+// Import loads the initial state of the store
+func (s *Store) Import(version int64, ch <-chan versiondb.ImportEntry) error {
+	batch := s.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	var counter int
+	for entry := range ch {
+		key := encodeKey(prependStoreKey(entry.StoreKey, entry.Key), uint64(version))
+		if err := batch.Set(key, encodeValue(entry.Value, false), nil); err != nil {
+			return err
+		}
+
+		counter++
+		if counter%ImportCommitBatchSize == 0 {
+			if err := batch.Commit(pebble.NoSync); err != nil {
+				return err
+			}
+			_ = batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+
+	if batch.Count() > 0 {
+		if err := batch.Commit(pebble.NoSync); err != nil {
+			return err
+		}
+	}
+
+	return s.SetLatestVersion(version)
+}
+
+// Prune implements VersionStore interface, the versions shadowed by the newest version not newer than the target
+// version are deleted.
+func (s *Store) Prune(version int64) error {
+	if err := s.waitPruning(); err != nil {
+		return err
+	}
+
+	s.pruneMtx.Lock()
+	defer s.pruneMtx.Unlock()
+	ok, err := s.setEarliestVersion(version)
+	if err != nil || !ok {
+		return err
+	}
+	return s.deleteShadowedVersions(uint64(version))
+}
+
+// pruneIfApplicable prunes the versions out of the retention window in background, in batches of `PruneInterval`
+// versions, the error of the last background pruning is returned.
+func (s *Store) pruneIfApplicable(latest int64) error {
+	if s.keepRecent <= 0 {
+		return nil
+	}
+	s.mtx.Lock()
+	done := s.pruneDone
+	s.mtx.Unlock()
+	if done != nil {
+		select {
+		case <-done:
+		default:
+			// the last pruning is still in progress
+			return nil
+		}
+		if err := s.waitPruning(); err != nil {
+			return err
+		}
+	}
+
+	target := latest - s.keepRecent
+	if target-s.earliestVersion.Load() < PruneInterval {
+		return nil
+	}
+
+	s.pruneMtx.Lock()
+	ok, err := s.setEarliestVersion(target)
+	if err != nil || !ok {
+		s.pruneMtx.Unlock()
+		return err
+	}
+	done = make(chan struct{})
+	s.mtx.Lock()
+	s.pruneDone = done
+	s.mtx.Unlock()
+	go func() {
+		err := s.deleteShadowedVersions(uint64(target))
+		s.pruneMtx.Unlock()
+
+		s.mtx.Lock()
+		s.pruneErr = err
+		s.mtx.Unlock()
+		close(done)
+	}()
+	return nil
+}
+
+// waitPruning waits for the background pruning, returns the error of it.
+func (s *Store) waitPruning() error {
+	s.mtx.Lock()
+	done := s.pruneDone
+	s.mtx.Unlock()
+	if done == nil {
+		return nil
+	}
+	<-done
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.pruneDone != done {
+		// collected by others
+		return nil
+	}
+	err := s.pruneErr
+	s.pruneDone = nil
+	s.pruneErr = nil
+	return err
+}
+
+// setEarliestVersion records the earliest version before deleting the data, so the queries on the pruned versions
+// fail before the data is deleted, returns false if the version is not newer than the earliest version.
+func (s *Store) setEarliestVersion(version int64) (bool, error) {
+	if version <= s.earliestVersion.Load() {
+		return false, nil
+	}
+	latest, err := s.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	if version > latest {
+		return false, fmt.Errorf("can't prune version %d newer than the latest version %d", version, latest)
+	}
+
+	var ts [VersionSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(version))
+	if err := s.db.Set([]byte(earliestVersionKey), ts[:], pebble.Sync); err != nil {
+		return false, err
+	}
+	s.earliestVersion.Store(version)
+	return true, nil
+}
+
+// deleteShadowedVersions scans the versioned keys, and deletes the versions older than the newest version not newer
+// than the target version of each key, which are not visible at the target version or later.
+// The visible deletions are retained, so the deleted keys won't be visible at any point.
+func (s *Store) deleteShadowedVersions(version uint64) error {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: dataPrefix,
+		UpperBound: cpIncr(dataPrefix),
+	})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	var (
+		currentKey []byte
+		shadowed   bool
+	)
+	for iter.First(); iter.Valid(); iter.Next() {
+		key, ts, err := decodeKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(key, currentKey) {
+			currentKey = key
+			shadowed = false
+		}
+		if shadowed {
+			if err := batch.Delete(bytes.Clone(iter.Key()), nil); err != nil {
+				return err
+			}
+			if batch.Count() >= ImportCommitBatchSize {
+				if err := batch.Commit(pebble.NoSync); err != nil {
+					return err
+				}
+				_ = batch.Close()
+				batch = s.db.NewBatch()
+			}
+			continue
+		}
+		if ts <= version {
+			// the versions after this one are shadowed
+			shadowed = true
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return batch.Commit(pebble.NoSync)
+}
+
+// Compact compacts the versioned keys, to reclaim the disk space of the pruned versions immediately.
+func (s *Store) Compact() error {
+	if err := s.waitPruning(); err != nil {
+		return err
+	}
+	return s.db.Compact(dataPrefix, cpIncr(dataPrefix), true)
+}
+
+// checkVersion rejects the queries on the pruned versions, `nil` version means the latest version.
+func (s *Store) checkVersion(version *int64) error {
+	if version == nil {
+		return nil
+	}
+	if earliest := s.earliestVersion.Load(); *version < earliest {
+		return fmt.Errorf("%w: %d, earliest version: %d", versiondb.ErrVersionPruned, *version, earliest)
+	}
+	return nil
+}
+
+// Flush implements VersionStore interface, it also waits for the background pruning.
+func (s *Store) Flush() error {
+	return errors.Join(
+		s.waitPruning(),
+		s.db.Flush(),
+	)
+}
+
+// Close waits for the background pruning and closes the db.
+func (s *Store) Close() error {
+	return errors.Join(
+		s.waitPruning(),
+		s.db.Close(),
+	)
+}
+
+func (s *Store) getMetadata(key string) ([]byte, error) {
+	bz, closer, err := s.db.Get([]byte(key))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(bz), nil
+}
+
+func targetVersion(version *int64) uint64 {
+	if version == nil {
+		return math.MaxUint64
+	}
+	return uint64(*version)
+}
+
+func encodeValue(value []byte, deleted bool) []byte {
+	if deleted {
+		return []byte{valueTypeDelete}
+	}
+	bz := make([]byte, 0, len(value)+1)
+	bz = append(bz, valueTypeSet)
+	return append(bz, value...)
+}
+
+// decodeValue returns false if the value is a deletion.
+func decodeValue(bz []byte) ([]byte, bool, error) {
+	if len(bz) == 0 {
+		return nil, false, errors.New("invalid value: empty")
+	}
+	switch bz[0] {
+	case valueTypeDelete:
+		return nil, false, nil
+	case valueTypeSet:
+		return bz[1:], true, nil
+	default:
+		return nil, false, fmt.Errorf("invalid value type: %d", bz[0])
+	}
+}
+
+func storePrefix(storeKey string) []byte {
+	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
+}
+
+// prependStoreKey prepends storeKey to the key
+func prependStoreKey(storeKey string, key []byte) []byte {
+	return append(storePrefix(storeKey), key...)
+}
+
+// cpIncr returns a slice of the same length except incremented by one,
+// returns nil on overflow (e.g. if bz bytes are all 0xFF).
+func cpIncr(bz []byte) []byte {
+	ret := bytes.Clone(bz)
+	for i := len(ret) - 1; i >= 0; i-- {
+		if ret[i] < 0xFF {
+			ret[i]++
+			return ret
+		}
+		ret[i] = 0x00
+	}
+	return nil
+}
+
+// iterateWithPrefix calculate the acual iterate range
+func iterateWithPrefix(prefix, begin, end []byte) ([]byte, []byte) {
+	begin = append(bytes.Clone(prefix), begin...)
+	if end == nil {
+		end = cpIncr(prefix)
+	} else {
+		end = append(bytes.Clone(prefix), end...)
+	}
+	return begin, end
+}
@@ -0,0 +1,110 @@
+package pebbledb
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/types"
+)
+
+func TestPebbleVersionDB(t *testing.T) {
+	versiondb.Run(t, func() versiondb.VersionStore {
+		store, err := NewStore(t.TempDir())
+		require.NoError(t, err)
+		return store
+	})
+}
+
+func TestSkipVersionZero(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+	versiondb.RunSkipVersionZero(t, store)
+}
+
+func TestKeyEncoding(t *testing.T) {
+	keys := [][]byte{
+		{},
+		{0},
+		{0, 0},
+		{0, 1},
+		{0, 0xff},
+		{1},
+		{1, 0},
+		{0xff},
+		{0xff, 0},
+	}
+	for i, key := range keys {
+		for _, version := range []uint64{0, 1, 1 << 32, ^uint64(0)} {
+			decoded, decodedVersion, err := decodeKey(encodeKey(key, version))
+			require.NoError(t, err)
+			require.Equal(t, key, decoded)
+			require.Equal(t, version, decodedVersion)
+		}
+		if i == 0 {
+			continue
+		}
+		// the order of the keys is preserved, and the newer versions sort first
+		require.Less(t, string(encodeKey(keys[i-1], 0)), string(encodeKey(key, 1)))
+		require.Less(t, string(encodeKey(key, 2)), string(encodeKey(key, 1)))
+		require.Less(t, string(encodeKey(key, 0)), string(nextKeyPrefix(key)))
+		require.Less(t, string(nextKeyPrefix(keys[i-1])), string(encodeKeyPrefix(key)))
+	}
+}
+
+func TestKeepRecent(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	store.SetKeepRecent(10)
+
+	for i := int64(0); i <= PruneInterval+20; i++ {
+		changeSet := []*types.StoreKVPair{
+			{StoreKey: "evm", Key: []byte("key"), Value: []byte(strconv.FormatInt(i, 10))},
+		}
+		switch i {
+		case 0:
+			changeSet = append(changeSet, &types.StoreKVPair{StoreKey: "evm", Key: []byte("genesis"), Value: []byte("0")})
+		case 1:
+			changeSet = append(changeSet, &types.StoreKVPair{StoreKey: "evm", Key: []byte("genesis"), Delete: true})
+		}
+		require.NoError(t, store.PutAtVersion(i, changeSet))
+	}
+	require.NoError(t, store.Flush())
+	earliest, err := store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(PruneInterval), earliest)
+
+	v := earliest
+	value, err := store.GetAtVersion("evm", []byte("key"), &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte(strconv.FormatInt(earliest, 10)), value)
+	value, err = store.GetAtVersion("evm", []byte("genesis"), &v)
+	require.NoError(t, err)
+	require.Nil(t, value)
+	v--
+	_, err = store.GetAtVersion("evm", []byte("key"), &v)
+	require.ErrorIs(t, err, versiondb.ErrVersionPruned)
+
+	// the shadowed versions are deleted
+	var count int
+	iter, err := store.db.NewIter(nil)
+	require.NoError(t, err)
+	for iter.First(); iter.Valid(); iter.Next() {
+		count++
+	}
+	require.NoError(t, iter.Close())
+	// the retained versions of "key", the deletion of "genesis", and the metadata
+	require.Equal(t, PruneInterval+20-int(earliest)+1+1+2, count)
+
+	// the earliest version is persisted
+	require.NoError(t, store.Close())
+	store, err = NewStore(dir)
+	require.NoError(t, err)
+	earliest, err = store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(PruneInterval), earliest)
+	require.NoError(t, store.Close())
+}
@@ -0,0 +1,182 @@
+package tsrocksdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/linxGnu/grocksdb"
+)
+
+// the iterators with `iter_start_ts` return the internal keys, which are the user keys followed by the timestamp and
+// a footer of the sequence number and the value type, see `ValueType` in rocksdb's `db/dbformat.h`.
+const (
+	internalKeyFooterSize = 8
+
+	typeDeletion              = 0x0
+	typeValue                 = 0x1
+	typeSingleDeletion        = 0x7
+	typeDeletionWithTimestamp = 0x14
+)
+
+var _ versiondb.HistoryStore = Store{}
+
+// versionEntry is a version of a key returned by the history iterators.
+type versionEntry struct {
+	key     []byte
+	version int64
+	value   []byte
+	deleted bool
+}
+
+// KeyHistory implements HistoryStore interface
+func (s Store) KeyHistory(storeKey string, key []byte, startVersion, endVersion int64, limit int) ([]versiondb.KeyChange, int64, error) {
+	if len(key) == 0 {
+		return nil, 0, errKeyEmpty
+	}
+	earliest, err := s.GetEarliestVersion()
+	if err != nil {
+		return nil, 0, err
+	}
+	startVersion = max(startVersion, earliest)
+	if endVersion < startVersion {
+		return nil, 0, nil
+	}
+	limit = versiondb.HistoryLimit(limit)
+
+	rawKey := prependStoreKey(storeKey, key)
+	readOpts := newHistoryReadOptions(startVersion, endVersion)
+	defer readOpts.Destroy()
+	itr := s.db.NewIteratorCF(readOpts, s.cfHandle)
+	defer itr.Close()
+
+	var changes []versiondb.KeyChange
+	for itr.Seek(rawKey); itr.Valid(); itr.Next() {
+		entry, err := readVersionEntry(itr)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !bytes.Equal(entry.key, rawKey) {
+			break
+		}
+		if s.skipVersionZero && entry.version == 0 {
+			continue
+		}
+		if len(changes) > 0 && changes[len(changes)-1].Version == entry.version {
+			// the same version is overridden, see `FixData`
+			continue
+		}
+		if len(changes) == limit {
+			return changes, entry.version, nil
+		}
+		changes = append(changes, versiondb.KeyChange{
+			Version: entry.version,
+			Value:   entry.value,
+			Deleted: entry.deleted,
+		})
+	}
+	return changes, 0, itr.Err()
+}
+
+// Diff implements HistoryStore interface, it iterates the versions within `(fromVersion, toVersion]` to find the
+// changed keys, and compares the values with the ones at `fromVersion`.
+func (s Store) Diff(storeKey string, prefix, startKey []byte, fromVersion, toVersion int64, limit int) ([]versiondb.KeyDiff, []byte, error) {
+	if fromVersion >= toVersion {
+		return nil, nil, fmt.Errorf("invalid version range: %d, %d", fromVersion, toVersion)
+	}
+	if err := s.checkVersion(&fromVersion); err != nil {
+		return nil, nil, err
+	}
+	limit = versiondb.HistoryLimit(limit)
+
+	storePfx := storePrefix(storeKey)
+	start := cloneAppend(storePfx, prefix)
+	end := cpIncr(start)
+	if startKey != nil {
+		if key := cloneAppend(storePfx, startKey); bytes.Compare(key, start) > 0 {
+			start = key
+		}
+	}
+
+	readOpts := newHistoryReadOptions(fromVersion+1, toVersion)
+	defer readOpts.Destroy()
+	itr := s.db.NewIteratorCF(readOpts, s.cfHandle)
+	defer itr.Close()
+
+	var (
+		diffs   []versiondb.KeyDiff
+		lastKey []byte
+	)
+	for itr.Seek(start); itr.Valid(); itr.Next() {
+		entry, err := readVersionEntry(itr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if end != nil && bytes.Compare(entry.key, end) >= 0 {
+			break
+		}
+		if bytes.Equal(entry.key, lastKey) {
+			// the older versions of the key
+			continue
+		}
+		lastKey = entry.key
+
+		key := entry.key[len(storePfx):]
+		fromValue, err := s.GetAtVersion(storeKey, key, &fromVersion)
+		if err != nil {
+			return nil, nil, err
+		}
+		if (fromValue == nil) == entry.deleted && bytes.Equal(fromValue, entry.value) {
+			// changed back to the original value
+			continue
+		}
+		if len(diffs) == limit {
+			return diffs, key, nil
+		}
+		diffs = append(diffs, versiondb.KeyDiff{
+			Key:  key,
+			From: fromValue,
+			To:   entry.value,
+		})
+	}
+	return diffs, nil, itr.Err()
+}
+
+// readVersionEntry decodes the internal key at the current position of the history iterator.
+func readVersionEntry(itr *grocksdb.Iterator) (versionEntry, error) {
+	key := moveSliceToBytes(itr.Key())
+	if len(key) < TimestampSize+internalKeyFooterSize {
+		return versionEntry{}, fmt.Errorf("invalid internal key: %X", key)
+	}
+	footer := binary.LittleEndian.Uint64(key[len(key)-internalKeyFooterSize:])
+	key = key[:len(key)-internalKeyFooterSize]
+	entry := versionEntry{
+		key:     key[:len(key)-TimestampSize],
+		version: int64(binary.LittleEndian.Uint64(key[len(key)-TimestampSize:])),
+	}
+
+	switch valueType := byte(footer); valueType {
+	case typeValue:
+		entry.value = moveSliceToBytes(itr.Value())
+		if entry.value == nil {
+			entry.value = []byte{}
+		}
+	case typeDeletion, typeSingleDeletion, typeDeletionWithTimestamp:
+		entry.deleted = true
+	default:
+		return versionEntry{}, fmt.Errorf("unexpected value type %d of key: %X", valueType, key)
+	}
+	return entry, nil
+}
+
+// newHistoryReadOptions returns the read options to iterate all the versions within `[startVersion, endVersion]`,
+// it must be kept alive until the iterator is closed.
+func newHistoryReadOptions(startVersion, endVersion int64) *grocksdb.ReadOptions {
+	var startTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTS[:], uint64(startVersion))
+
+	readOpts := newTSReadOptions(&endVersion)
+	readOpts.SetIterStartTimestamp(startTS[:])
+	return readOpts
+}
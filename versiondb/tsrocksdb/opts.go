@@ -64,6 +64,22 @@ func OpenVersionDB(dir string) (*grocksdb.DB, *grocksdb.ColumnFamilyHandle, erro
 	return db, cfHandles[1], nil
 }
 
+// OpenVersionDBAsSecondary opens versiondb as a secondary instance of the primary one at `dir`, it's read-only and
+// follows the updates of the primary with `TryCatchUpWithPrimary`, the info logs of it are kept in `secondaryDir`.
+func OpenVersionDBAsSecondary(dir, secondaryDir string) (*grocksdb.DB, *grocksdb.ColumnFamilyHandle, error) {
+	opts := grocksdb.NewDefaultOptions()
+	// required by the secondary instance, so it don't need to reopen the table files which are deleted by the primary
+	opts.SetMaxOpenFiles(-1)
+	db, cfHandles, err := grocksdb.OpenDbAsSecondaryColumnFamilies(
+		opts, dir, secondaryDir, []string{"default", VersionDBCFName},
+		[]*grocksdb.Options{opts, NewVersionDBOpts(false)},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, cfHandles[1], nil
+}
+
 // OpenVersionDBForReadOnly open versiondb in readonly mode
 func OpenVersionDBForReadOnly(dir string, errorIfWalFileExists bool) (*grocksdb.DB, *grocksdb.ColumnFamilyHandle, error) {
 	opts := grocksdb.NewDefaultOptions()
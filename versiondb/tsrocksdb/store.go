@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync/atomic"
 
 	"github.com/cosmos/iavl"
 	"github.com/crypto-org-chain/cronos/versiondb"
@@ -17,10 +18,15 @@ import (
 const (
 	TimestampSize = 8
 
-	StorePrefixTpl   = "s/k:%s/"
-	latestVersionKey = "s/latest"
+	StorePrefixTpl     = "s/k:%s/"
+	latestVersionKey   = "s/latest"
+	earliestVersionKey = "s/earliest"
 
 	ImportCommitBatchSize = 10000
+
+	// PruneInterval is the number of versions accumulated beyond the retention window before pruning them,
+	// to avoid updating the manifest on every block.
+	PruneInterval = 100
 )
 
 var (
@@ -43,6 +49,11 @@ type Store struct {
 
 	// see: https://github.com/crypto-org-chain/cronos/issues/1683
 	skipVersionZero bool
+
+	// keepRecent is the number of versions retained before the latest one, 0 means no pruning.
+	keepRecent int64
+	// earliestVersion caches the earliest version retained, -1 means it's not loaded yet.
+	earliestVersion *atomic.Int64
 }
 
 func NewStore(dir string) (Store, error) {
@@ -50,16 +61,26 @@ func NewStore(dir string) (Store, error) {
 	if err != nil {
 		return Store{}, err
 	}
-	return Store{
-		db:       db,
-		cfHandle: cfHandle,
-	}, nil
+	return NewStoreWithDB(db, cfHandle), nil
+}
+
+// NewSecondaryStore opens the versiondb at `dir` as a secondary instance, see `OpenVersionDBAsSecondary`,
+// the writes on it fail.
+func NewSecondaryStore(dir, secondaryDir string) (Store, error) {
+	db, cfHandle, err := OpenVersionDBAsSecondary(dir, secondaryDir)
+	if err != nil {
+		return Store{}, err
+	}
+	return NewStoreWithDB(db, cfHandle), nil
 }
 
 func NewStoreWithDB(db *grocksdb.DB, cfHandle *grocksdb.ColumnFamilyHandle) Store {
+	earliestVersion := new(atomic.Int64)
+	earliestVersion.Store(-1)
 	return Store{
-		db:       db,
-		cfHandle: cfHandle,
+		db:              db,
+		cfHandle:        cfHandle,
+		earliestVersion: earliestVersion,
 	}
 }
 
@@ -67,6 +88,12 @@ func (s *Store) SetSkipVersionZero(skip bool) {
 	s.skipVersionZero = skip
 }
 
+// SetKeepRecent sets the number of versions retained before the latest one, the older versions are pruned
+// when writing new versions, 0 disables the pruning.
+func (s *Store) SetKeepRecent(keepRecent int64) {
+	s.keepRecent = keepRecent
+}
+
 func (s Store) SetLatestVersion(version int64) error {
 	var ts [TimestampSize]byte
 	binary.LittleEndian.PutUint64(ts[:], uint64(version))
@@ -91,10 +118,17 @@ func (s Store) PutAtVersion(version int64, changeSet []*types.StoreKVPair) error
 		}
 	}
 
-	return s.db.Write(defaultSyncWriteOpts, batch)
+	if err := s.db.Write(defaultSyncWriteOpts, batch); err != nil {
+		return err
+	}
+	return s.pruneIfApplicable(version)
 }
 
 func (s Store) GetAtVersionSlice(storeKey string, key []byte, version *int64) (*grocksdb.Slice, error) {
+	if err := s.checkVersion(version); err != nil {
+		return nil, err
+	}
+
 	readOpts := newTSReadOptions(version)
 	defer readOpts.Destroy()
 	value, ts, err := s.db.GetCFWithTS(
@@ -149,6 +183,114 @@ func (s Store) GetLatestVersion() (int64, error) {
 	return int64(binary.LittleEndian.Uint64(bz)), nil
 }
 
+// GetEarliestVersion implements VersionStore interface
+func (s Store) GetEarliestVersion() (int64, error) {
+	if version := s.earliestVersion.Load(); version >= 0 {
+		return version, nil
+	}
+
+	bz, err := s.db.GetBytes(defaultReadOpts, []byte(earliestVersionKey))
+	if err != nil {
+		return 0, err
+	}
+	var version int64
+	if len(bz) > 0 {
+		version = int64(binary.LittleEndian.Uint64(bz))
+	}
+	// don't override the version set by a concurrent pruning
+	s.earliestVersion.CompareAndSwap(-1, version)
+	return s.earliestVersion.Load(), nil
+}
+
+// Prune implements VersionStore interface, the versions older than the target one are dropped by the compactions
+// with the `full_history_ts_low` of the column family.
+func (s Store) Prune(version int64) error {
+	earliest, err := s.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	if version <= earliest {
+		return nil
+	}
+	latest, err := s.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version > latest {
+		return fmt.Errorf("can't prune version %d newer than the latest version %d", version, latest)
+	}
+
+	var ts [TimestampSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(version))
+
+	// record the earliest version first, so the queries on the pruned versions fail before the data is dropped.
+	if err := s.db.Put(defaultSyncWriteOpts, []byte(earliestVersionKey), ts[:]); err != nil {
+		return err
+	}
+	s.earliestVersion.Store(version)
+
+	return s.db.IncreaseFullHistoryTsLow(s.cfHandle, ts[:])
+}
+
+// TryCatchUpWithPrimary applies the updates of the primary instance since the last catch up,
+// only applicable to the secondary instance.
+func (s Store) TryCatchUpWithPrimary() error {
+	if err := s.db.TryCatchUpWithPrimary(); err != nil {
+		return err
+	}
+	// the primary may have pruned more versions
+	s.earliestVersion.Store(-1)
+	return nil
+}
+
+// pruneIfApplicable prunes the versions out of the retention window, in batches of `PruneInterval` versions.
+func (s Store) pruneIfApplicable(latest int64) error {
+	if s.keepRecent <= 0 {
+		return nil
+	}
+	earliest, err := s.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	target := latest - s.keepRecent
+	if target-earliest < PruneInterval {
+		return nil
+	}
+	return s.Prune(target)
+}
+
+// checkVersion rejects the queries on the pruned versions, `nil` version means the latest version.
+func (s Store) checkVersion(version *int64) error {
+	if version == nil {
+		return nil
+	}
+	earliest, err := s.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	if *version < earliest {
+		return fmt.Errorf("%w: %d, earliest version: %d", versiondb.ErrVersionPruned, *version, earliest)
+	}
+	return nil
+}
+
+// Compact runs a manual compaction on the whole column family, to drop the pruned versions immediately.
+func (s Store) Compact() error {
+	earliest, err := s.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	var ts [TimestampSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(earliest))
+
+	opts := grocksdb.NewCompactRangeOptions()
+	defer opts.Destroy()
+	opts.SetFullHistoryTsLow(ts[:])
+	opts.SetBottommostLevelCompaction(grocksdb.KForceOptimized)
+	s.db.CompactRangeCFOpt(s.cfHandle, grocksdb.Range{}, opts)
+	return nil
+}
+
 // IteratorAtVersion implements VersionStore interface
 func (s Store) IteratorAtVersion(storeKey string, start, end []byte, version *int64) (versiondb.Iterator, error) {
 	return s.iteratorAtVersion(storeKey, start, end, version, false)
@@ -164,6 +306,10 @@ func (s Store) iteratorAtVersion(storeKey string, start, end []byte, version *in
 		return nil, errKeyEmpty
 	}
 
+	if err := s.checkVersion(version); err != nil {
+		return nil, err
+	}
+
 	prefix := storePrefix(storeKey)
 	start, end = iterateWithPrefix(prefix, start, end)
 
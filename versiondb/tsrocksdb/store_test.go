@@ -2,6 +2,7 @@ package tsrocksdb
 
 import (
 	"encoding/binary"
+	"strconv"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -20,6 +21,81 @@ func TestTSVersionDB(t *testing.T) {
 	})
 }
 
+func TestKeepRecent(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	store.SetKeepRecent(10)
+
+	for i := int64(0); i <= PruneInterval+20; i++ {
+		require.NoError(t, store.PutAtVersion(i, []*types.StoreKVPair{
+			{StoreKey: "evm", Key: []byte("key"), Value: []byte(strconv.FormatInt(i, 10))},
+		}))
+	}
+	earliest, err := store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(PruneInterval), earliest)
+
+	// the pruned versions are dropped by compaction, the retained ones are not affected
+	require.NoError(t, store.Compact())
+	v := earliest
+	value, err := store.GetAtVersion("evm", []byte("key"), &v)
+	require.NoError(t, err)
+	require.Equal(t, []byte(strconv.FormatInt(earliest, 10)), value)
+	v--
+	_, err = store.GetAtVersion("evm", []byte("key"), &v)
+	require.ErrorIs(t, err, versiondb.ErrVersionPruned)
+
+	// the earliest version is persisted
+	require.NoError(t, store.Flush())
+	store.cfHandle.Destroy()
+	store.db.Close()
+	store, err = NewStore(dir)
+	require.NoError(t, err)
+	earliest, err = store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(PruneInterval), earliest)
+}
+
+func TestSecondaryStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	for i := int64(1); i <= 2; i++ {
+		require.NoError(t, store.PutAtVersion(i, []*types.StoreKVPair{
+			{StoreKey: "evm", Key: []byte("key"), Value: []byte(strconv.FormatInt(i, 10))},
+		}))
+	}
+
+	secondary, err := NewSecondaryStore(dir, t.TempDir())
+	require.NoError(t, err)
+	latest, err := secondary.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latest)
+
+	require.NoError(t, store.PutAtVersion(3, []*types.StoreKVPair{
+		{StoreKey: "evm", Key: []byte("key"), Value: []byte("3")},
+	}))
+	require.NoError(t, store.Prune(2))
+
+	// the updates of primary are visible after catching up
+	require.NoError(t, secondary.TryCatchUpWithPrimary())
+	latest, err = secondary.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest)
+	value, err := secondary.GetAtVersion("evm", []byte("key"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), value)
+	earliest, err := secondary.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), earliest)
+
+	// the secondary instance is read-only
+	require.Error(t, secondary.PutAtVersion(4, []*types.StoreKVPair{
+		{StoreKey: "evm", Key: []byte("key"), Value: []byte("4")},
+	}))
+}
+
 // TestUserTimestampBasic tests the behaviors of user-defined timestamp feature of rocksdb
 func TestUserTimestampBasic(t *testing.T) {
 	key := []byte("hello")
@@ -228,6 +304,13 @@ func TestSkipVersionZero(t *testing.T) {
 	require.Equal(t, []byte{2}, bz)
 }
 
+// TestSkipVersionZeroShared runs the backend independent cases shared with the pebble backend.
+func TestSkipVersionZeroShared(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+	versiondb.RunSkipVersionZero(t, &store)
+}
+
 type kvPair struct {
 	Key   []byte
 	Value []byte
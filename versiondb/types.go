@@ -1,9 +1,14 @@
 package versiondb
 
 import (
+	"errors"
+
 	"cosmossdk.io/store/types"
 )
 
+// ErrVersionPruned is returned when querying the versions older than the earliest version retained.
+var ErrVersionPruned = errors.New("version is pruned")
+
 type Iterator interface {
 	types.Iterator
 
@@ -19,12 +24,19 @@ type VersionStore interface {
 	IteratorAtVersion(storeKey string, start, end []byte, version *int64) (Iterator, error)
 	ReverseIteratorAtVersion(storeKey string, start, end []byte, version *int64) (Iterator, error)
 	GetLatestVersion() (int64, error)
+	// GetEarliestVersion returns the earliest version can be queried, the older versions are pruned,
+	// the queries on them fail with `ErrVersionPruned`.
+	GetEarliestVersion() (int64, error)
 
 	// Persist the change set of a block,
 	// the `changeSet` should be ordered by (storeKey, key),
 	// the version should be latest version plus one.
 	PutAtVersion(version int64, changeSet []*types.StoreKVPair) error
 
+	// Prune drops the versions older than the target version, the target version becomes the earliest one,
+	// it's a noop if the target version is not newer than the earliest version.
+	Prune(version int64) error
+
 	// Import the initial state of the store
 	Import(version int64, ch <-chan ImportEntry) error
 
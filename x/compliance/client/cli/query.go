@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// GetQueryCmd returns the parent command for all compliance CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the compliance module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetParamsCmd(),
+		GetAddressStatusCmd(),
+		GetKYCApprovedCmd(),
+		GetFrozenCmd(),
+	)
+	return cmd
+}
+
+// GetParamsCmd queries the compliance module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets compliance params",
+		Long:  "Gets compliance params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAddressStatusCmd queries the KYC approval and frozen status of an address
+func GetAddressStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-status ADDRESS",
+		Short: "Gets the KYC approval and frozen status of a bech32 or hex address",
+		Long:  "Gets the KYC approval and frozen status of a bech32 or hex address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AddressStatus(cmd.Context(), &types.QueryAddressStatusRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetKYCApprovedCmd queries the KYC-approved addresses
+func GetKYCApprovedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kyc-approved",
+		Short: "Gets the KYC-approved addresses",
+		Long:  "Gets the KYC-approved addresses",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.KYCApproved(cmd.Context(), &types.QueryKYCApprovedRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "kyc-approved")
+	return cmd
+}
+
+// GetFrozenCmd queries the frozen addresses
+func GetFrozenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen",
+		Short: "Gets the frozen addresses",
+		Long:  "Gets the frozen addresses",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Frozen(cmd.Context(), &types.QueryFrozenRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen")
+	return cmd
+}
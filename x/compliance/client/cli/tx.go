@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// NewTxCmd returns a root CLI command handler for compliance transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "compliance subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		newSetKYCCmd("approve-kyc", "Add addresses to the KYC-approved set", true),
+		newSetKYCCmd("revoke-kyc", "Remove addresses from the KYC-approved set", false),
+		newSetFrozenCmd("freeze", "Freeze addresses", true),
+		newSetFrozenCmd("unfreeze", "Unfreeze addresses", false),
+	)
+	return txCmd
+}
+
+// newSetKYCCmd returns a CLI command handler updating the KYC-approved set, it must be
+// signed by the admin.
+func newSetKYCCmd(use, short string, approved bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " ADDRESS...",
+		Short: short + ", the transaction must be signed by the compliance admin",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetKYC(cliCtx.GetFromAddress(), args, approved)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newSetFrozenCmd returns a CLI command handler updating the frozen set, it must be
+// signed by the admin.
+func newSetFrozenCmd(use, short string, frozen bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " ADDRESS...",
+		Short: short + ", the transaction must be signed by the compliance admin",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFrozen(cliCtx.GetFromAddress(), args, frozen)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,45 @@
+package compliance
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/loka-network/loka/v1/x/compliance/keeper"
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	for _, address := range data.KYCApproved {
+		addr, err := types.ParseAddress(address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetKYCApproved(ctx, addr, true)
+	}
+
+	for _, address := range data.Frozen {
+		addr, err := types.ParseAddress(address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAddressFrozen(ctx, addr, true)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.NewGenesisState(k.GetParams(ctx), nil, nil)
+	for _, addr := range k.GetAllKYCApproved(ctx) {
+		genesis.KYCApproved = append(genesis.KYCApproved, addr.String())
+	}
+	for _, addr := range k.GetAllFrozen(ctx) {
+		genesis.Frozen = append(genesis.Frozen, addr.String())
+	}
+	return &genesis
+}
@@ -0,0 +1,70 @@
+package compliance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/loka-network/loka/v1/ibc"
+	"github.com/loka-network/loka/v1/utils"
+	"github.com/loka-network/loka/v1/x/compliance/keeper"
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the transfer middleware given
+// the compliance keeper and the underlying application.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It checks the received coin and recipient against the compliance rules before
+// passing the packet to the underlying application, a denied transfer returns an
+// error acknowledgement so the tokens are refunded on the counterparty chain. The
+// bank transfers of the underlying application are tagged with the IBC source, the
+// allowed transfers are audited by the send restriction when the tokens are credited.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ctx = types.WithSource(ctx, types.SourceIBC)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil || data.ValidateBasic() != nil {
+		// the invalid packets are rejected by the underlying application
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	recipient, err := utils.GetEvmosAddressFromBech32(data.Receiver)
+	if err != nil {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	// the sender lives on the counterparty chain, only the recipient is checked
+	if err := im.keeper.PreCheckTransfer(ctx, types.SourceIBC, nil, recipient, sdk.Coins{coin}); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.Module.OnRecvPacket(ctx, packet, relayer)
+}
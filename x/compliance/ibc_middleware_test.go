@@ -0,0 +1,123 @@
+package compliance_test
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	"github.com/loka-network/loka/v1/x/compliance"
+	"github.com/loka-network/loka/v1/x/compliance/keeper"
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+// mockTransferModule records the source of the received packets
+type mockTransferModule struct {
+	porttypes.IBCModule
+	sources []string
+}
+
+func (m *mockTransferModule) OnRecvPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	m.sources = append(m.sources, types.SourceFromContext(ctx))
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	receiver := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		transfertypes.PortID, "channel-0",
+		transfertypes.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0,
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context, k keeper.Keeper)
+		packet   channeltypes.Packet
+		expAck   bool
+	}{
+		{
+			"no restriction",
+			func(sdk.Context, keeper.Keeper) {},
+			packet, true,
+		},
+		{
+			"frozen receiver",
+			func(ctx sdk.Context, k keeper.Keeper) { k.SetAddressFrozen(ctx, receiver, true) },
+			packet, false,
+		},
+		{
+			"frozen sender is not checked",
+			func(ctx sdk.Context, k keeper.Keeper) { k.SetAddressFrozen(ctx, sender, true) },
+			packet, true,
+		},
+		{
+			"KYC required, receiver not approved",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+			},
+			packet, false,
+		},
+		{
+			"KYC required, receiver approved",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+				k.SetKYCApproved(ctx, receiver, true)
+			},
+			packet, true,
+		},
+		{
+			"invalid packet is passed to the transfer module",
+			func(ctx sdk.Context, k keeper.Keeper) { k.SetAddressFrozen(ctx, receiver, true) },
+			channeltypes.Packet{Data: []byte("invalid")}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			k := keeper.NewKeeper(key, cdc, authtypes.NewModuleAddress(govtypes.ModuleName), mockAccountKeeper{}, nil)
+			tc.malleate(ctx, k)
+
+			transfer := &mockTransferModule{}
+			middleware := compliance.NewIBCMiddleware(k, transfer)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			ack := middleware.OnRecvPacket(ctx, tc.packet, nil)
+			require.Equal(t, tc.expAck, ack.Success())
+			if !tc.expAck {
+				require.Empty(t, transfer.sources)
+				// the denial is audited
+				require.Len(t, ctx.EventManager().Events(), 1)
+				return
+			}
+			require.Equal(t, []string{types.SourceIBC}, transfer.sources)
+			// the allowed transfer is audited by the send restriction once the tokens are credited
+			require.Empty(t, ctx.EventManager().Events())
+		})
+	}
+}
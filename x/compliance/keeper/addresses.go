@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// IsKYCApproved returns true if the address is KYC-approved
+func (k Keeper) IsKYCApproved(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KYCApprovedKey(addr))
+}
+
+// SetKYCApproved adds the address to or removes it from the KYC-approved set
+func (k Keeper) SetKYCApproved(ctx sdk.Context, addr sdk.AccAddress, approved bool) {
+	setFlag(ctx.KVStore(k.storeKey), types.KYCApprovedKey(addr), approved)
+}
+
+// GetAllKYCApproved returns all the KYC-approved addresses
+func (k Keeper) GetAllKYCApproved(ctx sdk.Context) []sdk.AccAddress {
+	return getAll(ctx.KVStore(k.storeKey), types.KeyPrefixKYCApproved)
+}
+
+// IsFrozen returns true if the address is frozen
+func (k Keeper) IsFrozen(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FrozenKey(addr))
+}
+
+// SetAddressFrozen adds the address to or removes it from the frozen set
+func (k Keeper) SetAddressFrozen(ctx sdk.Context, addr sdk.AccAddress, frozen bool) {
+	setFlag(ctx.KVStore(k.storeKey), types.FrozenKey(addr), frozen)
+}
+
+// GetAllFrozen returns all the frozen addresses
+func (k Keeper) GetAllFrozen(ctx sdk.Context) []sdk.AccAddress {
+	return getAll(ctx.KVStore(k.storeKey), types.KeyPrefixFrozen)
+}
+
+func setFlag(store storetypes.KVStore, key []byte, set bool) {
+	if set {
+		store.Set(key, []byte{1})
+		return
+	}
+	store.Delete(key)
+}
+
+func getAll(store storetypes.KVStore, prefix []byte) []sdk.AccAddress {
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, addressFromKey(iterator.Key()[len(prefix):]))
+	}
+	return addrs
+}
+
+// addressFromKey returns the address of a key written with address.MustLengthPrefix
+func addressFromKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[1 : 1+int(key[0])])
+}
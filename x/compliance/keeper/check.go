@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// SendRestriction checks the bank transfers with CheckTransfer, the source of the transfer is
+// taken from the context. It is appended to the send restrictions of the bank keeper.
+func (k Keeper) SendRestriction(goCtx context.Context, from, to sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return to, k.CheckTransfer(ctx, types.SourceFromContext(ctx), from, to, coins)
+}
+
+// CheckTransfer checks a transfer of coins against the compliance rules:
+//   - neither the sender nor the recipient is frozen
+//   - none of the coins has a frozen denom
+//   - both parties are KYC-approved if it is required for any of the coins, module accounts
+//     and ICS20 escrow accounts are exempted
+//
+// The sender or the recipient is not checked if it is empty, e.g. the sender of the IBC receives
+// or the recipient of the EVM contract creations.
+// A compliance_check audit event is emitted for the denied and the KYC-gated transfers.
+func (k Keeper) CheckTransfer(ctx sdk.Context, source string, from, to sdk.AccAddress, coins sdk.Coins) error {
+	kycRequired, err := k.checkTransfer(ctx, from, to, coins)
+	if err == nil && !kycRequired {
+		return nil
+	}
+
+	k.emitCheck(ctx, source, from, to, coins, err)
+	return err
+}
+
+// PreCheckTransfer checks a transfer before it's executed by the bank keeper.
+// Only the denials are audited, the allowed transfers are audited by the send restriction once executed.
+func (k Keeper) PreCheckTransfer(ctx sdk.Context, source string, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if _, err := k.checkTransfer(ctx, from, to, coins); err != nil {
+		k.emitCheck(ctx, source, from, to, coins, err)
+		return err
+	}
+	return nil
+}
+
+// CheckEVMTransfer implements the evm TransferChecker interface, it checks the EVM value transfers
+// before they're executed so a denied transfer fails the call transferring the value.
+func (k Keeper) CheckEVMTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	return k.PreCheckTransfer(ctx, types.SourceEVM, from, to, coins)
+}
+
+// emitCheck emits the compliance_check audit event of a transfer, it's denied if err is not nil.
+func (k Keeper) emitCheck(ctx sdk.Context, source string, from, to sdk.AccAddress, coins sdk.Coins, err error) {
+	decision, reason := types.DecisionAllowed, ""
+	if err != nil {
+		decision, reason = types.DecisionDenied, err.Error()
+		k.Logger(ctx).Info(
+			"transfer denied",
+			"source", source, "sender", from.String(), "recipient", to.String(),
+			"amount", coins.String(), "reason", reason,
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeComplianceCheck,
+			sdk.NewAttribute(types.AttributeKeySource, source),
+			sdk.NewAttribute(types.AttributeKeySender, from.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, to.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDecision, decision),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// checkTransfer returns true if the transfer is subject to a KYC requirement and an error if
+// it is denied.
+func (k Keeper) checkTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) (bool, error) {
+	if !from.Empty() && k.IsFrozen(ctx, from) {
+		return false, errorsmod.Wrapf(types.ErrFrozenAddress, "sender %s", from)
+	}
+	if !to.Empty() && k.IsFrozen(ctx, to) {
+		return false, errorsmod.Wrapf(types.ErrFrozenAddress, "recipient %s", to)
+	}
+
+	params := k.GetParams(ctx)
+	kycRequired := false
+	for _, coin := range coins {
+		if params.IsDenomFrozen(coin.Denom) {
+			return false, errorsmod.Wrapf(types.ErrFrozenDenom, "transfers of %s are disabled", coin.Denom)
+		}
+		kycRequired = kycRequired || params.IsKYCRequired(coin.Denom)
+	}
+	if !kycRequired {
+		return false, nil
+	}
+
+	if !from.Empty() && !k.isKYCExempted(ctx, from) {
+		return true, errorsmod.Wrapf(types.ErrKYCRequired, "sender %s", from)
+	}
+	if !to.Empty() && !k.isKYCExempted(ctx, to) {
+		return true, errorsmod.Wrapf(types.ErrKYCRequired, "recipient %s", to)
+	}
+	return true, nil
+}
+
+// isKYCExempted returns true if the address is KYC-approved, a module account or an ICS20 escrow account
+func (k Keeper) isKYCExempted(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.IsKYCApproved(ctx, addr) {
+		return true
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return true
+	}
+	return k.isEscrowAddress(ctx, addr)
+}
+
+// isEscrowAddress returns true if the address is the escrow account of a channel of the ICS20 transfer port,
+// the escrow accounts are base accounts holding the tokens sent over IBC.
+func (k Keeper) isEscrowAddress(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.channelKeeper == nil {
+		return false
+	}
+	found := false
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		found = channel.PortId == transfertypes.PortID &&
+			addr.Equals(transfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId))
+		return found
+	})
+	return found
+}
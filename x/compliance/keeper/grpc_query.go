@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the compliance module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// AddressStatus returns the KYC approval and frozen status of an address
+func (k Keeper) AddressStatus(c context.Context, req *types.QueryAddressStatusRequest) (*types.QueryAddressStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := types.ParseAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAddressStatusResponse{
+		KYCApproved: k.IsKYCApproved(ctx, addr),
+		Frozen:      k.IsFrozen(ctx, addr),
+	}, nil
+}
+
+// KYCApproved returns the KYC-approved addresses
+func (k Keeper) KYCApproved(c context.Context, req *types.QueryKYCApprovedRequest) (*types.QueryKYCApprovedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := k.paginateAddresses(sdk.UnwrapSDKContext(c), types.KeyPrefixKYCApproved, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryKYCApprovedResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// Frozen returns the frozen addresses
+func (k Keeper) Frozen(c context.Context, req *types.QueryFrozenRequest) (*types.QueryFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := k.paginateAddresses(sdk.UnwrapSDKContext(c), types.KeyPrefixFrozen, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFrozenResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) paginateAddresses(
+	ctx sdk.Context, keyPrefix []byte, pagination *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	var addresses []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		addresses = append(addresses, addressFromKey(key).String())
+		return nil
+	})
+	return addresses, pageRes, err
+}
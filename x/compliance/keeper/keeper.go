@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// Keeper of the compliance module maintains the KYC-approved and frozen address sets
+// and checks the transfers against them.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	accountKeeper types.AccountKeeper
+	channelKeeper types.ChannelKeeper
+}
+
+// NewKeeper creates new instances of the compliance Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	ck types.ChannelKeeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		authority:     authority,
+		accountKeeper: ak,
+		channelKeeper: ck,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
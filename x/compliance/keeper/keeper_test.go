@@ -0,0 +1,352 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	"github.com/loka-network/loka/v1/x/compliance/keeper"
+	"github.com/loka-network/loka/v1/x/compliance/types"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// mockAccountKeeper returns the module accounts of the addresses in the map
+type mockAccountKeeper struct {
+	modules map[string]bool
+}
+
+func (ak mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	if ak.modules[string(addr)] {
+		return authtypes.NewEmptyModuleAccount("module")
+	}
+	return nil
+}
+
+// mockChannelKeeper iterates over the channels in the slice
+type mockChannelKeeper struct {
+	channels []channeltypes.IdentifiedChannel
+}
+
+func (ck mockChannelKeeper) IterateChannels(_ sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
+	for _, channel := range ck.channels {
+		if cb(channel) {
+			return
+		}
+	}
+}
+
+func setupKeeper(t *testing.T, modules ...sdk.AccAddress) (sdk.Context, keeper.Keeper) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ak := mockAccountKeeper{modules: make(map[string]bool)}
+	for _, addr := range modules {
+		ak.modules[string(addr)] = true
+	}
+	ck := mockChannelKeeper{channels: []channeltypes.IdentifiedChannel{
+		{PortId: "oracle", ChannelId: "channel-0"},
+		{PortId: transfertypes.PortID, ChannelId: "channel-1"},
+	}}
+	return ctx, keeper.NewKeeper(key, cdc, authtypes.NewModuleAddress(govtypes.ModuleName), ak, ck)
+}
+
+func TestCheckTransfer(t *testing.T) {
+	alice := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	bob := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	module := authtypes.NewModuleAddress("module")
+	coins := sdk.NewCoins(sdk.NewCoin("aloka", sdkmath.NewInt(1)))
+	restricted := sdk.NewCoins(sdk.NewCoin("restricted", sdkmath.NewInt(1)))
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context, k keeper.Keeper)
+		from, to sdk.AccAddress
+		coins    sdk.Coins
+		expErr   error
+		expEvent bool
+	}{
+		{
+			"no restriction",
+			func(sdk.Context, keeper.Keeper) {},
+			alice, bob, coins, nil, false,
+		},
+		{
+			"frozen sender",
+			func(ctx sdk.Context, k keeper.Keeper) { k.SetAddressFrozen(ctx, alice, true) },
+			alice, bob, coins, types.ErrFrozenAddress, true,
+		},
+		{
+			"frozen recipient",
+			func(ctx sdk.Context, k keeper.Keeper) { k.SetAddressFrozen(ctx, bob, true) },
+			alice, bob, coins, types.ErrFrozenAddress, true,
+		},
+		{
+			"unfrozen sender",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				k.SetAddressFrozen(ctx, alice, true)
+				k.SetAddressFrozen(ctx, alice, false)
+			},
+			alice, bob, coins, nil, false,
+		},
+		{
+			"empty sender is not checked",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+				k.SetKYCApproved(ctx, bob, true)
+			},
+			nil, bob, coins, nil, true,
+		},
+		{
+			"frozen denom",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{
+					DenomRestrictions: []types.DenomRestriction{{Denom: "restricted", Frozen: true}},
+				}))
+			},
+			alice, bob, restricted, types.ErrFrozenDenom, true,
+		},
+		{
+			"KYC required, sender not approved",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+				k.SetKYCApproved(ctx, bob, true)
+			},
+			alice, bob, coins, types.ErrKYCRequired, true,
+		},
+		{
+			"KYC required, recipient not approved",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+				k.SetKYCApproved(ctx, alice, true)
+			},
+			alice, bob, coins, types.ErrKYCRequired, true,
+		},
+		{
+			"KYC required, both approved",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+				k.SetKYCApproved(ctx, alice, true)
+				k.SetKYCApproved(ctx, bob, true)
+			},
+			alice, bob, coins, nil, true,
+		},
+		{
+			"KYC required, module account exempted",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+				k.SetKYCApproved(ctx, alice, true)
+			},
+			alice, module, coins, nil, true,
+		},
+		{
+			"KYC required for denom, other denom",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{
+					DenomRestrictions: []types.DenomRestriction{{Denom: "restricted", KYCRequired: true}},
+				}))
+			},
+			alice, bob, coins, nil, false,
+		},
+		{
+			"KYC required for denom",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetParams(ctx, types.Params{
+					DenomRestrictions: []types.DenomRestriction{{Denom: "restricted", KYCRequired: true}},
+				}))
+			},
+			alice, bob, restricted, types.ErrKYCRequired, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k := setupKeeper(t, module)
+			tc.malleate(ctx, k)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := k.CheckTransfer(ctx, types.SourceBank, tc.from, tc.to, tc.coins)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			events := ctx.EventManager().Events()
+			if !tc.expEvent {
+				require.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 1)
+			require.Equal(t, types.EventTypeComplianceCheck, events[0].Type)
+			decision, found := events[0].GetAttribute(types.AttributeKeyDecision)
+			require.True(t, found)
+			if tc.expErr != nil {
+				require.Equal(t, types.DecisionDenied, decision.Value)
+			} else {
+				require.Equal(t, types.DecisionAllowed, decision.Value)
+			}
+		})
+	}
+}
+
+func TestCheckEVMTransfer(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	alice := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	bob := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin("aloka", sdkmath.NewInt(1)))
+	require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+	k.SetKYCApproved(ctx, alice, true)
+	k.SetKYCApproved(ctx, bob, true)
+
+	// the allowed transfers are audited by the send restriction once executed
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CheckEVMTransfer(ctx, alice, bob, coins))
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the recipient of the contract creations is not known
+	require.NoError(t, k.CheckEVMTransfer(ctx, alice, nil, coins))
+	require.Empty(t, ctx.EventManager().Events())
+
+	k.SetAddressFrozen(ctx, bob, true)
+	require.ErrorIs(t, k.CheckEVMTransfer(ctx, alice, bob, coins), types.ErrFrozenAddress)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	source, found := events[0].GetAttribute(types.AttributeKeySource)
+	require.True(t, found)
+	require.Equal(t, types.SourceEVM, source.Value)
+	decision, found := events[0].GetAttribute(types.AttributeKeyDecision)
+	require.True(t, found)
+	require.Equal(t, types.DecisionDenied, decision.Value)
+
+	k.SetKYCApproved(ctx, alice, false)
+	require.ErrorIs(t, k.CheckEVMTransfer(ctx, alice, nil, coins), types.ErrKYCRequired)
+}
+
+func TestSendRestrictionSource(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	alice := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	bob := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	k.SetAddressFrozen(ctx, bob, true)
+
+	ctx = evmtypes.WithValueTransfer(ctx)
+	to, err := k.SendRestriction(ctx, alice, bob, sdk.NewCoins(sdk.NewCoin("aloka", sdkmath.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrFrozenAddress)
+	require.Equal(t, bob, to)
+
+	source, found := ctx.EventManager().Events()[0].GetAttribute(types.AttributeKeySource)
+	require.True(t, found)
+	require.Equal(t, types.SourceEVM, source.Value)
+}
+
+// TestSendRestrictionIBCEscrow checks the bank sends of the ICS20 transfers from and to the escrow accounts
+func TestSendRestrictionIBCEscrow(t *testing.T) {
+	alice := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+	coins := sdk.NewCoins(sdk.NewCoin("aloka", sdkmath.NewInt(1)))
+
+	testCases := []struct {
+		name     string
+		from, to sdk.AccAddress
+		expErr   error
+	}{
+		{"IBC send escrows the tokens", alice, escrow, nil},
+		{"IBC receive unescrows the tokens", escrow, alice, nil},
+		{
+			"escrow address of an unknown channel",
+			alice, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-2"), types.ErrKYCRequired,
+		},
+		{
+			"escrow address of another port",
+			alice, transfertypes.GetEscrowAddress("oracle", "channel-0"), types.ErrKYCRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k := setupKeeper(t)
+			require.NoError(t, k.SetParams(ctx, types.Params{KYCRequired: true}))
+			k.SetKYCApproved(ctx, alice, true)
+
+			_, err := k.SendRestriction(ctx, tc.from, tc.to, coins)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgServerAuthority(t *testing.T) {
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	admin := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	addr := utiltx.GenerateAddress()
+
+	ctx, k := setupKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.Params{Admin: admin.String()}))
+
+	_, err := k.SetKYC(ctx, types.NewMsgSetKYC(other, []string{addr.Hex()}, true))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = k.SetKYC(ctx, types.NewMsgSetKYC(admin, []string{addr.Hex()}, true))
+	require.NoError(t, err)
+	require.True(t, k.IsKYCApproved(ctx, addr.Bytes()))
+
+	_, err = k.SetFrozen(ctx, types.NewMsgSetFrozen(gov, []string{sdk.AccAddress(addr.Bytes()).String()}, true))
+	require.NoError(t, err)
+	require.True(t, k.IsFrozen(ctx, addr.Bytes()))
+
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: admin.String(), Params: types.DefaultParams()})
+	require.Error(t, err)
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: gov.String(), Params: types.DefaultParams()})
+	require.NoError(t, err)
+
+	// the admin can't manage the address sets once removed
+	_, err = k.SetFrozen(ctx, types.NewMsgSetFrozen(admin, []string{addr.Hex()}, false))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+}
+
+func TestQueryAddresses(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	var addrs []string
+	for i := 0; i < 3; i++ {
+		addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		k.SetKYCApproved(ctx, addr, true)
+		addrs = append(addrs, addr.String())
+	}
+
+	res, err := k.KYCApproved(ctx, &types.QueryKYCApprovedRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Addresses, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	next, err := k.KYCApproved(ctx, &types.QueryKYCApprovedRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, addrs, append(res.Addresses, next.Addresses...))
+
+	frozen, err := k.Frozen(ctx, &types.QueryFrozenRequest{})
+	require.NoError(t, err)
+	require.Empty(t, frozen.Addresses)
+
+	status, err := k.AddressStatus(ctx, &types.QueryAddressStatusRequest{Address: addrs[0]})
+	require.NoError(t, err)
+	require.True(t, status.KYCApproved)
+	require.False(t, status.Frozen)
+
+	_, err = k.AddressStatus(ctx, &types.QueryAddressStatusRequest{Address: "invalid"})
+	require.Error(t, err)
+}
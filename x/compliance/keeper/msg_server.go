@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetKYC implements the gRPC MsgServer interface, it updates the KYC-approved set.
+func (k *Keeper) SetKYC(goCtx context.Context, req *types.MsgSetKYC) (*types.MsgSetKYCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, address := range req.Addresses {
+		addr, err := types.ParseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		k.SetKYCApproved(ctx, addr, req.Approved)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetKYC,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyApproved, strconv.FormatBool(req.Approved)),
+			),
+		)
+	}
+
+	return &types.MsgSetKYCResponse{}, nil
+}
+
+// SetFrozen implements the gRPC MsgServer interface, it updates the frozen set.
+func (k *Keeper) SetFrozen(goCtx context.Context, req *types.MsgSetFrozen) (*types.MsgSetFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, address := range req.Addresses {
+		addr, err := types.ParseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		k.SetAddressFrozen(ctx, addr, req.Frozen)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetFrozen,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(req.Frozen)),
+			),
+		)
+	}
+
+	return &types.MsgSetFrozenResponse{}, nil
+}
+
+// checkAuthority checks that the authority is the governance account or the admin
+func (k Keeper) checkAuthority(ctx sdk.Context, authority string) error {
+	if authority == k.authority.String() {
+		return nil
+	}
+	if admin := k.GetParams(ctx).Admin; admin != "" && authority == admin {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrInvalidAuthority, "%s is neither the governance account nor the admin", authority)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/loka-network/loka/v1/x/compliance/types"
+)
+
+// GetParams returns the total set of compliance parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the compliance parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ParseAddress parses a bech32 or hex address
+func ParseAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	return addr, nil
+}
+
+// validateAddresses checks that the addresses are valid and unique
+func validateAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		addr, err := ParseAddress(address)
+		if err != nil {
+			return err
+		}
+		if seen[string(addr)] {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[string(addr)] = true
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+type sourceKey struct{}
+
+// WithSource returns the context tagging the bank transfers executed with it with the given
+// source in the compliance audit events.
+func WithSource(ctx sdk.Context, source string) sdk.Context {
+	return ctx.WithValue(sourceKey{}, source)
+}
+
+// SourceFromContext returns the source of the bank transfers tagged by WithSource, it is SourceEVM
+// for the EVM value transfers and defaults to SourceBank.
+func SourceFromContext(ctx sdk.Context) string {
+	if evmtypes.IsValueTransfer(ctx) {
+		return SourceEVM
+	}
+	if source, ok := ctx.Value(sourceKey{}).(string); ok {
+		return source
+	}
+	return SourceBank
+}
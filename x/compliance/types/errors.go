@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrFrozenAddress    = errorsmod.Register(ModuleName, 3, "address is frozen")
+	ErrFrozenDenom      = errorsmod.Register(ModuleName, 4, "denom is frozen")
+	ErrKYCRequired      = errorsmod.Register(ModuleName, 5, "address is not KYC-approved")
+)
@@ -0,0 +1,30 @@
+package types
+
+// compliance events
+const (
+	EventTypeComplianceCheck = "compliance_check"
+	EventTypeSetKYC          = "set_kyc"
+	EventTypeSetFrozen       = "set_frozen"
+
+	AttributeKeySource    = "source"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyDecision  = "decision"
+	AttributeKeyReason    = "reason"
+	AttributeKeyAuthority = "authority"
+	AttributeKeyAddress   = "address"
+	AttributeKeyApproved  = "approved"
+	AttributeKeyFrozen    = "frozen"
+
+	DecisionAllowed = "allowed"
+	DecisionDenied  = "denied"
+)
+
+// sources of the checked transfers
+const (
+	SourceBank  = "bank"
+	SourceEVM   = "evm"
+	SourceERC20 = "erc20"
+	SourceIBC   = "ibc"
+)
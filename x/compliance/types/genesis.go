@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, kycApproved, frozen []string) GenesisState {
+	return GenesisState{
+		Params:      params,
+		KYCApproved: kycApproved,
+		Frozen:      frozen,
+	}
+}
+
+// DefaultGenesisState sets default compliance genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := validateAddresses(gs.KYCApproved); err != nil {
+		return fmt.Errorf("invalid KYC-approved addresses: %w", err)
+	}
+	if err := validateAddresses(gs.Frozen); err != nil {
+		return fmt.Errorf("invalid frozen addresses: %w", err)
+	}
+	return gs.Params.Validate()
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve account info.
+type AccountKeeper interface {
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+}
+
+// ChannelKeeper defines the expected IBC channel keeper used to find the ICS20 escrow accounts.
+type ChannelKeeper interface {
+	IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
+}
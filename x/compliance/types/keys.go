@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "compliance"
+
+	// StoreKey key for compliance storage data
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the compliance persistent store
+const (
+	prefixParams = iota + 1
+	prefixKYCApproved
+	prefixFrozen
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey            = []byte{prefixParams}
+	KeyPrefixKYCApproved = []byte{prefixKYCApproved}
+	KeyPrefixFrozen      = []byte{prefixFrozen}
+)
+
+// KYCApprovedKey returns the store key of a KYC-approved address
+func KYCApprovedKey(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixKYCApproved, address.MustLengthPrefix(addr)...)
+}
+
+// FrozenKey returns the store key of a frozen address
+func FrozenKey(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixFrozen, address.MustLengthPrefix(addr)...)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetKYC{}
+	_ sdk.Msg = &MsgSetFrozen{}
+)
+
+// GetSigners returns the expected signers for a MsgUpdateParams message
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgSetKYC creates a new instance of MsgSetKYC
+func NewMsgSetKYC(authority sdk.AccAddress, addresses []string, approved bool) *MsgSetKYC {
+	return &MsgSetKYC{
+		Authority: authority.String(),
+		Addresses: addresses,
+		Approved:  approved,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgSetKYC message
+func (m *MsgSetKYC) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetKYC) ValidateBasic() error {
+	return validateAddressesMsg(m.Authority, m.Addresses)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetKYC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgSetFrozen creates a new instance of MsgSetFrozen
+func NewMsgSetFrozen(authority sdk.AccAddress, addresses []string, frozen bool) *MsgSetFrozen {
+	return &MsgSetFrozen{
+		Authority: authority.String(),
+		Addresses: addresses,
+		Frozen:    frozen,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgSetFrozen message
+func (m *MsgSetFrozen) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFrozen) ValidateBasic() error {
+	return validateAddressesMsg(m.Authority, m.Addresses)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFrozen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func validateAddressesMsg(authority string, addresses []string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if len(addresses) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "addresses can't be empty")
+	}
+	if err := validateAddresses(addresses); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
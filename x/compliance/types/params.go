@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default compliance module parameters, no transfer is restricted
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate performs a stateless validation of the compliance parameters
+func (p Params) Validate() error {
+	if p.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return fmt.Errorf("invalid admin address %s: %w", p.Admin, err)
+		}
+	}
+
+	seen := make(map[string]bool, len(p.DenomRestrictions))
+	for _, restriction := range p.DenomRestrictions {
+		if err := sdk.ValidateDenom(restriction.Denom); err != nil {
+			return fmt.Errorf("invalid denom restriction: %w", err)
+		}
+		if seen[restriction.Denom] {
+			return fmt.Errorf("duplicate denom restriction %s", restriction.Denom)
+		}
+		seen[restriction.Denom] = true
+	}
+	return nil
+}
+
+// GetDenomRestriction returns the restriction of the transfers of the denom
+func (p Params) GetDenomRestriction(denom string) (DenomRestriction, bool) {
+	for _, restriction := range p.DenomRestrictions {
+		if restriction.Denom == denom {
+			return restriction, true
+		}
+	}
+	return DenomRestriction{}, false
+}
+
+// IsKYCRequired returns true if both parties of the transfers of the denom must be KYC-approved
+func (p Params) IsKYCRequired(denom string) bool {
+	if p.KYCRequired {
+		return true
+	}
+	restriction, _ := p.GetDenomRestriction(denom)
+	return restriction.KYCRequired
+}
+
+// IsDenomFrozen returns true if the transfers of the denom are disabled
+func (p Params) IsDenomFrozen(denom string) bool {
+	restriction, _ := p.GetDenomRestriction(denom)
+	return restriction.Frozen
+}
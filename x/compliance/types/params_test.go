@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params Params
+		expErr bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid", Params{
+			Admin:       sdk.AccAddress(common.HexToAddress("0x1000").Bytes()).String(),
+			KYCRequired: true,
+			DenomRestrictions: []DenomRestriction{
+				{Denom: "aloka", Frozen: true},
+				{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", KYCRequired: true},
+			},
+		}, false},
+		{"invalid admin", Params{Admin: "invalid"}, true},
+		{"invalid denom", Params{DenomRestrictions: []DenomRestriction{{Denom: "1"}}}, true},
+		{"duplicate denom", Params{DenomRestrictions: []DenomRestriction{{Denom: "aloka"}, {Denom: "aloka"}}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		genesis GenesisState
+		expErr  bool
+	}{
+		{"default", *DefaultGenesisState(), false},
+		{"valid", NewGenesisState(DefaultParams(), []string{"0x1000000000000000000000000000000000000000"}, []string{"0x2000000000000000000000000000000000000000"}), false},
+		{"invalid address", NewGenesisState(DefaultParams(), []string{"invalid"}, nil), true},
+		{"duplicate address", NewGenesisState(DefaultParams(), nil, []string{
+			"0x2000000000000000000000000000000000000000", "0x2000000000000000000000000000000000000000",
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -138,6 +138,11 @@ func (k Keeper) PostTxProcessing(
 			continue
 		}
 
+		// the transfers of a native precompile pair already moved the coins
+		if k.IsNativePrecompile(ctx, contractAddr) {
+			continue
+		}
+
 		// create the corresponding sdk.Coin that is paired with ERC20
 		coins := sdk.Coins{{Denom: pair.Denom, Amount: math.NewIntFromBigInt(tokens)}}
 
@@ -111,8 +111,9 @@ func (k Keeper) OnRecvPacket(
 	}
 
 	pair, _ := k.GetTokenPair(ctx, pairID)
-	if !pair.Enabled {
-		// no-op: continue with the rest of the stack without conversion
+	if !pair.Enabled || k.IsNativePrecompile(ctx, pair.GetERC20Contract()) {
+		// no-op: continue with the rest of the stack without conversion, the coins of
+		// a native precompile pair are already its ERC20 balance
 		return ack
 	}
 
@@ -209,6 +210,12 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 		return nil
 	}
 
+	pair, _ := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+	if k.IsNativePrecompile(ctx, pair.GetERC20Contract()) {
+		// no-op, the refunded coins are already the ERC20 balance
+		return nil
+	}
+
 	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(sender), sender)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated the
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/loka-network/loka/v1/contracts"
+	teststypes "github.com/loka-network/loka/v1/types/tests"
+	"github.com/loka-network/loka/v1/utils"
+	"github.com/loka-network/loka/v1/x/erc20/types"
+	"github.com/loka-network/loka/v1/x/evm/precompiles"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("ICS-20 precompiled contract", Ordered, func() {
+	var (
+		sender, receiver string
+		senderAcc        sdk.AccAddress
+		receiverAcc      sdk.AccAddress
+		amount           int64 = 10
+	)
+
+	alokaMeta := banktypes.Metadata{
+		Description: "Base Denom for Evmos Chain",
+		Base:        utils.BaseDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    teststypes.AevmosDenomtrace.BaseDenom,
+				Exponent: 0,
+			},
+		},
+		Name:    utils.BaseDenom,
+		Symbol:  erc20Symbol,
+		Display: teststypes.AevmosDenomtrace.BaseDenom,
+	}
+
+	// transfer calls the precompiled contract from the sender and relays the sent packet to Osmosis
+	transfer := func(denom string) *big.Int {
+		endpoint := s.pathOsmosisEvmos.EndpointB
+		from := common.BytesToAddress(senderAcc)
+		res, err := s.app.Erc20Keeper.CallEVM(
+			s.EvmosChain.GetContext(), precompiles.ICS20ABI, from, precompiles.ICS20ContractAddress, true,
+			precompiles.IBCTransferMethodName,
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID, denom, big.NewInt(amount), receiver,
+			timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight, uint64(0), "",
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(res.VmError)
+
+		// the transfer and send packet events are returned as logs of the precompiled contract
+		s.Require().Len(res.Logs, 2)
+		for _, log := range res.Logs {
+			s.Require().Equal(precompiles.ICS20ContractAddress.Hex(), log.Address)
+		}
+		out, err := precompiles.ICS20ABI.Unpack(precompiles.IBCTransferMethodName, res.Ret)
+		s.Require().NoError(err)
+		sequence := out[0].(uint64)
+
+		s.EvmosChain.NextBlock()
+		err = s.pathOsmosisEvmos.EndpointA.UpdateClient()
+		s.Require().NoError(err)
+
+		data := transfertypes.NewFungibleTokenPacketData(utils.BaseDenom, strconv.FormatInt(amount, 10), sender, receiver, "")
+		packet := channeltypes.NewPacket(
+			data.GetBytes(), sequence,
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+			s.pathOsmosisEvmos.EndpointA.ChannelConfig.PortID, s.pathOsmosisEvmos.EndpointA.ChannelID,
+			timeoutHeight, 0,
+		)
+		err = s.pathOsmosisEvmos.RelayPacket(packet)
+		s.Require().NoError(err)
+
+		return new(big.Int).SetUint64(sequence)
+	}
+
+	BeforeEach(func() {
+		s.suiteIBCTesting = true
+		s.SetupTest()
+		s.suiteIBCTesting = false
+
+		sender = s.EvmosChain.SenderAccount.GetAddress().String()
+		receiver = s.IBCOsmosisChain.SenderAccount.GetAddress().String()
+		senderAcc = sdk.MustAccAddressFromBech32(sender)
+		receiverAcc = sdk.MustAccAddressFromBech32(receiver)
+	})
+
+	It("should transfer coins over IBC", func() {
+		balanceBefore := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), senderAcc, utils.BaseDenom)
+
+		s.Require().Equal(big.NewInt(1), transfer(utils.BaseDenom))
+
+		balanceAfter := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), senderAcc, utils.BaseDenom)
+		s.Require().Equal(balanceBefore.Amount.Sub(math.NewInt(amount)), balanceAfter.Amount)
+
+		ibcBalance := s.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(s.IBCOsmosisChain.GetContext(), receiverAcc, teststypes.AevmosIbcdenom)
+		s.Require().Equal(amount, ibcBalance.Amount.Int64())
+	})
+
+	It("should convert the ERC20 balance of a token pair before the transfer", func() {
+		pair, err := s.app.Erc20Keeper.RegisterCoin(s.EvmosChain.GetContext(), alokaMeta)
+		s.Require().NoError(err)
+
+		// keep less coins than the transfer amount
+		balance := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), senderAcc, utils.BaseDenom)
+		convertAmt := balance.Amount.Sub(math.NewInt(amount / 2))
+		msg := types.NewMsgConvertCoin(sdk.NewCoin(utils.BaseDenom, convertAmt), common.BytesToAddress(senderAcc), senderAcc)
+		_, err = s.app.Erc20Keeper.ConvertCoin(s.EvmosChain.GetContext(), msg)
+		s.Require().NoError(err)
+
+		transfer(types.ModuleName + "/" + pair.Erc20Address)
+
+		// only the missing coins are converted back
+		tokenBalance := s.app.Erc20Keeper.BalanceOf(s.EvmosChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(senderAcc))
+		s.Require().Equal(convertAmt.Sub(math.NewInt(amount-amount/2)).BigInt(), tokenBalance)
+		coinBalance := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), senderAcc, utils.BaseDenom)
+		s.Require().True(coinBalance.Amount.IsZero())
+
+		ibcBalance := s.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(s.IBCOsmosisChain.GetContext(), receiverAcc, teststypes.AevmosIbcdenom)
+		s.Require().Equal(amount, ibcBalance.Amount.Int64())
+	})
+
+	It("should fail for an unregistered ERC20 token", func() {
+		contract, err := s.DeployContractToChain("coin", "token", erc20Decimals)
+		s.Require().NoError(err)
+
+		endpoint := s.pathOsmosisEvmos.EndpointB
+		res, err := s.app.Erc20Keeper.CallEVM(
+			s.EvmosChain.GetContext(), precompiles.ICS20ABI, common.BytesToAddress(senderAcc), precompiles.ICS20ContractAddress, true,
+			precompiles.IBCTransferMethodName,
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID, contract.Hex(), big.NewInt(amount), receiver,
+			timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight, uint64(0), "",
+		)
+		s.Require().True(err != nil || res.VmError != "")
+	})
+})
@@ -39,6 +39,8 @@ type Keeper struct {
 	bankKeeper    bankkeeper.Keeper
 	evmKeeper     types.EVMKeeper
 	stakingKeeper types.StakingKeeper
+	// optional, checks the conversions against the compliance rules
+	complianceKeeper types.ComplianceKeeper
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -67,6 +69,12 @@ func NewKeeper(
 	}
 }
 
+// SetComplianceKeeper sets the compliance keeper checking the conversions
+func (k *Keeper) SetComplianceKeeper(ck types.ComplianceKeeper) *Keeper {
+	k.complianceKeeper = ck
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -28,6 +28,7 @@ import (
 // MintingEnabled checks that:
 //   - the global parameter for erc20 conversion is enabled
 //   - minting is enabled for the given (erc20,coin) token pair
+//   - the token pair is not served by a native precompiled contract
 //   - recipient address is not on the blocked list
 //   - bank module transfers are enabled for the Cosmos coin
 func (k Keeper) MintingEnabled(
@@ -61,6 +62,12 @@ func (k Keeper) MintingEnabled(
 		)
 	}
 
+	if k.IsNativePrecompile(ctx, pair.GetERC20Contract()) {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrNativePrecompile, "token '%s' doesn't need to be converted", token,
+		)
+	}
+
 	if k.bankKeeper.BlockedAddr(receiver.Bytes()) {
 		return types.TokenPair{}, errorsmod.Wrapf(
 			errortypes.ErrUnauthorized, "%s is not allowed to receive transactions", receiver,
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	"github.com/loka-network/loka/v1/contracts"
+	compliancetypes "github.com/loka-network/loka/v1/x/compliance/types"
 	"github.com/loka-network/loka/v1/x/erc20/types"
 )
 
@@ -40,7 +41,8 @@ func (k Keeper) ConvertCoin(
 	goCtx context.Context,
 	msg *types.MsgConvertCoin,
 ) (*types.MsgConvertCoinResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	// tag the bank transfers of the conversion in the compliance audit events
+	ctx := compliancetypes.WithSource(sdk.UnwrapSDKContext(goCtx), compliancetypes.SourceERC20)
 
 	// Error checked during msg validation
 	receiver := common.HexToAddress(msg.Receiver)
@@ -51,6 +53,10 @@ func (k Keeper) ConvertCoin(
 		return nil, err
 	}
 
+	if err := k.checkCompliance(ctx, sender, receiver.Bytes(), sdk.Coins{msg.Coin}); err != nil {
+		return nil, err
+	}
+
 	// Remove token pair if contract is suicided
 	erc20 := common.HexToAddress(pair.Erc20Address)
 	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
@@ -82,7 +88,8 @@ func (k Keeper) ConvertERC20(
 	goCtx context.Context,
 	msg *types.MsgConvertERC20,
 ) (*types.MsgConvertERC20Response, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	// tag the bank transfers of the conversion in the compliance audit events
+	ctx := compliancetypes.WithSource(sdk.UnwrapSDKContext(goCtx), compliancetypes.SourceERC20)
 
 	// Error checked during msg validation
 	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
@@ -93,6 +100,11 @@ func (k Keeper) ConvertERC20(
 		return nil, err
 	}
 
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: msg.Amount}}
+	if err := k.checkCompliance(ctx, sender.Bytes(), receiver, coins); err != nil {
+		return nil, err
+	}
+
 	// Remove token pair if contract is suicided
 	erc20 := common.HexToAddress(pair.Erc20Address)
 	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
@@ -118,6 +130,15 @@ func (k Keeper) ConvertERC20(
 	}
 }
 
+// checkCompliance checks the conversion against the compliance rules if the compliance
+// keeper is set.
+func (k Keeper) checkCompliance(ctx sdk.Context, sender, receiver sdk.AccAddress, coins sdk.Coins) error {
+	if k.complianceKeeper == nil {
+		return nil
+	}
+	return k.complianceKeeper.CheckTransfer(ctx, compliancetypes.SourceERC20, sender, receiver, coins)
+}
+
 // convertCoinNativeCoin handles the coin conversion for a native Cosmos coin
 // token pair:
 //   - escrow coins on module account
@@ -542,6 +563,10 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateNativePrecompiles(ctx, req.Params.NativePrecompiles); err != nil {
+		return nil, err
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
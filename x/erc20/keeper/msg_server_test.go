@@ -13,6 +13,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	compliancetypes "github.com/loka-network/loka/v1/x/compliance/types"
 	"github.com/loka-network/loka/v1/x/erc20/keeper"
 	"github.com/loka-network/loka/v1/x/erc20/types"
 	"github.com/loka-network/loka/v1/x/evm/statedb"
@@ -83,6 +85,38 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 			false,
 			false,
 		},
+		{
+			"ok - KYC approved account",
+			100,
+			10,
+			func(common.Address) {
+				suite.Require().NoError(suite.app.ComplianceKeeper.SetParams(suite.ctx, compliancetypes.Params{KYCRequired: true}))
+				suite.app.ComplianceKeeper.SetKYCApproved(suite.ctx, suite.address.Bytes(), true)
+			},
+			func() {},
+			true,
+			false,
+		},
+		{
+			"fail - KYC required",
+			100,
+			10,
+			func(common.Address) {
+				suite.Require().NoError(suite.app.ComplianceKeeper.SetParams(suite.ctx, compliancetypes.Params{KYCRequired: true}))
+			},
+			func() {},
+			false,
+			false,
+		},
+		{
+			"fail - frozen account",
+			100,
+			10,
+			func(common.Address) {},
+			func() { suite.app.ComplianceKeeper.SetAddressFrozen(suite.ctx, suite.address.Bytes(), true) },
+			false,
+			false,
+		},
 		{
 			"fail - deleted module account - force fail", 100, 10, func(common.Address) {},
 			func() {
@@ -218,6 +252,20 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 		{"ok - equal funds", 10, 10, 10, func() {}, true},
 		{"fail - insufficient funds", 10, 1, 5, func() {}, false},
 		{"fail ", 10, 1, -5, func() {}, false},
+		{
+			"fail - frozen account", 100, 10, 5,
+			func() { suite.app.ComplianceKeeper.SetAddressFrozen(suite.ctx, suite.address.Bytes(), true) },
+			false,
+		},
+		{
+			"fail - frozen denom", 100, 10, 5,
+			func() {
+				suite.Require().NoError(suite.app.ComplianceKeeper.SetParams(suite.ctx, compliancetypes.Params{
+					DenomRestrictions: []compliancetypes.DenomRestriction{{Denom: cosmosTokenBase, Frozen: true}},
+				}))
+			},
+			false,
+		},
 		{
 			"fail - deleted module account - force fail", 100, 10, 5,
 			func() {
@@ -1395,3 +1443,51 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParamsNativePrecompiles() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	pair := suite.setupRegisterCoin(metadataCoin)
+	erc20 := pair.GetERC20Contract()
+	suite.Commit()
+
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, math.NewInt(100)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+	msg := types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, math.NewInt(40)), suite.address, sender)
+	_, err := suite.app.Erc20Keeper.ConvertCoin(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	// an unregistered token can't be served by a native precompile
+	params := types.DefaultParams()
+	params.NativePrecompiles = []string{utiltx.GenerateAddress().Hex()}
+	_, err = suite.app.Erc20Keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	params.NativePrecompiles = []string{erc20.Hex()}
+	_, err = suite.app.Erc20Keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.Erc20Keeper.IsNativePrecompile(suite.ctx, erc20))
+	suite.Commit()
+
+	// the ERC20 balance stays in the contract until the holder uses it, it's added to the coins
+	// by the native precompiled contract
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase)
+	suite.Require().Equal(int64(60), balance.Amount.Int64())
+	suite.Require().Equal(big.NewInt(100), suite.BalanceOf(erc20, suite.address))
+
+	// the token pair doesn't need to be converted anymore
+	_, err = suite.app.Erc20Keeper.ConvertCoin(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNativePrecompile)
+
+	// the native precompile can't be removed
+	_, err = suite.app.Erc20Keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: types.DefaultParams()})
+	suite.Require().ErrorIs(err, types.ErrNativePrecompile)
+	suite.Require().ErrorContains(err, fmt.Sprintf("native precompiles [%s] can't be removed", erc20.Hex()))
+
+	suite.mintFeeCollector = false
+}
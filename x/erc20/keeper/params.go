@@ -17,7 +17,9 @@
 package keeper
 
 import (
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/loka-network/loka/v1/x/erc20/types"
 )
 
@@ -27,8 +29,9 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
+	nativePrecompiles := k.GetNativePrecompiles(ctx)
 
-	return types.NewParams(enableErc20, enableEvmHook)
+	return types.NewParams(enableErc20, enableEvmHook, nativePrecompiles)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -39,6 +42,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setEnableEVMHook(ctx, params.EnableEVMHook)
+	k.setNativePrecompiles(ctx, params.NativePrecompiles)
 
 	return nil
 }
@@ -74,3 +78,42 @@ func (k Keeper) setEnableEVMHook(ctx sdk.Context, enable bool) {
 	}
 	store.Delete(types.ParamStoreKeyEnableEVMHook)
 }
+
+// GetNativePrecompiles returns the ERC20 addresses of the token pairs served by a native
+// precompiled contract
+func (k Keeper) GetNativePrecompiles(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNativePrecompile)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var precompiles []string
+	for ; iterator.Valid(); iterator.Next() {
+		precompiles = append(precompiles, common.BytesToAddress(iterator.Key()).Hex())
+	}
+	return precompiles
+}
+
+// IsNativePrecompile returns true if the token pair ERC20 address is served by a native
+// precompiled contract
+func (k Keeper) IsNativePrecompile(ctx sdk.Context, erc20 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNativePrecompile)
+	return store.Has(erc20.Bytes())
+}
+
+// setNativePrecompiles replaces the NativePrecompiles param in the store
+func (k Keeper) setNativePrecompiles(ctx sdk.Context, precompiles []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNativePrecompile)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, precompile := range precompiles {
+		store.Set(common.HexToAddress(precompile).Bytes(), isTrue)
+	}
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"math/big"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/loka-network/loka/v1/x/erc20/types"
+)
+
+// GetAllowance returns the allowance of the owner to the spender on a native precompile ERC20 token
+func (k Keeper) GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.AllowanceKey(erc20, owner, spender))
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetAllowance sets the allowance of the owner to the spender on a native precompile ERC20 token,
+// a zero allowance is deleted.
+func (k Keeper) SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AllowanceKey(erc20, owner, spender)
+	if value.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, value.Bytes())
+}
+
+// validateNativePrecompiles checks that the native precompiles param update only adds registered
+// token pairs of native coins, the precompiles can't be removed as the ERC20 contracts balances
+// are migrated to the bank module by the native precompiled contracts as the holders use them.
+func (k Keeper) validateNativePrecompiles(ctx sdk.Context, precompiles []string) error {
+	current := make(map[common.Address]bool)
+	for _, precompile := range k.GetNativePrecompiles(ctx) {
+		current[common.HexToAddress(precompile)] = true
+	}
+
+	for _, precompile := range precompiles {
+		erc20 := common.HexToAddress(precompile)
+		if current[erc20] {
+			delete(current, erc20)
+			continue
+		}
+
+		pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, erc20))
+		if !found {
+			return errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", precompile)
+		}
+		if !pair.IsNativeCoin() {
+			return errorsmod.Wrapf(
+				types.ErrNativePrecompile, "token '%s' is not the token pair of a native coin", precompile,
+			)
+		}
+	}
+
+	if len(current) == 0 {
+		return nil
+	}
+
+	removed := make([]string, 0, len(current))
+	for erc20 := range current {
+		removed = append(removed, erc20.Hex())
+	}
+	slices.Sort(removed)
+	return errorsmod.Wrapf(types.ErrNativePrecompile, "native precompiles %v can't be removed", removed)
+}
@@ -34,4 +34,5 @@ var (
 	ErrEVMDenom               = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
 	ErrEVMCall                = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
 	ErrERC20TokenPairDisabled = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrNativePrecompile       = errorsmod.Register(ModuleName, 14, "token pair is served by a native precompiled contract")
 )
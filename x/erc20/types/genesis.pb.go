@@ -85,6 +85,9 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// native_precompiles is the list of the token pair ERC20 addresses served by a native ERC20
+	// precompiled contract, which operates on the bank balances of the pair denom.
+	NativePrecompiles []string `protobuf:"bytes,3,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,6 +137,13 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetNativePrecompiles() []string {
+	if m != nil {
+		return m.NativePrecompiles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -142,27 +152,29 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x9b, 0x29, 0x43, 0xd2, 0x4d, 0xb1, 0x88, 0xcc, 0x21, 0xd9, 0xdc, 0x69, 0x17, 0x53,
-	0x37, 0xbd, 0x78, 0x93, 0xc1, 0x50, 0x10, 0x61, 0x4c, 0xf1, 0xe0, 0x65, 0xa4, 0x23, 0xb6, 0x75,
-	0x76, 0xaf, 0x24, 0x59, 0xd1, 0x7f, 0xc0, 0xb3, 0x7f, 0xd6, 0x8e, 0x3b, 0x7a, 0x1a, 0xd2, 0xfe,
-	0x23, 0x92, 0xa4, 0x3b, 0xb8, 0xdb, 0x7b, 0xdf, 0xf7, 0xfb, 0x5e, 0xc2, 0x87, 0x4f, 0x79, 0x96,
-	0x80, 0xf4, 0xb9, 0x98, 0xf6, 0x2f, 0xfc, 0xac, 0xe7, 0x87, 0x7c, 0xce, 0x65, 0x2c, 0x69, 0x2a,
-	0x40, 0x81, 0xb7, 0x6f, 0x5c, 0x6a, 0x5c, 0x9a, 0xf5, 0x9a, 0xcd, 0x2d, 0xda, 0x1a, 0x86, 0x6d,
-	0x1e, 0x85, 0x10, 0x82, 0x19, 0x7d, 0x3d, 0x59, 0xb5, 0xf3, 0x85, 0x70, 0xed, 0xd6, 0xde, 0x7c,
-	0x54, 0x4c, 0x71, 0xef, 0x0a, 0x57, 0x53, 0x26, 0x58, 0x22, 0x1b, 0xa8, 0x8d, 0xba, 0x6e, 0xff,
-	0x98, 0xfe, 0x7f, 0x83, 0x8e, 0x8c, 0x3b, 0xd8, 0x5d, 0xae, 0x5b, 0xce, 0xb8, 0x64, 0xbd, 0x1b,
-	0xec, 0x2a, 0x98, 0xf1, 0xf9, 0x24, 0x65, 0xb1, 0x90, 0x8d, 0x4a, 0x7b, 0xa7, 0xeb, 0xf6, 0x4f,
-	0xb6, 0xa3, 0x4f, 0x1a, 0x19, 0xb1, 0x58, 0x94, 0x69, 0xac, 0x36, 0x82, 0xec, 0xbc, 0xe2, 0xaa,
-	0xbd, 0xec, 0x9d, 0xe1, 0x1a, 0x9f, 0xb3, 0xe0, 0x9d, 0x4f, 0x4c, 0xd0, 0xfc, 0x63, 0x6f, 0xec,
-	0x5a, 0x6d, 0xa8, 0x25, 0xef, 0x1a, 0x1f, 0x6c, 0x90, 0x2c, 0x99, 0x44, 0x00, 0xb3, 0x46, 0x45,
-	0x53, 0x83, 0xc3, 0x7c, 0xdd, 0xaa, 0x0f, 0x2d, 0xf9, 0xfc, 0x70, 0x07, 0x30, 0x1b, 0xd7, 0xcb,
-	0x60, 0x96, 0xe8, 0x75, 0x70, 0xbf, 0xcc, 0x09, 0x5a, 0xe5, 0x04, 0xfd, 0xe6, 0x04, 0x7d, 0x17,
-	0xc4, 0x59, 0x15, 0xc4, 0xf9, 0x29, 0x88, 0xf3, 0xd2, 0x0b, 0x63, 0x15, 0x2d, 0x02, 0x3a, 0x85,
-	0xc4, 0x8f, 0xb8, 0x5a, 0x9c, 0xa7, 0x02, 0xde, 0xf8, 0x54, 0xd9, 0x25, 0x5a, 0x04, 0xba, 0xd1,
-	0x8f, 0xb2, 0x5c, 0xf5, 0x99, 0x72, 0x19, 0x54, 0x4d, 0x89, 0x97, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x74, 0xfd, 0xa2, 0x98, 0xa6, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xeb, 0x40,
+	0x14, 0x85, 0x93, 0xf6, 0x51, 0xde, 0x9b, 0xb4, 0x4f, 0x1a, 0x44, 0x62, 0x91, 0xb4, 0x76, 0xd5,
+	0x4d, 0x13, 0x5b, 0xdd, 0xb8, 0x93, 0x42, 0x51, 0x10, 0xa1, 0x44, 0x71, 0xe1, 0x26, 0x4c, 0xc2,
+	0x25, 0x89, 0x6d, 0x32, 0xc3, 0xcc, 0x34, 0xe8, 0x1f, 0x70, 0xed, 0xc6, 0xff, 0xd4, 0x65, 0x97,
+	0xae, 0x8a, 0xa4, 0x7f, 0x44, 0x32, 0x93, 0x22, 0x76, 0x77, 0xef, 0x39, 0xdf, 0xb9, 0x33, 0x1c,
+	0x74, 0x02, 0x79, 0x4a, 0xb8, 0x0b, 0x2c, 0x1c, 0x9f, 0xb9, 0xf9, 0xc8, 0x8d, 0x20, 0x03, 0x9e,
+	0x70, 0x87, 0x32, 0x22, 0x88, 0xf9, 0x5f, 0xba, 0x8e, 0x74, 0x9d, 0x7c, 0xd4, 0xe9, 0xec, 0xd1,
+	0xca, 0x90, 0x6c, 0xe7, 0x30, 0x22, 0x11, 0x91, 0xa3, 0x5b, 0x4e, 0x4a, 0xed, 0xbf, 0xe9, 0xa8,
+	0x79, 0xad, 0x6e, 0xde, 0x0b, 0x2c, 0xc0, 0xbc, 0x40, 0x0d, 0x8a, 0x19, 0x4e, 0xb9, 0xa5, 0xf7,
+	0xf4, 0x81, 0x31, 0x3e, 0x72, 0x7e, 0xbf, 0xe1, 0xcc, 0xa4, 0x3b, 0xf9, 0xb3, 0xda, 0x74, 0x35,
+	0xaf, 0x62, 0xcd, 0x2b, 0x64, 0x08, 0x32, 0x87, 0xcc, 0xa7, 0x38, 0x61, 0xdc, 0xaa, 0xf5, 0xea,
+	0x03, 0x63, 0x7c, 0xbc, 0x1f, 0x7d, 0x28, 0x91, 0x19, 0x4e, 0x58, 0x95, 0x46, 0x62, 0x27, 0xf0,
+	0xfe, 0x87, 0x8e, 0x1a, 0xea, 0xb4, 0x79, 0x8a, 0x9a, 0x90, 0xe1, 0x60, 0x01, 0xbe, 0x4c, 0xca,
+	0x8f, 0xfc, 0xf5, 0x0c, 0xa5, 0x4d, 0x4b, 0xc9, 0xbc, 0x44, 0x07, 0x3b, 0x24, 0x4f, 0xfd, 0x98,
+	0x90, 0xb9, 0x55, 0x2b, 0xa9, 0x49, 0xbb, 0xd8, 0x74, 0x5b, 0x53, 0x45, 0x3e, 0xde, 0xdd, 0x10,
+	0x32, 0xf7, 0x5a, 0x55, 0x30, 0x4f, 0xcb, 0xd5, 0x1c, 0x22, 0x33, 0xc3, 0x22, 0xc9, 0xc1, 0xa7,
+	0x0c, 0x42, 0x92, 0xd2, 0x64, 0x01, 0xdc, 0xaa, 0xf7, 0xea, 0x83, 0x7f, 0x5e, 0x5b, 0x39, 0xb3,
+	0x1f, 0x63, 0x72, 0xfb, 0x34, 0x8a, 0x12, 0x11, 0x2f, 0x03, 0x27, 0x24, 0xa9, 0x1b, 0x83, 0x58,
+	0x0e, 0x29, 0x23, 0xcf, 0x10, 0x0a, 0xb5, 0xc4, 0xcb, 0xa0, 0x6c, 0xfa, 0xa5, 0x2a, 0x5d, 0xbc,
+	0x52, 0xe0, 0xab, 0xc2, 0xd6, 0xd7, 0x85, 0xad, 0x7f, 0x15, 0xb6, 0xfe, 0xbe, 0xb5, 0xb5, 0xf5,
+	0xd6, 0xd6, 0x3e, 0xb7, 0xb6, 0x16, 0x34, 0x64, 0xe9, 0xe7, 0xdf, 0x03, 0x00, 0xdf, 0x4c, 0x09,
+	0x76, 0xd6, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -232,6 +244,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NativePrecompiles) > 0 {
+		for iNdEx := len(m.NativePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NativePrecompiles[iNdEx])
+			copy(dAtA[i:], m.NativePrecompiles[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -295,6 +316,12 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if len(m.NativePrecompiles) > 0 {
+		for _, s := range m.NativePrecompiles {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -490,6 +517,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePrecompiles = append(m.NativePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -68,6 +68,12 @@ type EVMKeeper interface {
 	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 }
 
+// ComplianceKeeper defines the expected interface needed to check the conversions against the
+// compliance rules.
+type ComplianceKeeper interface {
+	CheckTransfer(ctx sdk.Context, source string, from, to sdk.AccAddress, coins sdk.Coins) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -45,6 +45,8 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixNativePrecompile
+	prefixAllowance
 )
 
 // KVStore key prefixes
@@ -52,4 +54,15 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixNativePrecompile = []byte{prefixNativePrecompile}
+	KeyPrefixAllowance        = []byte{prefixAllowance}
 )
+
+// AllowanceKey returns the store key of the allowance of an owner to a spender on a native
+// precompile ERC20 token
+func AllowanceKey(erc20, owner, spender common.Address) []byte {
+	key := make([]byte, 0, 3*common.AddressLength)
+	key = append(key, erc20.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return append(key, spender.Bytes()...)
+}
@@ -18,6 +18,8 @@ package types
 
 import (
 	fmt "fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Parameter store key
@@ -30,10 +32,12 @@ var (
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	nativePrecompiles []string,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:       enableErc20,
+		EnableEVMHook:     enableEVMHook,
+		NativePrecompiles: nativePrecompiles,
 	}
 }
 
@@ -53,10 +57,52 @@ func ValidateBool(i interface{}) error {
 	return nil
 }
 
+// ValidatePrecompiles checks that the precompiled contract addresses are valid and unique.
+//
+// The native precompiles list can only grow, which is checked by the keeper on MsgUpdateParams
+// against the stored list. Once a pair is served by a native precompile its balances are moved
+// from the ERC20 contract storage to the bank module as the holders use it, removing the
+// precompile would bring back the contract code with stale balances, so there's no way to retire it.
+func ValidatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+		addr := common.HexToAddress(precompile)
+		if seen[addr] {
+			return fmt.Errorf("duplicated precompile address %s", precompile)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := ValidateBool(p.EnableEVMHook); err != nil {
 		return err
 	}
 
+	if err := ValidatePrecompiles(p.NativePrecompiles); err != nil {
+		return err
+	}
+
 	return ValidateBool(p.EnableErc20)
 }
+
+// IsNativePrecompile returns true if the token pair ERC20 address is served by a native
+// precompiled contract
+func (p Params) IsNativePrecompile(erc20 common.Address) bool {
+	for _, precompile := range p.NativePrecompiles {
+		if common.HexToAddress(precompile) == erc20 {
+			return true
+		}
+	}
+	return false
+}
@@ -24,9 +24,24 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", types.DefaultParams(), false},
 		{
 			"valid",
-			types.NewParams(true, true),
+			types.NewParams(true, true, nil),
 			false,
 		},
+		{
+			"valid native precompiles",
+			types.NewParams(true, true, []string{"0xdAC17F958D2ee523a2206206994597C13D831ec7"}),
+			false,
+		},
+		{
+			"invalid native precompile address",
+			types.NewParams(true, true, []string{"aloka"}),
+			true,
+		},
+		{
+			"duplicated native precompiles",
+			types.NewParams(true, true, []string{"0xdAC17F958D2ee523a2206206994597C13D831ec7", "0xdac17f958d2ee523a2206206994597c13d831ec7"}),
+			true,
+		},
 		{
 			"empty",
 			types.Params{},
@@ -48,4 +63,6 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(types.ValidateBool(1))
 	suite.Require().NoError(types.ValidateBool(true))
+	suite.Require().Error(types.ValidatePrecompiles(true))
+	suite.Require().NoError(types.ValidatePrecompiles([]string{}))
 }
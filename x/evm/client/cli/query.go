@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetPermissionsCmd(),
 	)
 	return cmd
 }
@@ -147,3 +148,40 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPermissionsCmd queries if an account is allowed to create and call contracts
+func GetPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions ADDRESS",
+		Short: "Gets the contract create and call permissions of an account",
+		Long:  "Gets if the permission policies of the evm params allow an account to create and call contracts.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPermissionsRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.Permissions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -40,7 +41,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewSetFeeDenomCmd(),
+	)
 	return cmd
 }
 
@@ -75,7 +79,11 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			builder := clientCtx.TxConfig.NewTxBuilder()
+			// the fee granter, if any, sponsors the fees of the ethereum tx
+			builder.SetFeeGranter(clientCtx.FeeGranter)
+
+			tx, err := msg.BuildTx(builder, rsp.Params.EvmDenom)
 			if err != nil {
 				return err
 			}
@@ -124,3 +132,35 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetFeeDenomCmd command opts in paying the fees of the ethereum txs sent or sponsored by the
+// account in an alternative fee denomination
+func NewSetFeeDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-denom [DENOM]",
+		Short: "Pay the fees of the ethereum txs sent or sponsored by the account in an alternative fee denom, or in the evm denom if omitted",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFeeDenom{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if len(args) == 1 {
+				msg.Denom = args[0]
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -36,13 +36,14 @@ func InitGenesis(
 	accountKeeper types.AccountKeeper,
 	data types.GenesisState,
 ) []abci.ValidatorUpdate {
-	k.WithChainID(ctx)
-
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	// the chain id is validated against the EVM chain id of the genesis params
+	k.WithChainID(ctx)
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -82,6 +83,10 @@ func InitGenesis(
 		}
 	}
 
+	for _, afd := range data.AccountFeeDenoms {
+		k.SetAccountFeeDenom(ctx, sdk.MustAccAddressFromBech32(afd.Address), afd.Denom)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -110,7 +115,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:         ethGenAccounts,
+		Params:           k.GetParams(ctx),
+		AccountFeeDenoms: k.GetAllAccountFeeDenoms(ctx),
 	}
 }
@@ -17,6 +17,7 @@ package keeper
 
 import (
 	"math/big"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,7 +45,9 @@ type EVMBlockConfig struct {
 	// cache the big.Int version of block number, avoid repeated allocation
 	BlockNumber *big.Int
 	BlockTime   uint64
-	Rules       params.Rules
+	// Rules are the chain rules of the block with the time based Shanghai activation, they select the
+	// precompiled contracts, the access list of the txs and the instruction set.
+	Rules params.Rules
 }
 
 // EVMConfig encapsulates common parameters needed to create an EVM to execute a message
@@ -100,6 +103,8 @@ func (k *Keeper) EVMBlockConfig(ctx sdk.Context, chainID *big.Int) (*EVMBlockCon
 	}
 	blockNumber := big.NewInt(ctx.BlockHeight())
 	rules := ethCfg.Rules(blockNumber, ethCfg.MergeNetsplitBlock != nil)
+	// the Shanghai changes are activated by the block time rather than the shanghai_block
+	rules.IsShanghai = params.ChainConfig.IsShanghai(blockTime)
 
 	var zero common.Hash
 	cfg := &EVMBlockConfig{
@@ -159,16 +164,22 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 }
 
 // VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// module parameters, plus the Shanghai instruction set change once the fork is active. The config
+// generated uses the default JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, cfg *EVMConfig) vm.Config {
 	noBaseFee := true
 	if types.IsLondon(cfg.ChainConfig, ctx.BlockHeight()) {
 		noBaseFee = cfg.FeeMarketParams.NoBaseFee
 	}
 
+	extraEIPs := cfg.Params.EIPs()
+	if cfg.Rules.IsShanghai && !slices.Contains(extraEIPs, types.ShanghaiEIP) {
+		extraEIPs = append(extraEIPs, types.ShanghaiEIP)
+	}
+
 	return vm.Config{
 		Tracer:    cfg.GetTracer(),
 		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		ExtraEips: extraEIPs,
 	}
 }
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/x/evm/keeper"
+	"github.com/loka-network/loka/v1/x/evm/types"
+	feemarkettypes "github.com/loka-network/loka/v1/x/feemarket/types"
+)
+
+// The Cancun instructions, they're not defined by the EVM.
+const (
+	opBlobBaseFee = 0x4a
+	opTLoad       = 0x5c
+	opTStore      = 0x5d
+	opMCopy       = 0x5e
+)
+
+// TestVMConfigForks runs the instructions of the Shanghai and Cancun forks with the EVM config of
+// the keeper, the Cancun ones are not supported by the EVM.
+func TestVMConfigForks(t *testing.T) {
+	ethCfg := types.DefaultChainConfig().EthereumConfig(big.NewInt(9000))
+	push1 := func(n int) []byte {
+		code := []byte{}
+		for i := 0; i < n; i++ {
+			code = append(code, byte(vm.PUSH1), 0)
+		}
+		return code
+	}
+
+	testCases := []struct {
+		name     string
+		code     []byte
+		shanghai bool
+		expValid bool
+	}{
+		{"PUSH0 before shanghai", []byte{byte(vm.PUSH0)}, false, false},
+		{"PUSH0 after shanghai", []byte{byte(vm.PUSH0)}, true, true},
+		{"TLOAD", append(push1(1), opTLoad), true, false},
+		{"TSTORE", append(push1(2), opTStore), true, false},
+		{"MCOPY", append(push1(3), opMCopy), true, false},
+		{"BLOBBASEFEE", []byte{opBlobBaseFee}, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := ethCfg.Rules(big.NewInt(1), ethCfg.MergeNetsplitBlock != nil)
+			rules.IsShanghai = tc.shanghai
+			cfg := &keeper.EVMConfig{
+				EVMBlockConfig: &keeper.EVMBlockConfig{
+					Params:          types.DefaultParams(),
+					FeeMarketParams: feemarkettypes.DefaultParams(),
+					ChainConfig:     ethCfg,
+					Rules:           rules,
+				},
+			}
+			vmCfg := keeper.Keeper{}.VMConfig(sdk.Context{}, cfg)
+
+			db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			require.NoError(t, err)
+			contract := common.HexToAddress("0x1000")
+			db.SetCode(contract, append(tc.code, byte(vm.STOP)))
+
+			blockCtx := vm.BlockContext{
+				CanTransfer: core.CanTransfer,
+				Transfer:    core.Transfer,
+				BlockNumber: big.NewInt(1),
+			}
+			evm := vm.NewEVM(blockCtx, vm.TxContext{}, db, ethCfg, vmCfg)
+			_, _, err = evm.Call(vm.AccountRef(common.HexToAddress("0x2000")), contract, nil, 100000, big.NewInt(0))
+			if tc.expValid {
+				require.NoError(t, err)
+				return
+			}
+			var invalidOpCode *vm.ErrInvalidOpCode
+			require.ErrorAs(t, err, &invalidOpCode)
+		})
+	}
+}
+
+// mockPrecompile is a custom precompiled contract at a fixed address
+type mockPrecompile struct {
+	vm.PrecompiledContract
+}
+
+func (mockPrecompile) Address() common.Address {
+	return common.HexToAddress("0x0900")
+}
+
+// TestNewEVMRules checks that the custom precompiled contracts are created with the rules of the
+// EVM config, the Shanghai fork is activated by the block time rather than the block number.
+func TestNewEVMRules(t *testing.T) {
+	ethCfg := types.DefaultChainConfig().EthereumConfig(big.NewInt(9000))
+	ethCfg.ShanghaiBlock = nil
+
+	for _, shanghai := range []bool{false, true} {
+		var rules params.Rules
+		k := keeper.NewKeeper(
+			nil, nil, nil, nil,
+			authtypes.NewModuleAddress(govtypes.ModuleName), mockAccountKeeper{}, &mockBankKeeper{},
+			nil, nil, "", paramstypes.Subspace{},
+			[]keeper.CustomContractFn{func(_ sdk.Context, r params.Rules) vm.PrecompiledContract {
+				rules = r
+				return mockPrecompile{}
+			}},
+		)
+
+		blockRules := ethCfg.Rules(big.NewInt(1), ethCfg.MergeNetsplitBlock != nil)
+		blockRules.IsShanghai = shanghai
+		cfg := &keeper.EVMConfig{
+			EVMBlockConfig: &keeper.EVMBlockConfig{
+				Params:          types.DefaultParams(),
+				FeeMarketParams: feemarkettypes.DefaultParams(),
+				ChainConfig:     ethCfg,
+				Rules:           blockRules,
+			},
+			Tracer: types.NewNoOpTracer(),
+		}
+		ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(1)
+		sender := common.HexToAddress("0x2000")
+		msg := ethtypes.NewMessage(
+			sender, &sender, 0, big.NewInt(0), 21000, big.NewInt(0),
+			big.NewInt(0), big.NewInt(0), nil, nil, false,
+		)
+		db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		require.NoError(t, err)
+
+		evm := k.NewEVM(ctx, msg, cfg, db)
+		require.Equal(t, shanghai, rules.IsShanghai)
+		require.Contains(t, evm.ActivePrecompiles(blockRules), mockPrecompile{}.Address())
+	}
+}
+
+func TestIntrinsicGasShanghai(t *testing.T) {
+	testCases := []struct {
+		name               string
+		dataSize           int
+		isContractCreation bool
+		shanghai           bool
+		expErr             error
+		expGas             uint64
+	}{
+		{
+			"create before shanghai, no init code gas",
+			64, true, false, nil,
+			params.TxGasContractCreation + 64*params.TxDataZeroGas,
+		},
+		{
+			"call after shanghai, no init code gas",
+			64, false, true, nil,
+			params.TxGas + 64*params.TxDataZeroGas,
+		},
+		{
+			"create after shanghai, init code gas per word",
+			65, true, true, nil,
+			params.TxGasContractCreation + 65*params.TxDataZeroGas + 3*types.InitCodeWordGas,
+		},
+		{
+			"create after shanghai, max init code size",
+			types.MaxInitCodeSize, true, true, nil,
+			params.TxGasContractCreation + types.MaxInitCodeSize*params.TxDataZeroGas + types.MaxInitCodeSize/32*types.InitCodeWordGas,
+		},
+		{
+			"create after shanghai, init code too large",
+			types.MaxInitCodeSize + 1, true, true, types.ErrMaxInitCodeSizeExceeded, 0,
+		},
+		{
+			"create before shanghai, init code size not limited",
+			types.MaxInitCodeSize + 1, true, false, nil,
+			params.TxGasContractCreation + (types.MaxInitCodeSize+1)*params.TxDataZeroGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gas, err := keeper.IntrinsicGas(make([]byte, tc.dataSize), nil, tc.isContractCreation, true, true, tc.shanghai)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, gas)
+		})
+	}
+}
@@ -0,0 +1,4 @@
+package keeper
+
+// RejectMessage exposes rejectMessage to the keeper_test package.
+var RejectMessage = (*Keeper).rejectMessage
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// GetAccountFeeDenom returns the alternative fee denomination the account opted in paying the fees
+// of its Ethereum txs in, it's empty when the fees are paid in evm_denom.
+func (k Keeper) GetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.FeeDenomKey(addr)))
+}
+
+// SetAccountFeeDenom sets the alternative fee denomination the account pays the fees of its
+// Ethereum txs in, an empty denom opts out.
+func (k Keeper) SetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	if denom == "" {
+		store.Delete(types.FeeDenomKey(addr))
+		return
+	}
+	store.Set(types.FeeDenomKey(addr), []byte(denom))
+}
+
+// GetAllAccountFeeDenoms returns the alternative fee denominations opted in by the accounts.
+func (k Keeper) GetAllAccountFeeDenoms(ctx sdk.Context) []types.AccountFeeDenom {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var feeDenoms []types.AccountFeeDenom
+	for ; iterator.Valid(); iterator.Next() {
+		feeDenoms = append(feeDenoms, types.AccountFeeDenom{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Denom:   string(iterator.Value()),
+		})
+	}
+	return feeDenoms
+}
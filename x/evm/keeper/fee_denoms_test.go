@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	"github.com/loka-network/loka/v1/x/evm/keeper"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+func TestSetFeeDenom(t *testing.T) {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	key := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), key, nil, nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName), mockAccountKeeper{}, nil,
+		nil, nil, "", paramstypes.Subspace{}, nil,
+	)
+	params := evmtypes.DefaultParams()
+	params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyOneDec()}}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := k.SetFeeDenom(ctx, &evmtypes.MsgSetFeeDenom{Sender: sender.String(), Denom: "uosmo"})
+	require.ErrorIs(t, err, evmtypes.ErrInvalidFeeDenom)
+	require.Empty(t, k.GetAccountFeeDenom(ctx, sender))
+
+	_, err = k.SetFeeDenom(ctx, &evmtypes.MsgSetFeeDenom{Sender: sender.String(), Denom: "uatom"})
+	require.NoError(t, err)
+	require.Equal(t, "uatom", k.GetAccountFeeDenom(ctx, sender))
+	require.Equal(t, []evmtypes.AccountFeeDenom{{Address: sender.String(), Denom: "uatom"}}, k.GetAllAccountFeeDenoms(ctx))
+
+	// the empty denom opts out
+	_, err = k.SetFeeDenom(ctx, &evmtypes.MsgSetFeeDenom{Sender: sender.String()})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAccountFeeDenom(ctx, sender))
+	require.Empty(t, k.GetAllAccountFeeDenoms(ctx))
+}
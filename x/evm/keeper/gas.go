@@ -16,9 +16,11 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	errorsmod "cosmossdk.io/errors"
@@ -31,16 +33,58 @@ import (
 )
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
-func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *params.ChainConfig, isContractCreation bool) (uint64, error) {
+func (k *Keeper) GetEthIntrinsicGas(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *params.ChainConfig,
+	isContractCreation, shanghai bool,
+) (uint64, error) {
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
-	// shanghai := cfg.IsShanghai(height.Uint64())
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	return IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
+// IntrinsicGas computes the intrinsic gas of a message like core.IntrinsicGas, after Shanghai the
+// init code of a contract creation is limited to MaxInitCodeSize and charged per word (EIP-3860).
+//
+// NOTE: only the contract creation txs are covered, the EVM doesn't support EIP-3860 so the init
+// code of the CREATE and CREATE2 instructions is neither limited nor charged.
+func IntrinsicGas(
+	data []byte,
+	accessList ethtypes.AccessList,
+	isContractCreation, homestead, istanbul, shanghai bool,
+) (uint64, error) {
+	gas, err := core.IntrinsicGas(data, accessList, isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+	if !isContractCreation || !shanghai {
+		return gas, nil
+	}
+
+	if len(data) > types.MaxInitCodeSize {
+		return 0, errorsmod.Wrapf(
+			types.ErrMaxInitCodeSizeExceeded, "code size %d, limit %d", len(data), types.MaxInitCodeSize,
+		)
+	}
+	words := toWordSize(uint64(len(data)))
+	if (math.MaxUint64-gas)/types.InitCodeWordGas < words {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + words*types.InitCodeWordGas, nil
+}
+
+// toWordSize returns the ceiled word size required for init code payment calculation.
+func toWordSize(size uint64) uint64 {
+	if size > math.MaxUint64-31 {
+		return math.MaxUint64/32 + 1
+	}
+	return (size + 31) / 32
+}
+
+// RefundGas transfers the leftover gas to the sender of the message, or to the fee payer sponsoring it, caped to half of the total gas
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
@@ -55,10 +99,22 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		// the fees paid in an alternative fee denomination are refunded in the same denomination
+		if feeDenom, ok := types.FeeDenomFromContext(ctx); ok {
+			refundedCoins = feeDenom.ConvertRefund(remaining)
+			if refundedCoins.IsZero() {
+				return nil
+			}
+		}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees,
+		// or to the sponsor which paid the fees
+		payer := msg.From()
+		if sponsor, ok := types.FeePayerFromContext(ctx); ok {
+			payer = sponsor
+		}
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccountVirtual(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccountVirtual(ctx, authtypes.FeeCollectorName, payer.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	"github.com/loka-network/loka/v1/x/evm/keeper"
+	"github.com/loka-network/loka/v1/x/evm/statedb"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+	feemarkettypes "github.com/loka-network/loka/v1/x/feemarket/types"
+)
+
+type mockAccountKeeper struct {
+	evmtypes.AccountKeeper
+}
+
+func (mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (mockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+// mockBankKeeper records the coins refunded by the fee collector
+type mockBankKeeper struct {
+	evmtypes.BankKeeper
+	refunds map[string]sdk.Coins
+}
+
+func (k *mockBankKeeper) SendCoinsFromModuleToAccountVirtual(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if senderModule != authtypes.FeeCollectorName {
+		panic("invalid refund module " + senderModule)
+	}
+	k.refunds[recipientAddr.String()] = k.refunds[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+// mockFeeMarketKeeper returns the fee market params with the min gas multiplier
+type mockFeeMarketKeeper struct {
+	evmtypes.FeeMarketKeeper
+	minGasMultiplier sdkmath.LegacyDec
+}
+
+func (k mockFeeMarketKeeper) GetParams(sdk.Context) feemarkettypes.Params {
+	params := feemarkettypes.DefaultParams()
+	params.MinGasMultiplier = k.minGasMultiplier
+	return params
+}
+
+func TestRejectMessageGasUsed(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name             string
+		gasLimit         uint64
+		minGasMultiplier sdkmath.LegacyDec
+		expGasUsed       uint64
+	}{
+		{"gas limit is the intrinsic gas", 21000, sdkmath.LegacyNewDecWithPrec(5, 1), 21000},
+		{"min gas multiplier of the gas limit", 100000, sdkmath.LegacyNewDecWithPrec(5, 1), 50000},
+		{"min gas multiplier below the intrinsic gas", 30000, sdkmath.LegacyNewDecWithPrec(5, 1), 21000},
+		{"no min gas multiplier", 100000, sdkmath.LegacyZeroDec(), 21000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k := keeper.NewKeeper(
+				nil, nil, nil, nil,
+				authtypes.NewModuleAddress(govtypes.ModuleName), mockAccountKeeper{}, &mockBankKeeper{},
+				nil, mockFeeMarketKeeper{minGasMultiplier: tc.minGasMultiplier}, "", paramstypes.Subspace{}, nil,
+			)
+			ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(1)
+			cfg := &keeper.EVMConfig{
+				EVMBlockConfig: &keeper.EVMBlockConfig{
+					ChainConfig: evmtypes.DefaultChainConfig().EthereumConfig(big.NewInt(9000)),
+				},
+			}
+			txConfig := statedb.NewEmptyTxConfig(common.Hash{})
+
+			msg := ethtypes.NewMessage(
+				sender, &sender, 0, big.NewInt(0), tc.gasLimit, big.NewInt(10),
+				big.NewInt(10), big.NewInt(0), nil, nil, false,
+			)
+			res, err := keeper.RejectMessage(k, ctx, msg, cfg, txConfig, errors.New("rejected"))
+			require.NoError(t, err)
+			require.True(t, res.Failed())
+			require.Contains(t, res.VmError, evmtypes.ErrPreTxProcessing.Error())
+			require.Equal(t, tc.expGasUsed, res.GasUsed)
+		})
+	}
+}
+
+func TestRefundGasFeePayer(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+	sponsor := utiltx.GenerateAddress()
+	// 1 aloka is paid with 0.5 uatom
+	feeDenom := evmtypes.FeeDenom{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)}
+
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context) sdk.Context
+		leftover  uint64
+		expPayer  common.Address
+		expRefund sdk.Coins
+	}{
+		{
+			"refunded to the sender",
+			func(ctx sdk.Context) sdk.Context { return ctx },
+			1000, sender, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 10000)),
+		},
+		{
+			"refunded to the sponsor",
+			func(ctx sdk.Context) sdk.Context { return evmtypes.WithFeePayer(ctx, sponsor) },
+			1000, sponsor, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 10000)),
+		},
+		{
+			"refunded to the sender in the fee denom",
+			func(ctx sdk.Context) sdk.Context { return evmtypes.WithFeeDenom(ctx, feeDenom) },
+			1001, sender, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5005)),
+		},
+		{
+			"refund rounded down to nothing in the fee denom",
+			func(ctx sdk.Context) sdk.Context {
+				return evmtypes.WithFeeDenom(ctx, evmtypes.FeeDenom{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(1, 2)})
+			},
+			1, sender, nil,
+		},
+		{
+			"refunded to the sponsor in the fee denom",
+			func(ctx sdk.Context) sdk.Context {
+				return evmtypes.WithFeeDenom(evmtypes.WithFeePayer(ctx, sponsor), feeDenom)
+			},
+			1000, sponsor, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000)),
+		},
+		{
+			"no leftover gas",
+			func(ctx sdk.Context) sdk.Context { return evmtypes.WithFeePayer(ctx, sponsor) },
+			0, sponsor, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bankKeeper := &mockBankKeeper{refunds: make(map[string]sdk.Coins)}
+			k := keeper.NewKeeper(
+				nil, nil, nil, nil,
+				authtypes.NewModuleAddress(govtypes.ModuleName), mockAccountKeeper{}, bankKeeper,
+				nil, nil, "", paramstypes.Subspace{}, nil,
+			)
+			ctx := tc.malleate(sdk.Context{}.WithContext(context.Background()))
+
+			msg := ethtypes.NewMessage(
+				sender, &sender, 0, big.NewInt(0), 21000, big.NewInt(10),
+				big.NewInt(10), big.NewInt(0), nil, nil, false,
+			)
+			require.NoError(t, k.RefundGas(ctx, msg, tc.leftover, evmtypes.DefaultEVMDenom))
+			if tc.expRefund == nil {
+				require.Empty(t, bankKeeper.refunds)
+				return
+			}
+			require.Equal(t, map[string]sdk.Coins{sdk.AccAddress(tc.expPayer.Bytes()).String(): tc.expRefund}, bankKeeper.refunds)
+		})
+	}
+}
@@ -224,6 +224,30 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
+// Permissions implements the Query/Permissions gRPC method, it returns if the sender of a
+// transaction is allowed to create and call contracts.
+func (k Keeper) Permissions(c context.Context, req *types.QueryPermissionsRequest) (*types.QueryPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	address := common.HexToAddress(req.Address)
+
+	return &types.QueryPermissionsResponse{
+		CanCreate: params.EnableCreate && params.AccessControl.CheckPermission(address, true) == nil,
+		CanCall:   params.EnableCall && params.AccessControl.CheckPermission(address, false) == nil,
+	}, nil
+}
+
 // EthCall implements eth_call rpc api.
 func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.MsgEthereumTxResponse, error) {
 	if req == nil {
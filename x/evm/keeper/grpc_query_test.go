@@ -410,6 +410,27 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryPermissions() {
+	deployer := utiltx.GenerateAddress()
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.AccessControl = types.AccessControl{
+		Create: types.AccessPolicy{AccessType: types.AccessTypeAllowlist, Addresses: []string{deployer.Hex()}},
+		Call:   types.AccessPolicy{AccessType: types.AccessTypeDenylist, Addresses: []string{suite.address.Hex()}},
+	}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	res, err := suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: deployer.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryPermissionsResponse{CanCreate: true, CanCall: true}, res)
+
+	res, err = suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: suite.address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryPermissionsResponse{CanCreate: false, CanCall: false}, res)
+
+	_, err = suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: "0x1"})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
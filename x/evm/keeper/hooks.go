@@ -23,7 +23,10 @@ import (
 	"github.com/loka-network/loka/v1/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks      = MultiEvmHooks{}
+	_ types.EvmPreTxHooks = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -42,3 +45,17 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// PreTxProcessing delegate the call to the underlying hooks implementing EvmPreTxHooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg core.Message, cfg *types.PreTxConfig) error {
+	for i := range mh {
+		preHooks, ok := mh[i].(types.EvmPreTxHooks)
+		if !ok {
+			continue
+		}
+		if err := preHooks.PreTxProcessing(ctx, msg, cfg); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
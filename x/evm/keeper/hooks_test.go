@@ -31,6 +31,15 @@ func (dh FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtype
 	return errors.New("post tx processing failed")
 }
 
+// RejectHook rejects all the txs before their execution
+type RejectHook struct {
+	LogRecordHook
+}
+
+func (dh *RejectHook) PreTxProcessing(_ sdk.Context, _ core.Message, _ *types.PreTxConfig) error {
+	return errors.New("pre tx processing failed")
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -88,3 +97,51 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestEvmPreTxHooks() {
+	testCases := []struct {
+		msg       string
+		setupHook func() types.EvmHooks
+		expReject bool
+	}{
+		{
+			"post processing hook only",
+			func() types.EvmHooks {
+				return &LogRecordHook{}
+			},
+			false,
+		},
+		{
+			"reject hook",
+			func() types.EvmHooks {
+				return &RejectHook{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.app.EvmKeeper = suite.app.EvmKeeper.CleanHooks()
+		suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(tc.setupHook()))
+
+		ethSigner := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+		tx, err := newSignedEthTx(templateAccessListTx,
+			suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+			sdk.AccAddress(suite.address.Bytes()),
+			suite.signer,
+			ethSigner,
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+		suite.Require().NoError(err, tc.msg)
+		suite.Require().Equal(tc.expReject, res.Failed(), tc.msg)
+		if tc.expReject {
+			suite.Require().Contains(res.VmError, types.ErrPreTxProcessing.Error(), tc.msg)
+			// the template tx gas limit is its intrinsic gas
+			suite.Require().Equal(tx.Gas(), res.GasUsed, tc.msg)
+			suite.Require().Empty(res.Logs, tc.msg)
+		}
+	}
+}
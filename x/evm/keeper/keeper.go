@@ -40,6 +40,10 @@ import (
 // CustomContractFn defines a custom precompiled contract generator with ctx, rules and returns a precompiled contract.
 type CustomContractFn func(sdk.Context, params.Rules) vm.PrecompiledContract
 
+// DynamicContractsFn defines a generator of the precompiled contracts whose addresses depend on the
+// chain state, like the native ERC20 precompiled contracts of the erc20 token pairs.
+type DynamicContractsFn func(sdk.Context, params.Rules) []vm.PrecompiledContract
+
 // Keeper grants access to the EVM module state and implements the go-ethereum StateDB interface.
 type Keeper struct {
 	// Protobuf codec
@@ -78,8 +82,11 @@ type Keeper struct {
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 	// Legacy subspace
-	ss                paramstypes.Subspace
-	customContractFns []CustomContractFn
+	ss                 paramstypes.Subspace
+	customContractFns  []CustomContractFn
+	dynamicContractsFn DynamicContractsFn
+	// optional, checks the value transfers before they're executed
+	transferChecker types.TransferChecker
 }
 
 // NewKeeper generates new evm module keeper
@@ -127,7 +134,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-// WithChainID sets the chain id to the local variable in the keeper
+// WithChainID sets the chain id to the local variable in the keeper, the EIP155 chain id of the
+// chain identifier must match the EVMChainID param when it's set.
 func (k *Keeper) WithChainID(ctx sdk.Context) {
 	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
 	if err != nil {
@@ -138,8 +146,8 @@ func (k *Keeper) WithChainID(ctx sdk.Context) {
 		panic("chain id already set")
 	}
 
-	if !(chainID.Cmp(big.NewInt(567001)) == 0 || chainID.Cmp(big.NewInt(567000)) == 0 || chainID.Cmp(big.NewInt(567002)) == 0) {
-		panic("EVM only supports Evmos chain identifiers (567000 or 567001 or 567002)")
+	if err := types.ValidateEVMChainID(chainID, k.GetParams(ctx).EVMChainID); err != nil {
+		panic(err)
 	}
 
 	k.eip155ChainID = chainID
@@ -264,6 +272,28 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetDynamicContractsFn sets the generator of the state dependent precompiled contracts.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetDynamicContractsFn(fn DynamicContractsFn) *Keeper {
+	if k.dynamicContractsFn != nil {
+		panic("cannot set dynamic precompiled contracts twice")
+	}
+
+	k.dynamicContractsFn = fn
+	return k
+}
+
+// SetTransferChecker sets the checker of the EVM value transfers.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetTransferChecker(checker types.TransferChecker) *Keeper {
+	if k.transferChecker != nil {
+		panic("cannot set transfer checker twice")
+	}
+
+	k.transferChecker = checker
+	return k
+}
+
 // CleanHooks resets the hooks for the EVM module
 // NOTE: Should only be used for testing purposes
 func (k *Keeper) CleanHooks() *Keeper {
@@ -271,6 +301,16 @@ func (k *Keeper) CleanHooks() *Keeper {
 	return k
 }
 
+// PreTxProcessing delegate the call to the hooks. If no hook implementing EvmPreTxHooks has been registered, this
+// function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg core.Message, cfg *types.PreTxConfig) error {
+	preHooks, ok := k.hooks.(types.EvmPreTxHooks)
+	if !ok {
+		return nil
+	}
+	return preHooks.PreTxProcessing(ctx, msg, cfg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -5,6 +5,8 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	evmostypes "github.com/loka-network/loka/v1/types"
 	"github.com/loka-network/loka/v1/x/evm/keeper"
 	"github.com/loka-network/loka/v1/x/evm/statedb"
@@ -17,6 +19,7 @@ func (suite *KeeperTestSuite) TestWithChainID() {
 	testCases := []struct {
 		name       string
 		chainID    string
+		evmChainID uint64
 		expChainID int64
 		expPanic   bool
 	}{
@@ -24,32 +27,58 @@ func (suite *KeeperTestSuite) TestWithChainID() {
 			"fail - chainID is empty",
 			"",
 			0,
+			0,
 			true,
 		},
 		{
-			"fail - other chainID",
+			"success - other chainID",
 			"chain_7701-1",
 			0,
-			true,
+			7701,
+			false,
 		},
 		{
 			"success - Evmos mainnet chain ID",
 			"loka_567001-2",
+			0,
 			567001,
 			false,
 		},
 		{
 			"success - Evmos testnet chain ID",
 			"loka_567000-4",
+			0,
 			567000,
 			false,
 		},
+		{
+			"success - custom chain ID matching the EVM chain ID",
+			"lokadev_9000-1",
+			9000,
+			9000,
+			false,
+		},
+		{
+			"fail - chain ID not matching the EVM chain ID",
+			"loka_567000-4",
+			567001,
+			0,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			keeper := keeper.Keeper{}
-			ctx := suite.ctx.WithChainID(tc.chainID)
+			ctx, _ := suite.ctx.WithChainID(tc.chainID).CacheContext()
+			params := suite.app.EvmKeeper.GetParams(ctx)
+			params.EVMChainID = tc.evmChainID
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+
+			keeper := keeper.NewKeeper(
+				suite.app.AppCodec(), suite.app.GetKey(evmtypes.StoreKey), suite.app.GetTKey(evmtypes.TransientKey), nil,
+				authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper, suite.app.BankKeeper,
+				suite.app.StakingKeeper, suite.app.FeeMarketKeeper, "", suite.app.GetSubspace(evmtypes.ModuleName), nil,
+			)
 
 			if tc.expPanic {
 				suite.Require().Panics(func() {
@@ -32,6 +32,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hashicorp/go-metrics"
 
+	evmostypes "github.com/loka-network/loka/v1/types"
 	"github.com/loka-network/loka/v1/x/evm/types"
 )
 
@@ -110,6 +111,10 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
 	}
 
+	if payer, ok := types.FeePayerFromContext(ctx); ok {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyFeePayer, payer.Hex()))
+	}
+
 	if response.Failed() {
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
@@ -154,9 +159,39 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the chain would halt on the next block if the EVM chain id doesn't match the chain identifier
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateEVMChainID(chainID, req.Params.EVMChainID); err != nil {
+		return nil, err
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetFeeDenom implements the gRPC MsgServer interface. It sets the alternative fee denomination the
+// sender pays the fees of the Ethereum txs it sends or sponsors in, which must be registered in the
+// parameters. An empty denom opts out.
+func (k *Keeper) SetFeeDenom(goCtx context.Context, req *types.MsgSetFeeDenom) (*types.MsgSetFeeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if req.Denom != "" {
+		if _, ok := k.GetParams(ctx).GetFeeDenom(req.Denom); !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "%s is not a registered fee denom", req.Denom)
+		}
+	}
+
+	k.SetAccountFeeDenom(ctx, sender, req.Denom)
+	return &types.MsgSetFeeDenomResponse{}, nil
+}
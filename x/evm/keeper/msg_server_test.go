@@ -95,6 +95,30 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "pass - EVM chain id matching the chain identifier",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EVMChainID = 567000
+					return params
+				}(),
+			},
+			expectErr: false,
+		},
+		{
+			name: "fail - EVM chain id not matching the chain identifier",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EVMChainID = 9000
+					return params
+				}(),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
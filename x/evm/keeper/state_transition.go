@@ -16,7 +16,10 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
 	"math/big"
+	"sort"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
@@ -69,7 +72,78 @@ func (k *Keeper) NewEVM(
 		cfg.Tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, cfg)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	hooks := types.NewPermissionHooks(k.accessControl(ctx, cfg.Params, msg.From()), msg.From())
+	if k.transferChecker != nil {
+		guard := types.NewTransferGuard(ctx, k.transferChecker, cfg.Params.EvmDenom, hooks)
+		hooks, blockCtx.CanTransfer = guard, guard.CanTransfer
+	}
+	evm := vm.NewEVMWithHooks(hooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	if len(k.customContractFns) > 0 || k.dynamicContractsFn != nil {
+		evm.WithPrecompiles(k.precompiles(ctx, cfg.Rules))
+	}
+	return evm
+}
+
+// precompiles returns the default precompiled contracts of the chain rules extended with the
+// custom and dynamic ones, the active addresses are sorted to be deterministic. The rules are the
+// ones of the EVM config, with the time based Shanghai activation.
+func (k *Keeper) precompiles(
+	ctx sdk.Context,
+	rules params.Rules,
+) (map[common.Address]vm.PrecompiledContract, []common.Address) {
+	defaults := vm.DefaultPrecompiles(rules)
+
+	contracts := make(map[common.Address]vm.PrecompiledContract, len(defaults)+len(k.customContractFns))
+	active := make([]common.Address, 0, len(defaults)+len(k.customContractFns))
+	for addr, c := range defaults {
+		contracts[addr] = c
+		active = append(active, addr)
+	}
+	custom := make([]vm.PrecompiledContract, 0, len(k.customContractFns))
+	for _, fn := range k.customContractFns {
+		custom = append(custom, fn(ctx, rules))
+	}
+	if k.dynamicContractsFn != nil {
+		custom = append(custom, k.dynamicContractsFn(ctx, rules)...)
+	}
+	for _, c := range custom {
+		addr := c.Address()
+		if _, ok := contracts[addr]; ok {
+			panic(fmt.Sprintf("duplicated precompiled contract address %s", addr))
+		}
+		contracts[addr] = c
+		active = append(active, addr)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return bytes.Compare(active[i].Bytes(), active[j].Bytes()) < 0
+	})
+	return contracts, active
+}
+
+// checkValueTransfer checks the value transfer of the message with the transfer checker if it's set.
+func (k *Keeper) checkValueTransfer(ctx sdk.Context, msg core.Message, denom string) error {
+	if k.transferChecker == nil || msg.Value().Sign() == 0 {
+		return nil
+	}
+	recipient := msg.To()
+	if recipient == nil {
+		created := crypto.CreateAddress(msg.From(), msg.Nonce())
+		recipient = &created
+	}
+	coins := sdk.Coins{sdk.NewCoin(denom, math.NewIntFromBigInt(msg.Value()))}
+	return k.transferChecker.CheckEVMTransfer(ctx, msg.From().Bytes(), recipient.Bytes(), coins)
+}
+
+// accessControl returns the permission policies restricting the sender of a message. The module
+// accounts calling the EVM on behalf of the chain, e.g. the erc20 module deploying and minting the
+// token pairs, aren't restricted by the policies which only apply to the users.
+func (k *Keeper) accessControl(ctx sdk.Context, params types.Params, sender common.Address) types.AccessControl {
+	if acc := k.accountKeeper.GetAccount(ctx, sender.Bytes()); acc != nil {
+		if _, ok := acc.(sdk.ModuleAccountI); ok {
+			return types.AccessControl{}
+		}
+	}
+	return params.AccessControl
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -145,6 +219,11 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 // returning.
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
+//
+// # Hooks
+//
+// The pre processing hooks are called before the execution and can reject the transaction, which then only consumes
+// its intrinsic gas. The post processing hooks are called after a successful execution and revert it on failure.
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, k.eip155ChainID, tx.Hash())
 	if err != nil {
@@ -170,8 +249,22 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	var res *types.MsgEthereumTxResponse
+	preTxConfig := &types.PreTxConfig{
+		Params:      cfg.Params,
+		ChainConfig: cfg.ChainConfig,
+		CoinBase:    cfg.CoinBase,
+		BaseFee:     cfg.BaseFee,
+		TxHash:      txConfig.TxHash,
+	}
+	if err = k.PreTxProcessing(tmpCtx, msg, preTxConfig); err != nil {
+		// If hooks return error, reject the tx without executing it.
+		k.Logger(ctx).Error("tx pre processing failed", "error", err)
+		res, err = k.rejectMessage(ctx, msg, cfg, txConfig, err)
+	} else {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
@@ -247,6 +340,31 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	return res, nil
 }
 
+// rejectMessage returns the failed result of a message rejected by the pre processing hooks, it's not executed
+// but charged the intrinsic gas or the minimum gas of the gas limit like the failed executions.
+func (k *Keeper) rejectMessage(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *EVMConfig,
+	txConfig statedb.TxConfig,
+	reason error,
+) (*types.MsgEthereumTxResponse, error) {
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, msg.To() == nil, cfg.Rules.IsShanghai)
+	if err != nil {
+		// should have already been checked on Ante Handler
+		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+	}
+	if msg.Gas() < intrinsicGas {
+		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "reject message")
+	}
+
+	return &types.MsgEthereumTxResponse{
+		GasUsed: minimumGasUsed(msg.Gas(), intrinsicGas, k.GetMinGasMultiplier(ctx)),
+		VmError: fmt.Sprintf("%s: %s", types.ErrPreTxProcessing, reason),
+		Hash:    txConfig.TxHash.Hex(),
+	}, nil
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, k.eip155ChainID, common.Hash{})
@@ -315,6 +433,19 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// return error if the permission policies don't allow the sender to create or call contracts,
+	// the contracts created and called during the execution are checked by the EVM opcode hooks
+	accessControl := k.accessControl(ctx, cfg.Params, msg.From())
+	if err := accessControl.CheckPermission(msg.From(), msg.To() == nil); err != nil {
+		return nil, err
+	}
+
+	// return error if the value transfer of the message is denied, the ones of the calls and
+	// creations during the execution are checked by the CanTransfer function of the EVM
+	if err := k.checkValueTransfer(ctx, msg, cfg.Params.EvmDenom); err != nil {
+		return nil, err
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, stateDB)
 
@@ -333,7 +464,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
-	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation, cfg.Rules.IsShanghai)
 	if err != nil {
 		// should have already been checked on Ante Handler
 		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
@@ -348,9 +479,8 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), []common.Address{}, msg.AccessList())
-	}
+	// the coinbase is warm after Shanghai (EIP-3651)
+	stateDB.Prepare(cfg.Rules, msg.From(), cfg.CoinBase, msg.To(), []common.Address{}, msg.AccessList())
 
 	if contractCreation {
 		// take over the nonce management from evm:
@@ -393,20 +523,16 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+	} else if err := stateDB.Error(); err != nil {
+		// fail the queries, like eth_call and eth_estimateGas, as the commit would do
+		return nil, errorsmod.Wrap(err, "failed to execute stateDB")
 	}
 
-	// calculate a minimum amount of gas to be charged to sender if GasLimit
-	// is considerably higher than GasUsed to stay more aligned with Tendermint gas mechanics
-	// for more info https://github.com/evmos/ethermint/issues/1085
-	gasLimit := math.LegacyNewDec(int64(msg.Gas()))
-	minGasMultiplier := k.GetMinGasMultiplier(ctx)
-	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
-
 	if msg.Gas() < leftoverGas {
 		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
 	}
 
-	gasUsed := math.LegacyMaxDec(minimumGasUsed, math.LegacyNewDec(int64(temporaryGasUsed))).TruncateInt().Uint64()
+	gasUsed := minimumGasUsed(msg.Gas(), temporaryGasUsed, k.GetMinGasMultiplier(ctx))
 	// reset leftoverGas, to be used by the tracer
 	leftoverGas = msg.Gas() - gasUsed
 
@@ -418,3 +544,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		Hash:    txConfig.TxHash.Hex(),
 	}, nil
 }
+
+// minimumGasUsed returns the gas used, raised to the minimum amount of gas charged to the sender if the gas limit
+// is considerably higher than the gas used, to stay more aligned with Tendermint gas mechanics.
+// for more info https://github.com/evmos/ethermint/issues/1085
+func minimumGasUsed(gasLimit, gasUsed uint64, minGasMultiplier math.LegacyDec) uint64 {
+	minimum := math.LegacyNewDec(int64(gasLimit)).Mul(minGasMultiplier)
+	return math.LegacyMaxDec(minimum, math.LegacyNewDec(int64(gasUsed))).TruncateInt().Uint64()
+}
@@ -17,6 +17,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	utiltx "github.com/loka-network/loka/v1/testutil/tx"
+	compliancetypes "github.com/loka-network/loka/v1/x/compliance/types"
 	"github.com/loka-network/loka/v1/x/evm/keeper"
 	"github.com/loka-network/loka/v1/x/evm/statedb"
 	"github.com/loka-network/loka/v1/x/evm/types"
@@ -277,7 +278,7 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			)
 			suite.Require().NoError(err)
 
-			gas, err := suite.app.EvmKeeper.GetEthIntrinsicGas(suite.ctx, *m, ethCfg, tc.isContractCreation)
+			gas, err := suite.app.EvmKeeper.GetEthIntrinsicGas(suite.ctx, *m, ethCfg, tc.isContractCreation, false)
 			if tc.noError {
 				suite.Require().NoError(err)
 			} else {
@@ -666,6 +667,88 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestApplyMessagePermissions() {
+	listed := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	moduleAddr := common.BytesToAddress(suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName).GetAddress())
+
+	allowlist := types.AccessPolicy{AccessType: types.AccessTypeAllowlist, Addresses: []string{listed.Hex()}}
+	denylist := types.AccessPolicy{AccessType: types.AccessTypeDenylist, Addresses: []string{listed.Hex()}}
+
+	testCases := []struct {
+		name          string
+		accessControl types.AccessControl
+		from          common.Address
+		to            *common.Address
+		expErr        error
+	}{
+		{"create allowlist, listed", types.AccessControl{Create: allowlist}, listed, nil, nil},
+		{"create allowlist, not listed", types.AccessControl{Create: allowlist}, other, nil, types.ErrCreateNotPermitted},
+		{"create allowlist, module account", types.AccessControl{Create: allowlist}, moduleAddr, nil, nil},
+		{"create denylist, listed", types.AccessControl{Create: denylist}, listed, nil, types.ErrCreateNotPermitted},
+		{"create denylist, not listed", types.AccessControl{Create: denylist}, other, nil, nil},
+		{"call allowlist, listed", types.AccessControl{Call: allowlist}, listed, &contract, nil},
+		{"call allowlist, not listed", types.AccessControl{Call: allowlist}, other, &contract, types.ErrCallNotPermitted},
+		{"call allowlist, module account", types.AccessControl{Call: allowlist}, moduleAddr, &contract, nil},
+		{"call denylist, listed", types.AccessControl{Call: denylist}, listed, &contract, types.ErrCallNotPermitted},
+		{"call denylist, not listed", types.AccessControl{Call: denylist}, other, &contract, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			evmParams.AccessControl = tc.accessControl
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+			msg := ethtypes.NewMessage(
+				tc.from,
+				tc.to,
+				suite.StateDB().GetNonce(tc.from),
+				big.NewInt(0),
+				params.TxGasContractCreation,
+				big.NewInt(0),
+				big.NewInt(0),
+				big.NewInt(0),
+				nil,
+				ethtypes.AccessList{},
+				false,
+			)
+			res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, types.NewNoOpTracer(), true)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageValueTransferCompliance() {
+	recipient := utiltx.GenerateAddress()
+	suite.app.ComplianceKeeper.SetAddressFrozen(suite.ctx, recipient.Bytes(), true)
+
+	for _, commit := range []bool{true, false} {
+		msg := ethtypes.NewMessage(
+			suite.address,
+			&recipient,
+			suite.StateDB().GetNonce(suite.address),
+			big.NewInt(1),
+			params.TxGas,
+			big.NewInt(0),
+			big.NewInt(0),
+			big.NewInt(0),
+			nil,
+			ethtypes.AccessList{},
+			!commit,
+		)
+		_, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, types.NewNoOpTracer(), commit)
+		suite.Require().ErrorIs(err, compliancetypes.ErrFrozenAddress)
+	}
+	suite.Require().Zero(suite.StateDB().GetBalance(recipient).Sign())
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, gasPrice)
 	if err != nil {
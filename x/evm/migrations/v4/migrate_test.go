@@ -85,5 +85,8 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, legacySubspace.ps.EnableCreate, params.EnableCreate)
 	require.Equal(t, legacySubspace.ps.AllowUnprotectedTxs, params.AllowUnprotectedTxs)
 	require.Equal(t, legacySubspace.ps.ExtraEIPs, params.ExtraEIPs.EIPs)
-	require.EqualValues(t, legacySubspace.ps.ChainConfig, params.V4ChainConfig)
+	// the v4 chain config is wire compatible with the current one, which has the fork times on top
+	var chainConfig types.ChainConfig
+	cdc.MustUnmarshal(cdc.MustMarshal(&params.V4ChainConfig), &chainConfig)
+	require.Equal(t, legacySubspace.ps.ChainConfig, chainConfig)
 }
@@ -0,0 +1,42 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it sets the evm_chain_id param to the EIP155 chain id
+// of the chain identifier, so the chain can't be restarted with an identifier of
+// another EVM chain id.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	evmChainID, err := types.ParseEVMChainID(ctx.ChainID())
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.EVMChainID = evmChainID
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
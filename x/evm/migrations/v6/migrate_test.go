@@ -0,0 +1,45 @@
+package v6_test
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/encoding"
+	v6 "github.com/loka-network/loka/v1/x/evm/migrations/v6"
+	"github.com/loka-network/loka/v1/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithChainID("lokadev_9000-1")
+	kvStore := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+	err := v6.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	var migrated types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &migrated)
+
+	// the EVM chain id is pinned to the chain identifier
+	require.Equal(t, uint64(9000), migrated.EVMChainID)
+	require.NoError(t, types.ValidateEVMChainID(big.NewInt(9000), migrated.EVMChainID))
+	require.Error(t, types.ValidateEVMChainID(big.NewInt(567000), migrated.EVMChainID))
+
+	params.EVMChainID = migrated.EVMChainID
+	require.Equal(t, params, migrated)
+
+	// an invalid chain identifier fails the migration
+	err = v6.MigrateStore(ctx.WithChainID("loka"), storeKey, cdc)
+	require.Error(t, err)
+}
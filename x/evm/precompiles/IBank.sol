@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The bank precompiled contract is deployed at this address.
+address constant BANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000064;
+
+/// @title IBank
+/// @notice Exposes the bank module balances of any denom to Solidity contracts.
+/// A contract owns the denom `evm/<checksummed contract address>`, it's the only one which can
+/// mint and burn it.
+interface IBank {
+    /// @dev Emitted by transfer, mint and burn, the zero address stands for minting and burning.
+    event Transfer(address indexed from, address indexed to, string denom, uint256 value);
+
+    function balanceOf(address account, string calldata denom) external view returns (uint256);
+
+    function totalSupply(string calldata denom) external view returns (uint256);
+
+    /// @notice Transfers amount of denom from the caller to the recipient.
+    function transfer(address to, string calldata denom, uint256 amount) external returns (bool);
+
+    /// @notice Mints amount of the caller owned denom to the recipient.
+    function mint(address to, string calldata denom, uint256 amount) external returns (bool);
+
+    /// @notice Burns amount of the caller owned denom from the account, which must be the caller.
+    function burn(address from, string calldata denom, uint256 amount) external returns (bool);
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The ICS-20 transfer precompiled contract is deployed at this address.
+address constant ICS20_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000065;
+
+/// @title IICS20
+/// @notice Sends tokens over IBC on behalf of the caller.
+interface IICS20 {
+    /// @dev Emitted for every transfer, the receiver is an address on the counterparty chain.
+    event IBCTransfer(address indexed sender, string receiver, string denom, uint256 amount, string memo);
+
+    /// @dev Emitted for every packet sent by a transfer.
+    event SendPacket(
+        uint64 indexed sequence,
+        string sourcePort,
+        string sourceChannel,
+        string destinationPort,
+        string destinationChannel
+    );
+
+    /// @notice Transfers amount of denom to the receiver on the counterparty chain of the channel.
+    /// @param denom A bank denom, or the address of a registered ERC20 token, optionally prefixed
+    /// with `erc20/`. The missing coin balance of a registered token pair is converted from the
+    /// caller's ERC20 balance.
+    /// @return sequence The sequence of the sent packet.
+    function transfer(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        uint64 timeoutRevisionNumber,
+        uint64 timeoutRevisionHeight,
+        uint64 timeoutTimestamp,
+        string calldata memo
+    ) external returns (uint64 sequence);
+}
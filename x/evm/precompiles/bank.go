@@ -0,0 +1,272 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/loka-network/loka/v1/x/evm/statedb"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+const (
+	BalanceOfMethodName   = "balanceOf"
+	TotalSupplyMethodName = "totalSupply"
+	TransferMethodName    = "transfer"
+	MintMethodName        = "mint"
+	BurnMethodName        = "burn"
+
+	TransferEventName = "Transfer"
+
+	// EventTypeTransfer is the native event emitted by the bank precompile state changes,
+	// it's converted to the Transfer log.
+	EventTypeTransfer = "bank_precompile_transfer"
+
+	AttributeKeyFrom   = "from"
+	AttributeKeyTo     = "to"
+	AttributeKeyDenom  = "denom"
+	AttributeKeyAmount = "amount"
+
+	// EVMDenomPrefix is the prefix of the denoms owned by a contract
+	EVMDenomPrefix = "evm/"
+)
+
+// bankABIJSON is the ABI of the IBank interface, see IBank.sol
+const bankABIJSON = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[{"name":"denom","type":"string"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"burn","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"denom","type":"string","indexed":false},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+var (
+	// BankContractAddress is the address of the bank precompiled contract
+	BankContractAddress = common.BytesToAddress([]byte{100})
+
+	// BankABI is the parsed ABI of the bank precompiled contract
+	BankABI abi.ABI
+
+	// bankGasCosts are the fixed gas costs of the bank precompiled contract methods
+	bankGasCosts = map[string]uint64{
+		BalanceOfMethodName:   3000,
+		TotalSupplyMethodName: 3000,
+		TransferMethodName:    30000,
+		MintMethodName:        40000,
+		BurnMethodName:        40000,
+	}
+)
+
+func init() {
+	var err error
+	BankABI, err = abi.JSON(strings.NewReader(bankABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EVMDenom returns the bank denom owned by the contract, only the contract can mint or burn it.
+func EVMDenom(contract common.Address) string {
+	return EVMDenomPrefix + contract.Hex()
+}
+
+// ExtStateDB is the statedb the stateful precompiled contracts run on.
+type ExtStateDB interface {
+	vm.StateDB
+	ExecuteNativeAction(contract common.Address, converter statedb.EventConverter, action func(ctx sdk.Context) error) error
+	Context() sdk.Context
+}
+
+var _ vm.PrecompiledContract = &BankContract{}
+
+// BankContract is a stateful precompiled contract which exposes the bank module balances to
+// Solidity contracts, the state changes go through the statedb journal so they're reverted
+// with the calling frame.
+type BankContract struct {
+	bankKeeper evmtypes.BankKeeper
+}
+
+// NewBankContract creates the bank precompiled contract.
+func NewBankContract(bankKeeper evmtypes.BankKeeper) vm.PrecompiledContract {
+	return &BankContract{bankKeeper: bankKeeper}
+}
+
+// Address implements vm.PrecompiledContract
+func (bc *BankContract) Address() common.Address {
+	return BankContractAddress
+}
+
+// RequiredGas implements vm.PrecompiledContract
+func (bc *BankContract) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := BankABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	return bankGasCosts[method.Name]
+}
+
+// Run implements vm.PrecompiledContract
+func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+	if hasValue(contract) {
+		return nil, errors.New("the bank precompiled contract isn't payable")
+	}
+
+	stateDB, ok := evm.StateDB.(ExtStateDB)
+	if !ok {
+		return nil, fmt.Errorf("statedb %T doesn't support native actions", evm.StateDB)
+	}
+
+	method, err := BankABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case BalanceOfMethodName:
+		account := args[0].(common.Address)
+		denom := args[1].(string)
+		balance := bc.bankKeeper.GetBalance(stateDB.Context(), sdk.AccAddress(account.Bytes()), denom)
+		return method.Outputs.Pack(balance.Amount.BigInt())
+	case TotalSupplyMethodName:
+		denom := args[0].(string)
+		supply := bc.bankKeeper.GetSupply(stateDB.Context(), denom)
+		return method.Outputs.Pack(supply.Amount.BigInt())
+	}
+
+	if readonly {
+		return nil, vm.ErrWriteProtection
+	}
+
+	account := args[0].(common.Address)
+	denom := args[1].(string)
+	amount := args[2].(*big.Int)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+	caller := contract.CallerAddress
+
+	switch method.Name {
+	case TransferMethodName:
+		err = bc.transfer(stateDB, caller, account, denom, amount, func(ctx sdk.Context) error {
+			if bc.bankKeeper.BlockedAddr(account.Bytes()) {
+				return fmt.Errorf("%s is not allowed to receive funds", account)
+			}
+			return bc.bankKeeper.SendCoins(ctx, caller.Bytes(), account.Bytes(), coins)
+		})
+	case MintMethodName:
+		if denom != EVMDenom(caller) {
+			return nil, fmt.Errorf("%s is not the owner of denom %s", caller, denom)
+		}
+		err = bc.transfer(stateDB, common.Address{}, account, denom, amount, func(ctx sdk.Context) error {
+			if bc.bankKeeper.BlockedAddr(account.Bytes()) {
+				return fmt.Errorf("%s is not allowed to receive funds", account)
+			}
+			if err := bc.bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+				return err
+			}
+			return bc.bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, account.Bytes(), coins)
+		})
+	case BurnMethodName:
+		if denom != EVMDenom(caller) {
+			return nil, fmt.Errorf("%s is not the owner of denom %s", caller, denom)
+		}
+		// the owner can only burn its own balance, the holders transfer the coins to it first
+		if account != caller {
+			return nil, fmt.Errorf("%s can't burn the balance of %s", caller, account)
+		}
+		err = bc.transfer(stateDB, account, common.Address{}, denom, amount, func(ctx sdk.Context) error {
+			if err := bc.bankKeeper.SendCoinsFromAccountToModule(ctx, account.Bytes(), evmtypes.ModuleName, coins); err != nil {
+				return err
+			}
+			return bc.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, coins)
+		})
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// hasValue returns whether the call transfers value, the value is nil under DELEGATECALL.
+func hasValue(contract *vm.Contract) bool {
+	value := contract.Value()
+	return value != nil && value.Sign() != 0
+}
+
+// transfer runs the balance change as a native action and emits the Transfer log, the zero
+// address stands for minting and burning as in ERC20.
+func (bc *BankContract) transfer(
+	stateDB ExtStateDB,
+	from, to common.Address,
+	denom string,
+	amount *big.Int,
+	action func(ctx sdk.Context) error,
+) error {
+	return stateDB.ExecuteNativeAction(BankContractAddress, ConvertTransferEvent, func(ctx sdk.Context) error {
+		if err := action(ctx); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeTransfer,
+			sdk.NewAttribute(AttributeKeyFrom, from.Hex()),
+			sdk.NewAttribute(AttributeKeyTo, to.Hex()),
+			sdk.NewAttribute(AttributeKeyDenom, denom),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		))
+		return nil
+	})
+}
+
+// ConvertTransferEvent converts the native transfer events of the bank precompiled contract to
+// Transfer logs, the other native events are skipped.
+func ConvertTransferEvent(event sdk.Event) (*ethtypes.Log, error) {
+	if event.Type != EventTypeTransfer {
+		return nil, nil
+	}
+
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	amount, ok := new(big.Int).SetString(attrs[AttributeKeyAmount], 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid transfer amount %s", attrs[AttributeKeyAmount])
+	}
+
+	transferEvent := BankABI.Events[TransferEventName]
+	data, err := transferEvent.Inputs.NonIndexed().Pack(attrs[AttributeKeyDenom], amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethtypes.Log{
+		Topics: []common.Hash{
+			transferEvent.ID,
+			common.BytesToHash(common.HexToAddress(attrs[AttributeKeyFrom]).Bytes()),
+			common.BytesToHash(common.HexToAddress(attrs[AttributeKeyTo]).Bytes()),
+		},
+		Data: data,
+	}, nil
+}
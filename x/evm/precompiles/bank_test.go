@@ -0,0 +1,317 @@
+package precompiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/x/evm/statedb"
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+// mockBankKeeper keeps the balances in memory, the module accounts are keyed by name.
+type mockBankKeeper struct {
+	evmtypes.BankKeeper
+
+	balances map[string]sdkmath.Int
+	supply   map[string]sdkmath.Int
+	blocked  common.Address
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		balances: make(map[string]sdkmath.Int),
+		supply:   make(map[string]sdkmath.Int),
+		blocked:  common.HexToAddress("0xdead"),
+	}
+}
+
+func (k *mockBankKeeper) balance(owner, denom string) sdkmath.Int {
+	if amt, ok := k.balances[owner+denom]; ok {
+		return amt
+	}
+	return sdkmath.ZeroInt()
+}
+
+func (k *mockBankKeeper) move(from, to string, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if k.balance(from, coin.Denom).LT(coin.Amount) {
+			return errors.New("insufficient funds")
+		}
+	}
+	for _, coin := range coins {
+		k.balances[from+coin.Denom] = k.balance(from, coin.Denom).Sub(coin.Amount)
+		k.balances[to+coin.Denom] = k.balance(to, coin.Denom).Add(coin.Amount)
+	}
+	return nil
+}
+
+func (k *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, k.balance(addr.String(), denom))
+}
+
+func (k *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	if amt, ok := k.supply[denom]; ok {
+		return sdk.NewCoin(denom, amt)
+	}
+	return sdk.NewCoin(denom, sdkmath.ZeroInt())
+}
+
+func (k *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return common.BytesToAddress(addr) == k.blocked
+}
+
+func (k *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	return k.move(from.String(), to.String(), coins)
+}
+
+func (k *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, to sdk.AccAddress, coins sdk.Coins) error {
+	return k.move(module, to.String(), coins)
+}
+
+func (k *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, module string, coins sdk.Coins) error {
+	return k.move(from.String(), module, coins)
+}
+
+func (k *mockBankKeeper) MintCoins(_ context.Context, module string, coins sdk.Coins) error {
+	for _, coin := range coins {
+		k.balances[module+coin.Denom] = k.balance(module, coin.Denom).Add(coin.Amount)
+		k.supply[coin.Denom] = k.GetSupply(nil, coin.Denom).Amount.Add(coin.Amount)
+	}
+	return nil
+}
+
+func (k *mockBankKeeper) BurnCoins(_ context.Context, module string, coins sdk.Coins) error {
+	if err := k.move(module, "burnt", coins); err != nil {
+		return err
+	}
+	for _, coin := range coins {
+		k.supply[coin.Denom] = k.GetSupply(nil, coin.Denom).Amount.Sub(coin.Amount)
+	}
+	return nil
+}
+
+// mockStateDB runs the native actions directly and records the converted logs.
+type mockStateDB struct {
+	vm.StateDB
+
+	logs    []*ethtypes.Log
+	storage map[common.Address]map[common.Hash]common.Hash
+}
+
+func (s *mockStateDB) ExecuteNativeAction(contract common.Address, converter statedb.EventConverter, action func(ctx sdk.Context) error) error {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	if err := action(ctx); err != nil {
+		return err
+	}
+	for _, event := range ctx.EventManager().Events() {
+		log, err := converter(event)
+		if err != nil {
+			return err
+		}
+		if log != nil {
+			log.Address = contract
+			s.logs = append(s.logs, log)
+		}
+	}
+	return nil
+}
+
+func (s *mockStateDB) Context() sdk.Context {
+	return sdk.Context{}
+}
+
+func (s *mockStateDB) GetState(addr common.Address, key common.Hash) common.Hash {
+	return s.storage[addr][key]
+}
+
+func (s *mockStateDB) SetState(addr common.Address, key, value common.Hash) {
+	if s.storage == nil {
+		s.storage = make(map[common.Address]map[common.Hash]common.Hash)
+	}
+	if s.storage[addr] == nil {
+		s.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.storage[addr][key] = value
+}
+
+func (s *mockStateDB) Snapshot() int {
+	return 0
+}
+
+func (s *mockStateDB) RevertToSnapshot(int) {}
+
+func (s *mockStateDB) AddBalance(common.Address, *big.Int) {}
+
+// callPrecompile calls the precompiled contract through the EVM with the call opcode, the EVM
+// passes a nil value under DELEGATECALL and runs it as read-only like STATICCALL.
+func callPrecompile(stateDB vm.StateDB, p vm.PrecompiledContract, op vm.OpCode, caller common.Address, input []byte) ([]byte, error) {
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+	evm.WithPrecompiles(map[common.Address]vm.PrecompiledContract{p.Address(): p}, []common.Address{p.Address()})
+
+	var (
+		ret []byte
+		err error
+	)
+	switch op {
+	case vm.DELEGATECALL:
+		ret, _, err = evm.DelegateCall(vm.AccountRef(caller), p.Address(), input, 1000000)
+	case vm.STATICCALL:
+		ret, _, err = evm.StaticCall(vm.AccountRef(caller), p.Address(), input, 1000000)
+	default:
+		return nil, fmt.Errorf("unsupported call opcode %s", op)
+	}
+	return ret, err
+}
+
+func TestBankContract(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	user := common.HexToAddress("0x2000")
+	denom := EVMDenom(owner)
+
+	testCases := []struct {
+		name     string
+		caller   common.Address
+		method   string
+		args     []interface{}
+		readonly bool
+		expErr   bool
+		expLogs  int
+		expRet   interface{}
+	}{
+		{"balance of", user, BalanceOfMethodName, []interface{}{user, "aloka"}, true, false, 0, big.NewInt(100)},
+		{"total supply", user, TotalSupplyMethodName, []interface{}{denom}, true, false, 0, big.NewInt(0)},
+		{"transfer", user, TransferMethodName, []interface{}{owner, "aloka", big.NewInt(60)}, false, false, 1, true},
+		{"transfer insufficient funds", user, TransferMethodName, []interface{}{owner, "aloka", big.NewInt(101)}, false, true, 0, nil},
+		{"transfer to blocked address", user, TransferMethodName, []interface{}{common.HexToAddress("0xdead"), "aloka", big.NewInt(1)}, false, true, 0, nil},
+		{"transfer in static call", user, TransferMethodName, []interface{}{owner, "aloka", big.NewInt(1)}, true, true, 0, nil},
+		{"transfer invalid denom", user, TransferMethodName, []interface{}{owner, "1", big.NewInt(1)}, false, true, 0, nil},
+		{"mint by owner", owner, MintMethodName, []interface{}{user, denom, big.NewInt(10)}, false, false, 1, true},
+		{"mint by another contract", user, MintMethodName, []interface{}{user, denom, big.NewInt(10)}, false, true, 0, nil},
+		{"mint registered denom", owner, MintMethodName, []interface{}{user, "aloka", big.NewInt(10)}, false, true, 0, nil},
+		{"burn by owner", owner, BurnMethodName, []interface{}{owner, denom, big.NewInt(0)}, false, false, 1, true},
+		{"burn from holder", owner, BurnMethodName, []interface{}{user, denom, big.NewInt(0)}, false, true, 0, nil},
+		{"burn by another contract", user, BurnMethodName, []interface{}{user, denom, big.NewInt(1)}, false, true, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bankKeeper := newMockBankKeeper()
+			bankKeeper.balances[sdk.AccAddress(user.Bytes()).String()+"aloka"] = sdkmath.NewInt(100)
+			stateDB := &mockStateDB{}
+			bc := NewBankContract(bankKeeper)
+
+			input, err := BankABI.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+			require.NotZero(t, bc.RequiredGas(input))
+
+			contract := vm.NewContract(vm.AccountRef(tc.caller), vm.AccountRef(BankContractAddress), big.NewInt(0), 100000)
+			contract.Input = input
+
+			ret, err := bc.Run(&vm.EVM{StateDB: stateDB}, contract, tc.readonly)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			out, err := BankABI.Methods[tc.method].Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprint(tc.expRet), fmt.Sprint(out[0]))
+
+			require.Len(t, stateDB.logs, tc.expLogs)
+			for _, log := range stateDB.logs {
+				require.Equal(t, BankContractAddress, log.Address)
+				require.Equal(t, BankABI.Events[TransferEventName].ID, log.Topics[0])
+			}
+		})
+	}
+}
+
+func TestBankContractMintBurnSupply(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	user := common.HexToAddress("0x2000")
+	denom := EVMDenom(owner)
+
+	bankKeeper := newMockBankKeeper()
+	stateDB := &mockStateDB{}
+	bc := NewBankContract(bankKeeper)
+	evm := &vm.EVM{StateDB: stateDB}
+
+	call := func(method string, args ...interface{}) []interface{} {
+		input, err := BankABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(vm.AccountRef(owner), vm.AccountRef(BankContractAddress), big.NewInt(0), 100000)
+		contract.Input = input
+		ret, err := bc.Run(evm, contract, false)
+		require.NoError(t, err)
+		out, err := BankABI.Methods[method].Outputs.Unpack(ret)
+		require.NoError(t, err)
+		return out
+	}
+
+	call(MintMethodName, user, denom, big.NewInt(50))
+	call(MintMethodName, owner, denom, big.NewInt(30))
+	call(BurnMethodName, owner, denom, big.NewInt(20))
+	require.Equal(t, big.NewInt(60), call(TotalSupplyMethodName, denom)[0])
+	require.Equal(t, big.NewInt(50), call(BalanceOfMethodName, user, denom)[0])
+	require.Equal(t, big.NewInt(10), call(BalanceOfMethodName, owner, denom)[0])
+
+	require.Len(t, stateDB.logs, 3)
+	mintLog, burnLog := stateDB.logs[0], stateDB.logs[2]
+	require.Equal(t, common.Hash{}, mintLog.Topics[1])
+	require.Equal(t, common.BytesToHash(user.Bytes()), mintLog.Topics[2])
+	require.Equal(t, common.BytesToHash(owner.Bytes()), burnLog.Topics[1])
+	require.Equal(t, common.Hash{}, burnLog.Topics[2])
+
+	data, err := BankABI.Events[TransferEventName].Inputs.NonIndexed().Unpack(burnLog.Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{denom, big.NewInt(20)}, data)
+}
+
+func TestBankContractDelegateAndStaticCall(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	user := common.HexToAddress("0x2000")
+	denom := EVMDenom(owner)
+
+	for _, op := range []vm.OpCode{vm.DELEGATECALL, vm.STATICCALL} {
+		t.Run(op.String(), func(t *testing.T) {
+			bankKeeper := newMockBankKeeper()
+			bankKeeper.balances[sdk.AccAddress(user.Bytes()).String()+"aloka"] = sdkmath.NewInt(100)
+			stateDB := &mockStateDB{}
+			bc := NewBankContract(bankKeeper)
+
+			input, err := BankABI.Pack(BalanceOfMethodName, user, "aloka")
+			require.NoError(t, err)
+			ret, err := callPrecompile(stateDB, bc, op, user, input)
+			require.NoError(t, err)
+			out, err := BankABI.Methods[BalanceOfMethodName].Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(100), out[0])
+
+			// the state changes are rejected
+			input, err = BankABI.Pack(TransferMethodName, owner, "aloka", big.NewInt(1))
+			require.NoError(t, err)
+			_, err = callPrecompile(stateDB, bc, op, user, input)
+			require.ErrorIs(t, err, vm.ErrWriteProtection)
+
+			input, err = BankABI.Pack(MintMethodName, user, denom, big.NewInt(1))
+			require.NoError(t, err)
+			_, err = callPrecompile(stateDB, bc, op, owner, input)
+			require.ErrorIs(t, err, vm.ErrWriteProtection)
+
+			require.Empty(t, stateDB.logs)
+			require.Equal(t, sdkmath.NewInt(100), bankKeeper.balance(sdk.AccAddress(user.Bytes()).String(), "aloka"))
+		})
+	}
+}
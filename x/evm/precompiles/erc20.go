@@ -0,0 +1,416 @@
+package precompiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/loka-network/loka/v1/contracts"
+	erc20types "github.com/loka-network/loka/v1/x/erc20/types"
+)
+
+const (
+	NameMethodName              = "name"
+	SymbolMethodName            = "symbol"
+	DecimalsMethodName          = "decimals"
+	AllowanceMethodName         = "allowance"
+	ApproveMethodName           = "approve"
+	TransferFromMethodName      = "transferFrom"
+	IncreaseAllowanceMethodName = "increaseAllowance"
+	DecreaseAllowanceMethodName = "decreaseAllowance"
+
+	ApprovalEventName = "Approval"
+
+	// EventTypeERC20Transfer and EventTypeERC20Approval are the native events emitted by the
+	// native ERC20 precompile state changes, they're converted to the Transfer and Approval logs.
+	EventTypeERC20Transfer = "erc20_precompile_transfer"
+	EventTypeERC20Approval = "erc20_precompile_approval"
+
+	AttributeKeyOwner   = "owner"
+	AttributeKeySpender = "spender"
+)
+
+// erc20GasCosts are the fixed gas costs of the native ERC20 precompiled contract methods
+var erc20GasCosts = map[string]uint64{
+	NameMethodName:              3000,
+	SymbolMethodName:            3000,
+	DecimalsMethodName:          3000,
+	TotalSupplyMethodName:       3000,
+	BalanceOfMethodName:         3000,
+	AllowanceMethodName:         3000,
+	TransferMethodName:          30000,
+	TransferFromMethodName:      40000,
+	ApproveMethodName:           20000,
+	IncreaseAllowanceMethodName: 20000,
+	DecreaseAllowanceMethodName: 20000,
+}
+
+// The storage slots of the ERC20MinterBurnerDecimals state variables, the token pair contract
+// keeps the balances and allowances there until they're migrated by the native precompiled
+// contract.
+var (
+	legacyBalancesSlot    = common.BigToHash(big.NewInt(2))
+	legacyAllowancesSlot  = common.BigToHash(big.NewInt(3))
+	legacyTotalSupplySlot = common.BigToHash(big.NewInt(4))
+)
+
+// ERC20BankKeeper defines the bank keeper the native ERC20 balances are kept in.
+type ERC20BankKeeper interface {
+	BankKeeper
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NativeERC20Keeper defines the erc20 keeper which keeps the native precompiled token pairs and
+// their allowances.
+type NativeERC20Keeper interface {
+	GetNativePrecompiles(ctx sdk.Context) []string
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int
+	SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int)
+}
+
+// NativeERC20Contracts returns the generator of the native ERC20 precompiled contracts of the
+// token pairs listed in the erc20 native_precompiles param, to be set as the EVM keeper dynamic
+// precompiled contracts.
+func NativeERC20Contracts(
+	bankKeeper ERC20BankKeeper,
+	erc20Keeper NativeERC20Keeper,
+) func(sdk.Context, params.Rules) []vm.PrecompiledContract {
+	return func(ctx sdk.Context, _ params.Rules) []vm.PrecompiledContract {
+		addrs := erc20Keeper.GetNativePrecompiles(ctx)
+		contracts := make([]vm.PrecompiledContract, 0, len(addrs))
+		for _, addr := range addrs {
+			pair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetTokenPairID(ctx, addr))
+			if !found {
+				continue
+			}
+			contracts = append(contracts, NewNativeERC20Contract(pair, bankKeeper, erc20Keeper))
+		}
+		return contracts
+	}
+}
+
+var _ vm.PrecompiledContract = &NativeERC20Contract{}
+
+// NativeERC20Contract is a stateful precompiled contract serving the ERC20 interface of a token
+// pair at its ERC20 address, the balances are the bank balances of the pair denom so the token
+// doesn't need to be converted. The allowances are kept in the erc20 module store.
+//
+// The balances and allowances left in the token pair contract storage are added to the native
+// ones, and migrated before the state of the account is changed, so enabling the precompile
+// doesn't need to find the holders.
+type NativeERC20Contract struct {
+	pair        erc20types.TokenPair
+	bankKeeper  ERC20BankKeeper
+	erc20Keeper NativeERC20Keeper
+}
+
+// NewNativeERC20Contract creates the native ERC20 precompiled contract of the token pair.
+func NewNativeERC20Contract(
+	pair erc20types.TokenPair,
+	bankKeeper ERC20BankKeeper,
+	erc20Keeper NativeERC20Keeper,
+) vm.PrecompiledContract {
+	return &NativeERC20Contract{
+		pair:        pair,
+		bankKeeper:  bankKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// Address implements vm.PrecompiledContract
+func (ec *NativeERC20Contract) Address() common.Address {
+	return ec.pair.GetERC20Contract()
+}
+
+// RequiredGas implements vm.PrecompiledContract
+func (ec *NativeERC20Contract) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	return erc20GasCosts[method.Name]
+}
+
+// Run implements vm.PrecompiledContract
+func (ec *NativeERC20Contract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+	if hasValue(contract) {
+		return nil, errors.New("the native ERC20 precompiled contract isn't payable")
+	}
+
+	stateDB, ok := evm.StateDB.(ExtStateDB)
+	if !ok {
+		return nil, fmt.Errorf("statedb %T doesn't support native actions", evm.StateDB)
+	}
+
+	method, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := erc20GasCosts[method.Name]; !ok {
+		return nil, fmt.Errorf("method %s is not supported by the native ERC20 precompiled contract", method.Name)
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := stateDB.Context()
+	token := ec.Address()
+	switch method.Name {
+	case NameMethodName, SymbolMethodName, DecimalsMethodName:
+		return ec.metadata(ctx, method)
+	case TotalSupplyMethodName:
+		supply := ec.bankKeeper.GetSupply(ctx, ec.pair.Denom)
+		return method.Outputs.Pack(supply.Amount.BigInt())
+	case BalanceOfMethodName:
+		account := args[0].(common.Address)
+		balance := ec.bankKeeper.GetBalance(ctx, account.Bytes(), ec.pair.Denom).Amount.BigInt()
+		legacy := stateDB.GetState(token, legacyBalanceKey(account)).Big()
+		return method.Outputs.Pack(balance.Add(balance, legacy))
+	case AllowanceMethodName:
+		owner, spender := args[0].(common.Address), args[1].(common.Address)
+		allowance := new(big.Int).Set(ec.erc20Keeper.GetAllowance(ctx, token, owner, spender))
+		legacy := stateDB.GetState(token, legacyAllowanceKey(owner, spender)).Big()
+		return method.Outputs.Pack(allowance.Add(allowance, legacy))
+	}
+
+	if readonly {
+		return nil, vm.ErrWriteProtection
+	}
+
+	caller := contract.CallerAddress
+	// migrate the legacy state which is going to change
+	switch method.Name {
+	case TransferMethodName:
+		err = migrateLegacyBalance(stateDB, ec.bankKeeper, ec.pair, caller)
+	case TransferFromMethodName:
+		from := args[0].(common.Address)
+		if err = ec.migrateLegacyAllowance(stateDB, from, caller); err == nil {
+			err = migrateLegacyBalance(stateDB, ec.bankKeeper, ec.pair, from)
+		}
+	default:
+		err = ec.migrateLegacyAllowance(stateDB, caller, args[0].(common.Address))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case TransferMethodName:
+		err = ec.transfer(stateDB, caller, args[0].(common.Address), args[1].(*big.Int))
+	case TransferFromMethodName:
+		from, to, amount := args[0].(common.Address), args[1].(common.Address), args[2].(*big.Int)
+		err = ec.spendAllowance(stateDB, from, caller, amount)
+		if err == nil {
+			err = ec.transfer(stateDB, from, to, amount)
+		}
+	case ApproveMethodName:
+		err = ec.approve(stateDB, caller, args[0].(common.Address), args[1].(*big.Int))
+	case IncreaseAllowanceMethodName:
+		spender := args[0].(common.Address)
+		allowance := new(big.Int).Add(ec.erc20Keeper.GetAllowance(ctx, token, caller, spender), args[1].(*big.Int))
+		if allowance.Cmp(math.MaxBig256) > 0 {
+			return nil, errors.New("ERC20: allowance overflow")
+		}
+		err = ec.approve(stateDB, caller, spender, allowance)
+	case DecreaseAllowanceMethodName:
+		spender := args[0].(common.Address)
+		allowance := new(big.Int).Sub(ec.erc20Keeper.GetAllowance(ctx, token, caller, spender), args[1].(*big.Int))
+		if allowance.Sign() < 0 {
+			return nil, errors.New("ERC20: decreased allowance below zero")
+		}
+		err = ec.approve(stateDB, caller, spender, allowance)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// metadata returns the name, symbol or decimals of the token pair from the denom metadata, the
+// decimals are the exponent of the last denom unit as for the deployed ERC20 contracts.
+func (ec *NativeERC20Contract) metadata(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	metadata, found := ec.bankKeeper.GetDenomMetaData(ctx, ec.pair.Denom)
+	if !found {
+		return nil, fmt.Errorf("denom %s has no metadata", ec.pair.Denom)
+	}
+
+	switch method.Name {
+	case NameMethodName:
+		return method.Outputs.Pack(metadata.Name)
+	case SymbolMethodName:
+		return method.Outputs.Pack(metadata.Symbol)
+	default:
+		decimals := uint8(0)
+		if len(metadata.DenomUnits) > 0 {
+			decimals = uint8(metadata.DenomUnits[len(metadata.DenomUnits)-1].Exponent)
+		}
+		return method.Outputs.Pack(decimals)
+	}
+}
+
+// transfer moves the pair coins as a native action and emits the Transfer log.
+func (ec *NativeERC20Contract) transfer(stateDB ExtStateDB, from, to common.Address, amount *big.Int) error {
+	if to == (common.Address{}) {
+		return errors.New("ERC20: transfer to the zero address")
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(ec.pair.Denom, sdkmath.NewIntFromBigInt(amount)))
+
+	return stateDB.ExecuteNativeAction(ec.Address(), ConvertERC20Event, func(ctx sdk.Context) error {
+		if ec.bankKeeper.BlockedAddr(to.Bytes()) {
+			return fmt.Errorf("%s is not allowed to receive funds", to)
+		}
+		if err := ec.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeERC20Transfer,
+			sdk.NewAttribute(AttributeKeyFrom, from.Hex()),
+			sdk.NewAttribute(AttributeKeyTo, to.Hex()),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		))
+		return nil
+	})
+}
+
+// approve sets the allowance as a native action and emits the Approval log.
+func (ec *NativeERC20Contract) approve(stateDB ExtStateDB, owner, spender common.Address, amount *big.Int) error {
+	if spender == (common.Address{}) {
+		return errors.New("ERC20: approve to the zero address")
+	}
+
+	return stateDB.ExecuteNativeAction(ec.Address(), ConvertERC20Event, func(ctx sdk.Context) error {
+		ec.erc20Keeper.SetAllowance(ctx, ec.Address(), owner, spender, amount)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeERC20Approval,
+			sdk.NewAttribute(AttributeKeyOwner, owner.Hex()),
+			sdk.NewAttribute(AttributeKeySpender, spender.Hex()),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		))
+		return nil
+	})
+}
+
+// spendAllowance decreases the allowance of the spender, the max uint256 allowance is infinite
+// and is left unchanged without emitting an Approval log, as in the OpenZeppelin implementation.
+func (ec *NativeERC20Contract) spendAllowance(stateDB ExtStateDB, owner, spender common.Address, amount *big.Int) error {
+	allowance := ec.erc20Keeper.GetAllowance(stateDB.Context(), ec.Address(), owner, spender)
+	if allowance.Cmp(math.MaxBig256) == 0 {
+		return nil
+	}
+	if allowance.Cmp(amount) < 0 {
+		return errors.New("ERC20: insufficient allowance")
+	}
+	return stateDB.ExecuteNativeAction(ec.Address(), nil, func(ctx sdk.Context) error {
+		ec.erc20Keeper.SetAllowance(ctx, ec.Address(), owner, spender, new(big.Int).Sub(allowance, amount))
+		return nil
+	})
+}
+
+// migrateLegacyAllowance moves the allowance left in the token pair contract storage to the erc20
+// module store, where it can't be set before the legacy one is migrated.
+func (ec *NativeERC20Contract) migrateLegacyAllowance(stateDB ExtStateDB, owner, spender common.Address) error {
+	token := ec.Address()
+	key := legacyAllowanceKey(owner, spender)
+	allowance := stateDB.GetState(token, key).Big()
+	if allowance.Sign() == 0 {
+		return nil
+	}
+	stateDB.SetState(token, key, common.Hash{})
+	return stateDB.ExecuteNativeAction(token, nil, func(ctx sdk.Context) error {
+		ec.erc20Keeper.SetAllowance(ctx, token, owner, spender, allowance)
+		return nil
+	})
+}
+
+// migrateLegacyBalance moves the ERC20 balance left in the token pair contract storage to the
+// coins escrowed by the erc20 module, as MsgConvertERC20 does for the token pairs of native coins.
+func migrateLegacyBalance(stateDB ExtStateDB, bankKeeper BankKeeper, pair erc20types.TokenPair, holder common.Address) error {
+	token := pair.GetERC20Contract()
+	key := legacyBalanceKey(holder)
+	balance := stateDB.GetState(token, key).Big()
+	if balance.Sign() == 0 {
+		return nil
+	}
+	stateDB.SetState(token, key, common.Hash{})
+	supply := stateDB.GetState(token, legacyTotalSupplySlot).Big()
+	stateDB.SetState(token, legacyTotalSupplySlot, common.BigToHash(supply.Sub(supply, balance)))
+
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdkmath.NewIntFromBigInt(balance)))
+	return stateDB.ExecuteNativeAction(token, nil, func(ctx sdk.Context) error {
+		return bankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, holder.Bytes(), coins)
+	})
+}
+
+// legacyBalanceKey returns the storage key of `_balances[holder]` in the token pair contract.
+func legacyBalanceKey(holder common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.BytesToHash(holder.Bytes()).Bytes(), legacyBalancesSlot.Bytes())
+}
+
+// legacyAllowanceKey returns the storage key of `_allowances[owner][spender]` in the token pair
+// contract.
+func legacyAllowanceKey(owner, spender common.Address) common.Hash {
+	inner := crypto.Keccak256Hash(common.BytesToHash(owner.Bytes()).Bytes(), legacyAllowancesSlot.Bytes())
+	return crypto.Keccak256Hash(common.BytesToHash(spender.Bytes()).Bytes(), inner.Bytes())
+}
+
+// ConvertERC20Event converts the native transfer and approval events of the native ERC20
+// precompiled contracts to the Transfer and Approval logs, the other native events are skipped.
+func ConvertERC20Event(event sdk.Event) (*ethtypes.Log, error) {
+	var eventName, fromKey, toKey string
+	switch event.Type {
+	case EventTypeERC20Transfer:
+		eventName, fromKey, toKey = TransferEventName, AttributeKeyFrom, AttributeKeyTo
+	case EventTypeERC20Approval:
+		eventName, fromKey, toKey = ApprovalEventName, AttributeKeyOwner, AttributeKeySpender
+	default:
+		return nil, nil
+	}
+
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	amount, ok := new(big.Int).SetString(attrs[AttributeKeyAmount], 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %s", attrs[AttributeKeyAmount])
+	}
+
+	abiEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events[eventName]
+	data, err := abiEvent.Inputs.NonIndexed().Pack(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethtypes.Log{
+		Topics: []common.Hash{
+			abiEvent.ID,
+			common.BytesToHash(common.HexToAddress(attrs[fromKey]).Bytes()),
+			common.BytesToHash(common.HexToAddress(attrs[toKey]).Bytes()),
+		},
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,300 @@
+package precompiles
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loka-network/loka/v1/contracts"
+	erc20types "github.com/loka-network/loka/v1/x/erc20/types"
+)
+
+// mockERC20BankKeeper adds the denom metadata to the in-memory bank keeper.
+type mockERC20BankKeeper struct {
+	*mockBankKeeper
+}
+
+func (k mockERC20BankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	if denom != "aloka" {
+		return banktypes.Metadata{}, false
+	}
+	return banktypes.Metadata{
+		Base:       "aloka",
+		Name:       "Loka",
+		Symbol:     "LOKA",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "aloka"}, {Denom: "loka", Exponent: 18}},
+	}, true
+}
+
+// mockNativeERC20Keeper keeps the allowances in memory.
+type mockNativeERC20Keeper struct {
+	NativeERC20Keeper
+
+	allowances map[string]*big.Int
+}
+
+func (k *mockNativeERC20Keeper) GetAllowance(_ sdk.Context, erc20, owner, spender common.Address) *big.Int {
+	if allowance, ok := k.allowances[string(erc20types.AllowanceKey(erc20, owner, spender))]; ok {
+		return allowance
+	}
+	return big.NewInt(0)
+}
+
+func (k *mockNativeERC20Keeper) SetAllowance(_ sdk.Context, erc20, owner, spender common.Address, value *big.Int) {
+	k.allowances[string(erc20types.AllowanceKey(erc20, owner, spender))] = value
+}
+
+func TestNativeERC20Contract(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	spender := common.HexToAddress("0x2000")
+	token := common.HexToAddress("0x3000")
+	pair := erc20types.NewTokenPair(token, "aloka", erc20types.OWNER_MODULE)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	testCases := []struct {
+		name      string
+		caller    common.Address
+		method    string
+		args      []interface{}
+		allowance *big.Int
+		readonly  bool
+		expErr    bool
+		expLogs   int
+		expRet    interface{}
+	}{
+		{"name", owner, NameMethodName, nil, nil, true, false, 0, "Loka"},
+		{"symbol", owner, SymbolMethodName, nil, nil, true, false, 0, "LOKA"},
+		{"decimals", owner, DecimalsMethodName, nil, nil, true, false, 0, 18},
+		{"total supply", owner, TotalSupplyMethodName, nil, nil, true, false, 0, 0},
+		{"balance of", owner, BalanceOfMethodName, []interface{}{owner}, nil, true, false, 0, 100},
+		{"allowance", owner, AllowanceMethodName, []interface{}{owner, spender}, big.NewInt(5), true, false, 0, 5},
+		{"transfer", owner, TransferMethodName, []interface{}{spender, big.NewInt(60)}, nil, false, false, 1, true},
+		{"transfer insufficient funds", owner, TransferMethodName, []interface{}{spender, big.NewInt(101)}, nil, false, true, 0, nil},
+		{"transfer to zero address", owner, TransferMethodName, []interface{}{common.Address{}, big.NewInt(1)}, nil, false, true, 0, nil},
+		{"transfer to blocked address", owner, TransferMethodName, []interface{}{common.HexToAddress("0xdead"), big.NewInt(1)}, nil, false, true, 0, nil},
+		{"transfer in static call", owner, TransferMethodName, []interface{}{spender, big.NewInt(1)}, nil, true, true, 0, nil},
+		{"approve", owner, ApproveMethodName, []interface{}{spender, big.NewInt(10)}, nil, false, false, 1, true},
+		{"increase allowance", owner, IncreaseAllowanceMethodName, []interface{}{spender, big.NewInt(10)}, big.NewInt(5), false, false, 1, true},
+		{"increase allowance overflow", owner, IncreaseAllowanceMethodName, []interface{}{spender, big.NewInt(1)}, math.MaxBig256, false, true, 0, nil},
+		{"decrease allowance", owner, DecreaseAllowanceMethodName, []interface{}{spender, big.NewInt(5)}, big.NewInt(5), false, false, 1, true},
+		{"decrease allowance below zero", owner, DecreaseAllowanceMethodName, []interface{}{spender, big.NewInt(6)}, big.NewInt(5), false, true, 0, nil},
+		{"transfer from", spender, TransferFromMethodName, []interface{}{owner, spender, big.NewInt(5)}, big.NewInt(5), false, false, 1, true},
+		{"transfer from infinite allowance", spender, TransferFromMethodName, []interface{}{owner, spender, big.NewInt(5)}, math.MaxBig256, false, false, 1, true},
+		{"transfer from insufficient allowance", spender, TransferFromMethodName, []interface{}{owner, spender, big.NewInt(6)}, big.NewInt(5), false, true, 0, nil},
+		{"unsupported method", owner, MintMethodName, []interface{}{owner, big.NewInt(1)}, nil, false, true, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bankKeeper := mockERC20BankKeeper{newMockBankKeeper()}
+			bankKeeper.balances[sdk.AccAddress(owner.Bytes()).String()+"aloka"] = sdkmath.NewInt(100)
+			erc20Keeper := &mockNativeERC20Keeper{allowances: make(map[string]*big.Int)}
+			if tc.allowance != nil {
+				erc20Keeper.SetAllowance(sdk.Context{}, token, owner, spender, tc.allowance)
+			}
+			stateDB := &mockStateDB{}
+			ec := NewNativeERC20Contract(pair, bankKeeper, erc20Keeper)
+			require.Equal(t, token, ec.Address())
+
+			input, err := erc20ABI.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+
+			contract := vm.NewContract(vm.AccountRef(tc.caller), vm.AccountRef(token), big.NewInt(0), 100000)
+			contract.Input = input
+
+			ret, err := ec.Run(&vm.EVM{StateDB: stateDB}, contract, tc.readonly)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotZero(t, ec.RequiredGas(input))
+
+			out, err := erc20ABI.Methods[tc.method].Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprint(tc.expRet), fmt.Sprint(out[0]))
+
+			require.Len(t, stateDB.logs, tc.expLogs)
+			for _, log := range stateDB.logs {
+				require.Equal(t, token, log.Address)
+			}
+		})
+	}
+}
+
+func TestNativeERC20ContractTransferFrom(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	spender := common.HexToAddress("0x2000")
+	receiver := common.HexToAddress("0x4000")
+	token := common.HexToAddress("0x3000")
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	bankKeeper := mockERC20BankKeeper{newMockBankKeeper()}
+	bankKeeper.balances[sdk.AccAddress(owner.Bytes()).String()+"aloka"] = sdkmath.NewInt(100)
+	erc20Keeper := &mockNativeERC20Keeper{allowances: make(map[string]*big.Int)}
+	stateDB := &mockStateDB{}
+	ec := NewNativeERC20Contract(erc20types.NewTokenPair(token, "aloka", erc20types.OWNER_MODULE), bankKeeper, erc20Keeper)
+	evm := &vm.EVM{StateDB: stateDB}
+
+	call := func(caller common.Address, method string, args ...interface{}) []interface{} {
+		input, err := erc20ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(vm.AccountRef(caller), vm.AccountRef(token), big.NewInt(0), 100000)
+		contract.Input = input
+		ret, err := ec.Run(evm, contract, false)
+		require.NoError(t, err)
+		out, err := erc20ABI.Methods[method].Outputs.Unpack(ret)
+		require.NoError(t, err)
+		return out
+	}
+
+	call(owner, ApproveMethodName, spender, big.NewInt(50))
+	call(spender, TransferFromMethodName, owner, receiver, big.NewInt(30))
+	require.Equal(t, big.NewInt(20), call(owner, AllowanceMethodName, owner, spender)[0])
+	require.Equal(t, big.NewInt(70), call(owner, BalanceOfMethodName, owner)[0])
+	require.Equal(t, big.NewInt(30), call(owner, BalanceOfMethodName, receiver)[0])
+
+	require.Len(t, stateDB.logs, 2)
+	approvalLog, transferLog := stateDB.logs[0], stateDB.logs[1]
+	require.Equal(t, erc20ABI.Events[ApprovalEventName].ID, approvalLog.Topics[0])
+	require.Equal(t, common.BytesToHash(owner.Bytes()), approvalLog.Topics[1])
+	require.Equal(t, common.BytesToHash(spender.Bytes()), approvalLog.Topics[2])
+	require.Equal(t, erc20ABI.Events[TransferEventName].ID, transferLog.Topics[0])
+	require.Equal(t, common.BytesToHash(owner.Bytes()), transferLog.Topics[1])
+	require.Equal(t, common.BytesToHash(receiver.Bytes()), transferLog.Topics[2])
+	require.Equal(t, common.BigToHash(big.NewInt(30)).Bytes(), transferLog.Data)
+}
+
+func TestNativeERC20ContractLegacyState(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	spender := common.HexToAddress("0x2000")
+	receiver := common.HexToAddress("0x4000")
+	token := common.HexToAddress("0x3000")
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// the owner converted 40 of its 100 coins before the precompile was enabled
+	bankKeeper := mockERC20BankKeeper{newMockBankKeeper()}
+	bankKeeper.balances[sdk.AccAddress(owner.Bytes()).String()+"aloka"] = sdkmath.NewInt(60)
+	bankKeeper.balances[erc20types.ModuleName+"aloka"] = sdkmath.NewInt(40)
+	erc20Keeper := &mockNativeERC20Keeper{allowances: make(map[string]*big.Int)}
+	stateDB := &mockStateDB{}
+	stateDB.SetState(token, legacyBalanceKey(owner), common.BigToHash(big.NewInt(40)))
+	stateDB.SetState(token, legacyTotalSupplySlot, common.BigToHash(big.NewInt(40)))
+	stateDB.SetState(token, legacyAllowanceKey(owner, spender), common.BigToHash(big.NewInt(7)))
+	ec := NewNativeERC20Contract(erc20types.NewTokenPair(token, "aloka", erc20types.OWNER_MODULE), bankKeeper, erc20Keeper)
+	evm := &vm.EVM{StateDB: stateDB}
+
+	call := func(caller common.Address, method string, readonly bool, args ...interface{}) []interface{} {
+		input, err := erc20ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(vm.AccountRef(caller), vm.AccountRef(token), big.NewInt(0), 100000)
+		contract.Input = input
+		ret, err := ec.Run(evm, contract, readonly)
+		require.NoError(t, err)
+		out, err := erc20ABI.Methods[method].Outputs.Unpack(ret)
+		require.NoError(t, err)
+		return out
+	}
+
+	// the legacy state is added to the native one
+	require.Equal(t, big.NewInt(100), call(owner, BalanceOfMethodName, true, owner)[0])
+	require.Equal(t, big.NewInt(7), call(owner, AllowanceMethodName, true, owner, spender)[0])
+
+	// the legacy allowance and balance are migrated before they're spent
+	call(spender, TransferFromMethodName, false, owner, receiver, big.NewInt(5))
+	require.Equal(t, big.NewInt(2), call(owner, AllowanceMethodName, true, owner, spender)[0])
+	require.Equal(t, big.NewInt(95), call(owner, BalanceOfMethodName, true, owner)[0])
+	require.Equal(t, big.NewInt(5), call(owner, BalanceOfMethodName, true, receiver)[0])
+	require.Equal(t, sdkmath.NewInt(95), bankKeeper.balance(sdk.AccAddress(owner.Bytes()).String(), "aloka"))
+	require.True(t, bankKeeper.balance(erc20types.ModuleName, "aloka").IsZero())
+	require.Equal(t, common.Hash{}, stateDB.GetState(token, legacyBalanceKey(owner)))
+	require.Equal(t, common.Hash{}, stateDB.GetState(token, legacyAllowanceKey(owner, spender)))
+	require.Equal(t, common.Hash{}, stateDB.GetState(token, legacyTotalSupplySlot))
+
+	// approve replaces the legacy allowance
+	stateDB.SetState(token, legacyAllowanceKey(owner, receiver), common.BigToHash(big.NewInt(9)))
+	call(owner, ApproveMethodName, false, receiver, big.NewInt(3))
+	require.Equal(t, big.NewInt(3), call(owner, AllowanceMethodName, true, owner, receiver)[0])
+}
+
+// TestLegacyERC20StorageLayout checks the legacy storage slots against the compiled token pair
+// contract.
+func TestLegacyERC20StorageLayout(t *testing.T) {
+	db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(vm.StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(1),
+	}
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, db, params.TestChainConfig, vm.Config{})
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+	deployer := erc20types.ModuleAddress
+	owner := common.HexToAddress("0x1000")
+	spender := common.HexToAddress("0x2000")
+
+	ctorArgs, err := erc20.ABI.Pack("", "Loka", "LOKA", uint8(18))
+	require.NoError(t, err)
+	_, token, _, err := evm.Create(vm.AccountRef(deployer), append(append([]byte{}, erc20.Bin...), ctorArgs...), 10000000, big.NewInt(0))
+	require.NoError(t, err)
+
+	call := func(caller common.Address, method string, args ...interface{}) {
+		input, err := erc20.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		_, _, err = evm.Call(vm.AccountRef(caller), token, input, 10000000, big.NewInt(0))
+		require.NoError(t, err)
+	}
+	call(deployer, "mint", owner, big.NewInt(40))
+	call(owner, ApproveMethodName, spender, big.NewInt(7))
+
+	require.Equal(t, big.NewInt(40), db.GetState(token, legacyBalanceKey(owner)).Big())
+	require.Equal(t, big.NewInt(40), db.GetState(token, legacyTotalSupplySlot).Big())
+	require.Equal(t, big.NewInt(7), db.GetState(token, legacyAllowanceKey(owner, spender)).Big())
+}
+
+func TestNativeERC20ContractDelegateAndStaticCall(t *testing.T) {
+	owner := common.HexToAddress("0x1000")
+	spender := common.HexToAddress("0x2000")
+	token := common.HexToAddress("0x3000")
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	for _, op := range []vm.OpCode{vm.DELEGATECALL, vm.STATICCALL} {
+		t.Run(op.String(), func(t *testing.T) {
+			bankKeeper := mockERC20BankKeeper{newMockBankKeeper()}
+			bankKeeper.balances[sdk.AccAddress(owner.Bytes()).String()+"aloka"] = sdkmath.NewInt(100)
+			erc20Keeper := &mockNativeERC20Keeper{allowances: make(map[string]*big.Int)}
+			stateDB := &mockStateDB{}
+			ec := NewNativeERC20Contract(erc20types.NewTokenPair(token, "aloka", erc20types.OWNER_MODULE), bankKeeper, erc20Keeper)
+
+			input, err := erc20ABI.Pack(BalanceOfMethodName, owner)
+			require.NoError(t, err)
+			ret, err := callPrecompile(stateDB, ec, op, owner, input)
+			require.NoError(t, err)
+			out, err := erc20ABI.Methods[BalanceOfMethodName].Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(100), out[0])
+
+			for _, method := range []string{TransferMethodName, ApproveMethodName} {
+				input, err := erc20ABI.Pack(method, spender, big.NewInt(1))
+				require.NoError(t, err)
+				_, err = callPrecompile(stateDB, ec, op, owner, input)
+				require.ErrorIs(t, err, vm.ErrWriteProtection)
+			}
+			require.Empty(t, stateDB.logs)
+			require.Empty(t, erc20Keeper.allowances)
+		})
+	}
+}
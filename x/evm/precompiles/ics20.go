@@ -0,0 +1,333 @@
+package precompiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/loka-network/loka/v1/contracts"
+	erc20types "github.com/loka-network/loka/v1/x/erc20/types"
+)
+
+const (
+	IBCTransferMethodName = "transfer"
+
+	IBCTransferEventName = "IBCTransfer"
+	SendPacketEventName  = "SendPacket"
+
+	// ibcTransferGas is the fixed gas cost of an ICS-20 transfer, the IBC writes run with a
+	// zero KV gas config.
+	ibcTransferGas uint64 = 100000
+)
+
+// ics20ABIJSON is the ABI of the IICS20 interface, see IICS20.sol
+const ics20ABIJSON = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"sourcePort","type":"string"},{"name":"sourceChannel","type":"string"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"},{"name":"receiver","type":"string"},{"name":"timeoutRevisionNumber","type":"uint64"},{"name":"timeoutRevisionHeight","type":"uint64"},{"name":"timeoutTimestamp","type":"uint64"},{"name":"memo","type":"string"}],"outputs":[{"name":"sequence","type":"uint64"}]},
+	{"type":"event","name":"IBCTransfer","anonymous":false,"inputs":[{"name":"sender","type":"address","indexed":true},{"name":"receiver","type":"string","indexed":false},{"name":"denom","type":"string","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"memo","type":"string","indexed":false}]},
+	{"type":"event","name":"SendPacket","anonymous":false,"inputs":[{"name":"sequence","type":"uint64","indexed":true},{"name":"sourcePort","type":"string","indexed":false},{"name":"sourceChannel","type":"string","indexed":false},{"name":"destinationPort","type":"string","indexed":false},{"name":"destinationChannel","type":"string","indexed":false}]}
+]`
+
+var (
+	// ICS20ContractAddress is the address of the ICS-20 transfer precompiled contract
+	ICS20ContractAddress = common.BytesToAddress([]byte{101})
+
+	// ICS20ABI is the parsed ABI of the ICS-20 transfer precompiled contract
+	ICS20ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ICS20ABI, err = abi.JSON(strings.NewReader(ics20ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// TransferKeeper defines the ICS-20 transfer keeper wrapped by the precompiled contract.
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// BankKeeper defines the bank keeper used to convert the ERC20 tokens to coins.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// ERC20Keeper defines the erc20 keeper used to resolve the token pairs.
+type ERC20Keeper interface {
+	IsERC20Enabled(ctx sdk.Context) bool
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	IsNativePrecompile(ctx sdk.Context, erc20 common.Address) bool
+}
+
+var _ vm.PrecompiledContract = &ICS20Contract{}
+
+// ICS20Contract is a stateful precompiled contract which sends tokens over IBC on behalf of the
+// caller. The denom can be a bank denom or the address of a registered ERC20 token, in which case
+// the missing coin balance is converted from the ERC20 balance within the EVM before the transfer.
+type ICS20Contract struct {
+	bankKeeper     BankKeeper
+	transferKeeper TransferKeeper
+	erc20Keeper    ERC20Keeper
+}
+
+// NewICS20Contract creates the ICS-20 transfer precompiled contract.
+func NewICS20Contract(bankKeeper BankKeeper, transferKeeper TransferKeeper, erc20Keeper ERC20Keeper) vm.PrecompiledContract {
+	return &ICS20Contract{
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		erc20Keeper:    erc20Keeper,
+	}
+}
+
+// Address implements vm.PrecompiledContract
+func (ic *ICS20Contract) Address() common.Address {
+	return ICS20ContractAddress
+}
+
+// RequiredGas implements vm.PrecompiledContract
+func (ic *ICS20Contract) RequiredGas(_ []byte) uint64 {
+	return ibcTransferGas
+}
+
+// Run implements vm.PrecompiledContract
+func (ic *ICS20Contract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+	if hasValue(contract) {
+		return nil, errors.New("the ICS-20 precompiled contract isn't payable")
+	}
+	if readonly {
+		return nil, vm.ErrWriteProtection
+	}
+
+	stateDB, ok := evm.StateDB.(ExtStateDB)
+	if !ok {
+		return nil, fmt.Errorf("statedb %T doesn't support native actions", evm.StateDB)
+	}
+
+	method, err := ICS20ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name != IBCTransferMethodName {
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	sender := contract.CallerAddress
+	denom := args[2].(string)
+	amount := sdkmath.NewIntFromBigInt(args[3].(*big.Int))
+	if !amount.IsPositive() {
+		return nil, errors.New("the transfer amount must be positive")
+	}
+
+	pair, registered := ic.tokenPair(stateDB.Context(), denom)
+	if registered {
+		denom = pair.Denom
+		if ic.erc20Keeper.IsNativePrecompile(stateDB.Context(), pair.GetERC20Contract()) {
+			// the coins of a native precompile pair are already its ERC20 balance, except the
+			// balance left in the contract storage
+			err = migrateLegacyBalance(stateDB, ic.bankKeeper, pair, sender)
+		} else {
+			err = ic.convertERC20(evm, contract, stateDB, pair, sender, amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else if common.IsHexAddress(strings.TrimPrefix(denom, erc20types.ModuleName+"/")) {
+		return nil, fmt.Errorf("token %s is not a registered and enabled ERC20 token pair", denom)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		args[0].(string),
+		args[1].(string),
+		sdk.NewCoin(denom, amount),
+		sdk.AccAddress(sender.Bytes()).String(),
+		args[4].(string),
+		clienttypes.NewHeight(args[5].(uint64), args[6].(uint64)),
+		args[7].(uint64),
+		args[8].(string),
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var sequence uint64
+	if err := stateDB.ExecuteNativeAction(ICS20ContractAddress, ConvertIBCTransferEvent, func(ctx sdk.Context) error {
+		res, err := ic.transferKeeper.Transfer(ctx, msg)
+		if err != nil {
+			return err
+		}
+		sequence = res.Sequence
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// tokenPair returns the enabled token pair of the denom or ERC20 address, if any.
+func (ic *ICS20Contract) tokenPair(ctx sdk.Context, denom string) (erc20types.TokenPair, bool) {
+	if !ic.erc20Keeper.IsERC20Enabled(ctx) {
+		return erc20types.TokenPair{}, false
+	}
+
+	token := strings.TrimPrefix(denom, erc20types.ModuleName+"/")
+	id := ic.erc20Keeper.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return erc20types.TokenPair{}, false
+	}
+	pair, found := ic.erc20Keeper.GetTokenPair(ctx, id)
+	if !found || !pair.Enabled {
+		return erc20types.TokenPair{}, false
+	}
+	return pair, true
+}
+
+// convertERC20 converts the missing coin balance of the sender from its ERC20 balance, as
+// MsgConvertERC20 does. The ERC20 calls run in the current EVM so they see the pending state of
+// the transaction, and are reverted with it.
+func (ic *ICS20Contract) convertERC20(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB ExtStateDB,
+	pair erc20types.TokenPair,
+	sender common.Address,
+	amount sdkmath.Int,
+) error {
+	balance := ic.bankKeeper.GetBalance(stateDB.Context(), sender.Bytes(), pair.Denom)
+	if balance.Amount.GTE(amount) {
+		return nil
+	}
+	diff := amount.Sub(balance.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, diff))
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	var (
+		caller vm.ContractRef
+		input  []byte
+		err    error
+	)
+	switch {
+	case pair.IsNativeCoin():
+		// burn the tokens minted by the erc20 module and unescrow the coins
+		caller = vm.AccountRef(erc20types.ModuleAddress)
+		input, err = erc20.Pack("burnCoins", sender, diff.BigInt())
+	case pair.IsNativeERC20():
+		// escrow the tokens on the erc20 module and mint the coins
+		caller = vm.AccountRef(sender)
+		input, err = erc20.Pack("transfer", erc20types.ModuleAddress, diff.BigInt())
+	default:
+		return erc20types.ErrUndefinedOwner
+	}
+	if err != nil {
+		return err
+	}
+
+	ret, leftOverGas, err := evm.Call(caller, pair.GetERC20Contract(), input, contract.Gas, big.NewInt(0))
+	contract.Gas = leftOverGas
+	if err != nil {
+		return fmt.Errorf("failed to convert %s from ERC20: %w", coins, err)
+	}
+	if pair.IsNativeERC20() {
+		res, err := erc20.Unpack("transfer", ret)
+		if err != nil {
+			return err
+		}
+		if ok, _ := res[0].(bool); !ok {
+			return fmt.Errorf("failed to convert %s from ERC20: transfer returned false", coins)
+		}
+	}
+
+	return stateDB.ExecuteNativeAction(ICS20ContractAddress, nil, func(ctx sdk.Context) error {
+		if pair.IsNativeERC20() {
+			if err := ic.bankKeeper.MintCoins(ctx, erc20types.ModuleName, coins); err != nil {
+				return err
+			}
+		}
+		return ic.bankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, sender.Bytes(), coins)
+	})
+}
+
+// ConvertIBCTransferEvent converts the ICS-20 transfer and send packet events to IBCTransfer and
+// SendPacket logs, the other native events are skipped.
+func ConvertIBCTransferEvent(event sdk.Event) (*ethtypes.Log, error) {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	switch event.Type {
+	case transfertypes.EventTypeTransfer:
+		sender, err := sdk.AccAddressFromBech32(attrs[sdk.AttributeKeySender])
+		if err != nil {
+			return nil, err
+		}
+		amount, ok := new(big.Int).SetString(attrs[transfertypes.AttributeKeyAmount], 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid transfer amount %s", attrs[transfertypes.AttributeKeyAmount])
+		}
+
+		transferEvent := ICS20ABI.Events[IBCTransferEventName]
+		data, err := transferEvent.Inputs.NonIndexed().Pack(
+			attrs[transfertypes.AttributeKeyReceiver],
+			attrs[transfertypes.AttributeKeyDenom],
+			amount,
+			attrs[transfertypes.AttributeKeyMemo],
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &ethtypes.Log{
+			Topics: []common.Hash{transferEvent.ID, common.BytesToHash(sender.Bytes())},
+			Data:   data,
+		}, nil
+	case channeltypes.EventTypeSendPacket:
+		sequence, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		packetEvent := ICS20ABI.Events[SendPacketEventName]
+		data, err := packetEvent.Inputs.NonIndexed().Pack(
+			attrs[channeltypes.AttributeKeySrcPort],
+			attrs[channeltypes.AttributeKeySrcChannel],
+			attrs[channeltypes.AttributeKeyDstPort],
+			attrs[channeltypes.AttributeKeyDstChannel],
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &ethtypes.Log{
+			Topics: []common.Hash{packetEvent.ID, common.BigToHash(new(big.Int).SetUint64(sequence))},
+			Data:   data,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,103 @@
+package precompiles
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertIBCTransferEvent(t *testing.T) {
+	sender := common.HexToAddress("0x1000")
+
+	log, err := ConvertIBCTransferEvent(sdk.NewEvent(
+		transfertypes.EventTypeTransfer,
+		sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(sender.Bytes()).String()),
+		sdk.NewAttribute(transfertypes.AttributeKeyReceiver, "cosmos1receiver"),
+		sdk.NewAttribute(transfertypes.AttributeKeyDenom, "aloka"),
+		sdk.NewAttribute(transfertypes.AttributeKeyAmount, "10"),
+		sdk.NewAttribute(transfertypes.AttributeKeyMemo, "memo"),
+	))
+	require.NoError(t, err)
+	transferEvent := ICS20ABI.Events[IBCTransferEventName]
+	require.Equal(t, []common.Hash{transferEvent.ID, common.BytesToHash(sender.Bytes())}, log.Topics)
+	data, err := transferEvent.Inputs.NonIndexed().Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"cosmos1receiver", "aloka", big.NewInt(10), "memo"}, data)
+
+	log, err = ConvertIBCTransferEvent(sdk.NewEvent(
+		channeltypes.EventTypeSendPacket,
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, "7"),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcPort, "transfer"),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, "channel-0"),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstPort, "transfer"),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, "channel-1"),
+	))
+	require.NoError(t, err)
+	packetEvent := ICS20ABI.Events[SendPacketEventName]
+	require.Equal(t, []common.Hash{packetEvent.ID, common.BigToHash(big.NewInt(7))}, log.Topics)
+	data, err = packetEvent.Inputs.NonIndexed().Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"transfer", "channel-0", "transfer", "channel-1"}, data)
+
+	log, err = ConvertIBCTransferEvent(sdk.NewEvent(EventTypeTransfer))
+	require.NoError(t, err)
+	require.Nil(t, log)
+
+	_, err = ConvertIBCTransferEvent(sdk.NewEvent(transfertypes.EventTypeTransfer, sdk.NewAttribute(sdk.AttributeKeySender, "invalid")))
+	require.Error(t, err)
+}
+
+func TestICS20ContractValidation(t *testing.T) {
+	sender := common.HexToAddress("0x1000")
+	ic := NewICS20Contract(nil, nil, nil)
+
+	input, err := ICS20ABI.Pack(
+		IBCTransferMethodName,
+		"transfer", "channel-0", "aloka", big.NewInt(10), "cosmos1receiver",
+		uint64(0), uint64(100), uint64(0), "",
+	)
+	require.NoError(t, err)
+	require.NotZero(t, ic.RequiredGas(input))
+
+	testCases := []struct {
+		name     string
+		value    int64
+		readonly bool
+	}{
+		{"payable call", 1, false},
+		{"static call", 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contract := vm.NewContract(vm.AccountRef(sender), vm.AccountRef(ICS20ContractAddress), big.NewInt(tc.value), 100000)
+			contract.Input = input
+			_, err := ic.Run(&vm.EVM{StateDB: &mockStateDB{}}, contract, tc.readonly)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestICS20ContractDelegateAndStaticCall(t *testing.T) {
+	sender := common.HexToAddress("0x1000")
+	ic := NewICS20Contract(nil, nil, nil)
+
+	input, err := ICS20ABI.Pack(
+		IBCTransferMethodName,
+		"transfer", "channel-0", "aloka", big.NewInt(10), "cosmos1receiver",
+		uint64(0), uint64(100), uint64(0), "",
+	)
+	require.NoError(t, err)
+
+	for _, op := range []vm.OpCode{vm.DELEGATECALL, vm.STATICCALL} {
+		t.Run(op.String(), func(t *testing.T) {
+			_, err := callPrecompile(&mockStateDB{}, ic, op, sender, input)
+			require.ErrorIs(t, err, vm.ErrWriteProtection)
+		})
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
 )
 
 const StateDBContextKey = "statedb"
@@ -407,6 +409,8 @@ func (s *StateDB) Transfer(sender, recipient common.Address, amount *big.Int) {
 	senderAddr := sdk.AccAddress(sender.Bytes())
 	recipientAddr := sdk.AccAddress(recipient.Bytes())
 	if err := s.ExecuteNativeAction(common.Address{}, nil, func(ctx sdk.Context) error {
+		// the transfer is checked by the send restrictions of the bank keeper
+		ctx = evmtypes.WithValueTransfer(ctx)
 		return s.keeper.Transfer(ctx, senderAddr, recipientAddr, coins)
 	}); err != nil {
 		s.err = err
@@ -118,6 +118,20 @@ func getTimeValue(time *sdkmath.Int) *uint64 {
 	return &t
 }
 
+// IsShanghai returns whether the Shanghai fork is active at the block time. The EVM
+// instruction set follows the time based activation, the shanghai_block value is only
+// kept for the Ethereum ChainConfig.
+func (cc ChainConfig) IsShanghai(time uint64) bool {
+	return isTimestampForked(getTimeValue(cc.ShanghaiTime), time)
+}
+
+func isTimestampForked(fork *uint64, time uint64) bool {
+	if fork == nil {
+		return false
+	}
+	return *fork <= time
+}
+
 // Validate performs a basic validation of the ChainConfig params. The function will return an error
 // if any of the block values is uninitialized (i.e nil) or if the EIP150Hash is an invalid hash.
 func (cc ChainConfig) Validate() error {
@@ -175,6 +189,9 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateTime(cc.ShanghaiTime); err != nil {
+		return errorsmod.Wrap(err, "ShanghaiTime")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
@@ -190,6 +207,21 @@ func validateHash(hex string) error {
 	return nil
 }
 
+func validateTime(time *sdkmath.Int) error {
+	// nil value means that the fork has not yet been scheduled
+	if time == nil {
+		return nil
+	}
+
+	if time.IsNegative() || !time.IsUint64() {
+		return errorsmod.Wrapf(
+			ErrInvalidChainConfig, "time value must be a valid unix timestamp: %s", time,
+		)
+	}
+
+	return nil
+}
+
 func validateBlock(block *sdkmath.Int) error {
 	// nil value means that the fork has not yet been applied
 	if block == nil {
@@ -367,6 +367,56 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid ShanghaiTime",
+			ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         newIntPtr(0),
+				ShanghaiTime:        newIntPtr(1700000000),
+			},
+			false,
+		},
+		{
+			"invalid ShanghaiTime",
+			ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         newIntPtr(0),
+				ShanghaiTime:        newIntPtr(-1),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -379,3 +429,13 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigIsShanghai(t *testing.T) {
+	cfg := DefaultChainConfig()
+	require.False(t, cfg.IsShanghai(0), "unscheduled fork")
+
+	cfg.ShanghaiTime = newIntPtr(100)
+	require.False(t, cfg.IsShanghai(99))
+	require.True(t, cfg.IsShanghai(100))
+	require.True(t, cfg.IsShanghai(101))
+}
@@ -39,6 +39,7 @@ var (
 const (
 	// Amino names
 	updateParamsName = "ethermint/MsgUpdateParams"
+	setFeeDenomName  = "ethermint/MsgSetFeeDenom"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -57,6 +58,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgSetFeeDenom{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -104,4 +106,5 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeDenom{}, setFeeDenomName, nil)
 }
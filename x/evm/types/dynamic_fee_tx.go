@@ -269,10 +269,10 @@ func (tx DynamicFeeTx) Validate() error {
 		)
 	}
 
-	if !(chainID.Cmp(big.NewInt(567001)) == 0 || chainID.Cmp(big.NewInt(567000)) == 0 || chainID.Cmp(big.NewInt(567002)) == 0) {
+	if chainID.Sign() <= 0 {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidChainID,
-			"chain ID must be 567000 or 567001 or 567002 on Evmos, got %s", chainID,
+			"chain ID must be positive, got %s", chainID,
 		)
 	}
 
@@ -42,9 +42,16 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrMaxInitCodeSizeExceeded
+	codeErrCreateNotPermitted
+	codeErrCallNotPermitted
+	codeErrInvalidFeeDenom
 )
 
-var ErrPostTxProcessing = errors.New("failed to execute post processing")
+var (
+	ErrPreTxProcessing  = errors.New("failed to execute pre processing")
+	ErrPostTxProcessing = errors.New("failed to execute post processing")
+)
 
 var (
 	// ErrInvalidState returns an error resulting from an invalid Storage State.
@@ -91,6 +98,18 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrMaxInitCodeSizeExceeded returns an error if the init code of a contract creation exceeds the EIP-3860 limit.
+	ErrMaxInitCodeSizeExceeded = errorsmod.Register(ModuleName, codeErrMaxInitCodeSizeExceeded, "max initcode size exceeded")
+
+	// ErrCreateNotPermitted returns an error if the create permission policy doesn't allow the contract creation.
+	ErrCreateNotPermitted = errorsmod.Register(ModuleName, codeErrCreateNotPermitted, "EVM Create operation is not permitted")
+
+	// ErrCallNotPermitted returns an error if the call permission policy doesn't allow the contract call.
+	ErrCallNotPermitted = errorsmod.Register(ModuleName, codeErrCallNotPermitted, "EVM Call operation is not permitted")
+
+	// ErrInvalidFeeDenom returns an error if the fees are paid in a fee denomination that isn't registered or opted in.
+	ErrInvalidFeeDenom = errorsmod.Register(ModuleName, codeErrInvalidFeeDenom, "invalid fee denom")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
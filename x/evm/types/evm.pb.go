@@ -24,6 +24,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccessType defines how the addresses of a permission policy are applied
+type AccessType int32
+
+const (
+	// ACCESS_TYPE_OPEN lets anybody perform the operation, the addresses are ignored
+	AccessTypeOpen AccessType = 0
+	// ACCESS_TYPE_ALLOWLIST only lets the listed addresses perform the operation
+	AccessTypeAllowlist AccessType = 1
+	// ACCESS_TYPE_DENYLIST lets anybody but the listed addresses perform the operation
+	AccessTypeDenylist AccessType = 2
+)
+
+var AccessType_name = map[int32]string{
+	0: "ACCESS_TYPE_OPEN",
+	1: "ACCESS_TYPE_ALLOWLIST",
+	2: "ACCESS_TYPE_DENYLIST",
+}
+
+var AccessType_value = map[string]int32{
+	"ACCESS_TYPE_OPEN":      0,
+	"ACCESS_TYPE_ALLOWLIST": 1,
+	"ACCESS_TYPE_DENYLIST":  2,
+}
+
+func (x AccessType) String() string {
+	return proto.EnumName(AccessType_name, int32(x))
+}
+
+func (AccessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
@@ -42,6 +74,14 @@ type Params struct {
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
 	// header_hash_num is the number of header hash to persist.
 	HeaderHashNum uint64 `protobuf:"varint,7,opt,name=header_hash_num,json=headerHashNum,proto3" json:"header_hash_num,omitempty"`
+	// evm_chain_id is the EIP-155 chain id of the EVM, the chain id of the Cosmos chain
+	// identifier must match it. Zero derives it from the chain identifier.
+	EVMChainID uint64 `protobuf:"varint,8,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	// access_control defines the permission policies of the contract creations and calls
+	AccessControl AccessControl `protobuf:"bytes,9,opt,name=access_control,json=accessControl,proto3" json:"access_control" yaml:"access_control"`
+	// fee_denoms defines the alternative denominations accepted to pay the fees of the
+	// Cosmos and Ethereum transactions besides the evm_denom
+	FeeDenoms []FeeDenom `protobuf:"bytes,10,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -126,6 +166,189 @@ func (m *Params) GetHeaderHashNum() uint64 {
 	return 0
 }
 
+func (m *Params) GetEVMChainID() uint64 {
+	if m != nil {
+		return m.EVMChainID
+	}
+	return 0
+}
+
+func (m *Params) GetAccessControl() AccessControl {
+	if m != nil {
+		return m.AccessControl
+	}
+	return AccessControl{}
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+// FeeDenom defines an alternative fee denomination, e.g. the denom of an x/erc20
+// token pair or an IBC denom, with its conversion rate to the evm_denom.
+type FeeDenom struct {
+	// denom is the alternative fee denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of denom paid for one unit of evm_denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AccessControl defines the permission policies of the EVM, applied to the
+// transaction senders and to the contracts executing the CREATE, CREATE2 and
+// CALL opcodes.
+type AccessControl struct {
+	// create defines the permission policy of the contract creations
+	Create AccessPolicy `protobuf:"bytes,1,opt,name=create,proto3" json:"create" yaml:"create"`
+	// call defines the permission policy of the contract calls
+	Call AccessPolicy `protobuf:"bytes,2,opt,name=call,proto3" json:"call" yaml:"call"`
+}
+
+func (m *AccessControl) Reset()         { *m = AccessControl{} }
+func (m *AccessControl) String() string { return proto.CompactTextString(m) }
+func (*AccessControl) ProtoMessage()    {}
+func (*AccessControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *AccessControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl.Merge(m, src)
+}
+func (m *AccessControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl proto.InternalMessageInfo
+
+func (m *AccessControl) GetCreate() AccessPolicy {
+	if m != nil {
+		return m.Create
+	}
+	return AccessPolicy{}
+}
+
+func (m *AccessControl) GetCall() AccessPolicy {
+	if m != nil {
+		return m.Call
+	}
+	return AccessPolicy{}
+}
+
+// AccessPolicy defines the access type of an operation and the hex addresses it
+// applies to.
+type AccessPolicy struct {
+	// access_type defines if the addresses are allowed or denied
+	AccessType AccessType `protobuf:"varint,1,opt,name=access_type,json=accessType,proto3,enum=ethermint.evm.v1.AccessType" json:"access_type,omitempty" yaml:"access_type"`
+	// addresses defines the hex addresses of the allowlist or denylist
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *AccessPolicy) Reset()         { *m = AccessPolicy{} }
+func (m *AccessPolicy) String() string { return proto.CompactTextString(m) }
+func (*AccessPolicy) ProtoMessage()    {}
+func (*AccessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *AccessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessPolicy.Merge(m, src)
+}
+func (m *AccessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessPolicy proto.InternalMessageInfo
+
+func (m *AccessPolicy) GetAccessType() AccessType {
+	if m != nil {
+		return m.AccessType
+	}
+	return AccessTypeOpen
+}
+
+func (m *AccessPolicy) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -168,13 +391,17 @@ type ChainConfig struct {
 	ShanghaiBlock *cosmossdk_io_math.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
 	// cancun_block switch block (nil = no fork, 0 = already on cancun)
 	CancunBlock *cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// shanghai_time switch time (nil = no fork, 0 = already on shanghai). It enables PUSH0 (EIP-3855),
+	// the warm coinbase (EIP-3651) and the init code limit and gas of the contract creation txs
+	// (EIP-3860), the CREATE and CREATE2 instructions don't meter the init code.
+	ShanghaiTime *cosmossdk_io_math.Int `protobuf:"bytes,24,opt,name=shanghai_time,json=shanghaiTime,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_time,omitempty" yaml:"shanghai_time"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +456,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +513,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +589,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,7 +704,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +745,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +805,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +912,11 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 }
 
 func init() {
+	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "ethermint.evm.v1.FeeDenom")
+	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
+	proto.RegisterType((*AccessPolicy)(nil), "ethermint.evm.v1.AccessPolicy")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -698,110 +929,133 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xe3, 0xb8,
-	0x19, 0x4e, 0x62, 0x25, 0x91, 0x69, 0xc7, 0xd6, 0x30, 0x4e, 0xd6, 0x3b, 0x83, 0x46, 0xa9, 0x0e,
-	0x45, 0x8a, 0xee, 0x26, 0x93, 0x4c, 0xd3, 0x1d, 0xec, 0xa2, 0x2d, 0xc6, 0x33, 0xd9, 0x36, 0xe9,
-	0x74, 0x3a, 0xe0, 0x64, 0x51, 0xb4, 0x68, 0x21, 0xd0, 0x12, 0x57, 0xd6, 0x5a, 0x12, 0x0d, 0x92,
-	0x72, 0xec, 0xfe, 0x82, 0x02, 0xbd, 0xf4, 0x27, 0xec, 0x5f, 0xe8, 0xbf, 0x58, 0xf4, 0x34, 0xc7,
-	0xa2, 0x07, 0xa1, 0xc8, 0xdc, 0x72, 0xcc, 0xbd, 0x40, 0xc1, 0x0f, 0xcb, 0x1f, 0x49, 0xb3, 0x3e,
-	0x99, 0xcf, 0xfb, 0xf1, 0x3c, 0xe4, 0xcb, 0x57, 0x26, 0x09, 0x1e, 0x13, 0xd1, 0x23, 0x2c, 0x8d,
-	0x33, 0x71, 0x44, 0x86, 0xe9, 0xd1, 0xf0, 0x58, 0xfe, 0x1c, 0x0e, 0x18, 0x15, 0x14, 0x3a, 0xa5,
-	0xef, 0x50, 0x1a, 0x87, 0xc7, 0x8f, 0x5b, 0x11, 0x8d, 0xa8, 0x72, 0x1e, 0xc9, 0x91, 0x8e, 0xf3,
-	0xfe, 0x51, 0x01, 0x1b, 0x6f, 0x31, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x86, 0xa9, 0x1f, 0x92,
-	0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x75, 0x5b, 0xb8, 0xce, 0x18, 0xa7, 0xc9,
-	0xe7, 0x5e, 0xe9, 0xf2, 0x90, 0x4d, 0x86, 0xe9, 0x2b, 0x39, 0x84, 0x3f, 0x07, 0x5b, 0x24, 0xc3,
-	0xdd, 0x84, 0xf8, 0x01, 0x23, 0x58, 0x90, 0xf6, 0xda, 0xfe, 0xea, 0x81, 0xdd, 0x69, 0xdf, 0x16,
-	0x6e, 0xcb, 0xa4, 0xcd, 0xba, 0x3d, 0x54, 0xd7, 0xf8, 0xa5, 0x82, 0xf0, 0x33, 0x50, 0x9b, 0xf8,
-	0x71, 0x92, 0xb4, 0x2b, 0x2a, 0x79, 0xf7, 0xb6, 0x70, 0xe1, 0x7c, 0x32, 0x4e, 0x12, 0x0f, 0x01,
-	0x93, 0x8a, 0x93, 0x04, 0xbe, 0x00, 0x80, 0x8c, 0x04, 0xc3, 0x3e, 0x89, 0x07, 0xbc, 0x6d, 0xed,
-	0x57, 0x0e, 0x2a, 0x1d, 0xef, 0xba, 0x70, 0xab, 0x67, 0xd2, 0x7a, 0x76, 0xfe, 0x96, 0xdf, 0x16,
-	0xee, 0x23, 0x43, 0x52, 0x06, 0x7a, 0xa8, 0xaa, 0xc0, 0x59, 0x3c, 0xe0, 0xf0, 0xcf, 0xa0, 0x1e,
-	0xf4, 0x70, 0x9c, 0xf9, 0x01, 0xcd, 0xbe, 0x8e, 0xa3, 0xf6, 0xfa, 0xfe, 0xea, 0x41, 0xed, 0xe4,
-	0x07, 0x87, 0x8b, 0x75, 0x3b, 0x7c, 0x29, 0xa3, 0x5e, 0xaa, 0xa0, 0xce, 0x93, 0xef, 0x0a, 0x77,
-	0xe5, 0xb6, 0x70, 0xb7, 0x35, 0xf5, 0x2c, 0x81, 0x87, 0x6a, 0xc1, 0x34, 0x12, 0x9e, 0x80, 0x1d,
-	0x9c, 0x24, 0xf4, 0xca, 0xcf, 0x33, 0x59, 0x68, 0x12, 0x08, 0x12, 0xfa, 0x62, 0xc4, 0xdb, 0x1b,
-	0x72, 0x91, 0x68, 0x5b, 0x39, 0xbf, 0x9a, 0xfa, 0x2e, 0x47, 0x1c, 0xfe, 0x08, 0x34, 0x7b, 0x04,
-	0x87, 0x84, 0xf9, 0x3d, 0xcc, 0x7b, 0x7e, 0x96, 0xa7, 0xed, 0xcd, 0xfd, 0xd5, 0x03, 0x0b, 0x6d,
-	0x69, 0xf3, 0xaf, 0x31, 0xef, 0xbd, 0xc9, 0x53, 0xef, 0xbf, 0x0d, 0x50, 0x9b, 0x99, 0x15, 0xfc,
-	0x13, 0x68, 0xf6, 0x68, 0x4a, 0xb8, 0x20, 0x38, 0xf4, 0xbb, 0x09, 0x0d, 0xfa, 0x66, 0xfb, 0x9e,
-	0xfd, 0xbb, 0x70, 0x77, 0x02, 0xca, 0x53, 0xca, 0x79, 0xd8, 0x3f, 0x8c, 0xe9, 0x51, 0x8a, 0x45,
-	0xef, 0xf0, 0x3c, 0x13, 0xb7, 0x85, 0xbb, 0xab, 0xd7, 0xb0, 0x90, 0xe9, 0xa1, 0x46, 0x69, 0xe9,
-	0x48, 0x03, 0xec, 0x81, 0x46, 0x88, 0xa9, 0xff, 0x35, 0x65, 0x7d, 0x43, 0xbe, 0xa6, 0xc8, 0x3b,
-	0xff, 0x97, 0xfc, 0xba, 0x70, 0xeb, 0xaf, 0x5e, 0xfc, 0xee, 0x4b, 0xca, 0xfa, 0x8a, 0xe2, 0xb6,
-	0x70, 0x77, 0xb4, 0xd8, 0x3c, 0x91, 0x87, 0xea, 0x21, 0xa6, 0x65, 0x18, 0xfc, 0x3d, 0x70, 0xca,
-	0x00, 0x9e, 0x0f, 0x06, 0x94, 0x09, 0xd3, 0x13, 0x9f, 0x5e, 0x17, 0x6e, 0xc3, 0x50, 0xbe, 0xd3,
-	0x9e, 0xdb, 0xc2, 0xfd, 0x68, 0x81, 0xd4, 0xe4, 0x78, 0xa8, 0x61, 0x68, 0x4d, 0x28, 0xec, 0x82,
-	0x3a, 0x89, 0x07, 0xc7, 0xa7, 0x4f, 0xcd, 0x02, 0x2c, 0xb5, 0x80, 0x5f, 0x3e, 0xb4, 0x80, 0xda,
-	0xd9, 0xf9, 0xdb, 0xe3, 0xd3, 0xa7, 0x93, 0xf9, 0x9b, 0x0d, 0x9f, 0x65, 0xf1, 0x50, 0x4d, 0x43,
-	0x3d, 0xf9, 0x73, 0x60, 0xa0, 0xda, 0x3c, 0xd5, 0x4e, 0xd5, 0xce, 0xc1, 0x75, 0xe1, 0x02, 0xcd,
-	0x24, 0x37, 0x6f, 0x5a, 0xf5, 0xee, 0xf8, 0x2f, 0x38, 0x13, 0x71, 0x9e, 0x4e, 0xb8, 0x80, 0x4e,
-	0x96, 0x51, 0xe5, 0x74, 0x4f, 0xcd, 0x74, 0x37, 0x96, 0x9d, 0xee, 0xe9, 0x7d, 0xd3, 0x3d, 0x9d,
-	0x9f, 0xae, 0x8e, 0x29, 0x35, 0x9e, 0x1b, 0x8d, 0xcd, 0x65, 0x35, 0x9e, 0xdf, 0xa7, 0xf1, 0x7c,
-	0x5e, 0x43, 0xc7, 0xc8, 0xbe, 0x5c, 0x58, 0x67, 0xdb, 0x5e, 0xba, 0x2f, 0xef, 0x54, 0xa8, 0x51,
-	0x5a, 0x34, 0x7b, 0x1f, 0xb4, 0x02, 0x9a, 0x71, 0x21, 0x6d, 0x19, 0x1d, 0x24, 0xc4, 0x48, 0x54,
-	0x95, 0xc4, 0xf3, 0x87, 0x24, 0x9e, 0x98, 0xcf, 0xf7, 0x9e, 0x74, 0x0f, 0x6d, 0xcf, 0x9b, 0xb5,
-	0x98, 0x0f, 0x9c, 0x01, 0x11, 0x84, 0xf1, 0x6e, 0xce, 0x22, 0x23, 0x04, 0x94, 0xd0, 0x4f, 0x1f,
-	0x12, 0x32, 0x1d, 0xba, 0x98, 0xea, 0xa1, 0xe6, 0xd4, 0xa4, 0x05, 0xfe, 0x00, 0x1a, 0xb1, 0x54,
-	0xed, 0xe6, 0x89, 0xa1, 0xaf, 0x29, 0xfa, 0x93, 0x87, 0xe8, 0xcd, 0x57, 0x35, 0x9f, 0xe8, 0xa1,
-	0xad, 0x89, 0x41, 0x53, 0x87, 0x00, 0xa6, 0x79, 0xcc, 0xfc, 0x28, 0xc1, 0x41, 0x4c, 0x98, 0xa1,
-	0xaf, 0x2b, 0xfa, 0x9f, 0x3d, 0x44, 0xff, 0xb1, 0xa6, 0xbf, 0x9b, 0xec, 0x21, 0x47, 0x1a, 0x7f,
-	0xa5, 0x6d, 0x5a, 0xe5, 0x1d, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0xf0, 0x6f, 0x29, 0xfe, 0xa7,
-	0x0f, 0xf1, 0x9b, 0x0e, 0x9a, 0x4d, 0xf3, 0x50, 0x4d, 0xc3, 0x92, 0x34, 0xa1, 0x59, 0x48, 0x27,
-	0xa4, 0x8f, 0x96, 0x26, 0x9d, 0x4d, 0xf3, 0x50, 0x4d, 0x43, 0x4d, 0x1a, 0x81, 0x6d, 0xcc, 0x18,
-	0xbd, 0x5a, 0x28, 0x08, 0x54, 0xdc, 0x9f, 0x3d, 0xc4, 0xfd, 0x58, 0x73, 0xdf, 0x93, 0xed, 0xa1,
-	0x47, 0xca, 0x3a, 0x57, 0x92, 0x10, 0xc0, 0x88, 0xe1, 0xf1, 0x82, 0x4e, 0x6b, 0xe9, 0xc2, 0xdf,
-	0x4d, 0xf6, 0x90, 0x23, 0x8d, 0x73, 0x2a, 0xdf, 0x80, 0x56, 0x4a, 0x58, 0x44, 0xfc, 0x8c, 0x08,
-	0x3e, 0x48, 0x62, 0x61, 0x74, 0x76, 0x96, 0xfe, 0x0e, 0xee, 0x4b, 0xf7, 0x10, 0x54, 0xe6, 0x37,
-	0xc6, 0x5a, 0x76, 0x29, 0xef, 0xe1, 0x2c, 0xea, 0xe1, 0xd8, 0xa8, 0xec, 0x2e, 0xdd, 0xa5, 0xf3,
-	0x89, 0x1e, 0xda, 0x9a, 0x18, 0xca, 0xad, 0x0e, 0x70, 0x16, 0xe4, 0x93, 0xad, 0xfe, 0x68, 0xe9,
-	0xad, 0x9e, 0x4d, 0x93, 0xa7, 0xb0, 0x82, 0x8a, 0xf4, 0xc2, 0xb2, 0x1b, 0x4e, 0xf3, 0xc2, 0xb2,
-	0x9b, 0x8e, 0x73, 0x61, 0xd9, 0x8e, 0xf3, 0xe8, 0xc2, 0xb2, 0xb7, 0x9d, 0x16, 0xda, 0x1a, 0xd3,
-	0x84, 0xfa, 0xc3, 0x67, 0x3a, 0x09, 0xd5, 0xc8, 0x15, 0xe6, 0xe6, 0x8f, 0x06, 0x35, 0x02, 0x2c,
-	0x70, 0x32, 0xe6, 0xa6, 0x10, 0xc8, 0xd1, 0xe5, 0x99, 0x39, 0xb6, 0x8e, 0xc0, 0xfa, 0x3b, 0x21,
-	0xef, 0x2f, 0x0e, 0xa8, 0xf4, 0xc9, 0x58, 0x1f, 0xb6, 0x48, 0x0e, 0x61, 0x0b, 0xac, 0x0f, 0x71,
-	0x92, 0xeb, 0x8b, 0x50, 0x15, 0x69, 0xe0, 0xbd, 0x05, 0xcd, 0x4b, 0x86, 0x33, 0x8e, 0x03, 0x11,
-	0xd3, 0xec, 0x35, 0x8d, 0x38, 0x84, 0xc0, 0x52, 0xe7, 0x84, 0xce, 0x55, 0x63, 0xf8, 0x63, 0x60,
-	0x25, 0x34, 0xe2, 0xed, 0xb5, 0xfd, 0xca, 0x41, 0xed, 0x64, 0xe7, 0xee, 0x55, 0xe4, 0x35, 0x8d,
-	0x90, 0x0a, 0xf1, 0xfe, 0xb9, 0x06, 0x2a, 0xaf, 0x69, 0x04, 0xdb, 0x60, 0x13, 0x87, 0x21, 0x23,
-	0x9c, 0x1b, 0xa6, 0x09, 0x84, 0xbb, 0x60, 0x43, 0xd0, 0x41, 0x1c, 0x68, 0xba, 0x2a, 0x32, 0x48,
-	0x0a, 0x87, 0x58, 0x60, 0x75, 0xb0, 0xd6, 0x91, 0x1a, 0xc3, 0x13, 0x50, 0x57, 0x2b, 0x93, 0x57,
-	0x8e, 0x2e, 0x61, 0xea, 0x7c, 0xb4, 0x3a, 0xcd, 0x9b, 0xc2, 0xad, 0x29, 0xfb, 0x1b, 0x65, 0x46,
-	0xb3, 0x00, 0x7e, 0x02, 0x36, 0xc5, 0x68, 0xf6, 0xac, 0xdb, 0xbe, 0x29, 0xdc, 0xa6, 0x98, 0x2e,
-	0x53, 0x1e, 0x65, 0x68, 0x43, 0x8c, 0xd4, 0x91, 0x76, 0x04, 0x6c, 0x31, 0xf2, 0xe3, 0x2c, 0x24,
-	0x23, 0x75, 0x9c, 0x59, 0x9d, 0xd6, 0x4d, 0xe1, 0x3a, 0x33, 0xe1, 0xe7, 0xd2, 0x87, 0x36, 0xc5,
-	0x48, 0x0d, 0xe0, 0x27, 0x00, 0xe8, 0x29, 0x29, 0x05, 0x7d, 0x3a, 0x6d, 0xdd, 0x14, 0x6e, 0x55,
-	0x59, 0x15, 0xf7, 0x74, 0x08, 0x3d, 0xb0, 0xae, 0xb9, 0x6d, 0xc5, 0x5d, 0xbf, 0x29, 0x5c, 0x3b,
-	0xa1, 0x91, 0xe6, 0xd4, 0x2e, 0x59, 0x2a, 0x46, 0x52, 0x3a, 0x24, 0xa1, 0x3a, 0x22, 0x6c, 0x34,
-	0x81, 0xde, 0xdf, 0xd6, 0x80, 0x7d, 0x39, 0x42, 0x84, 0xe7, 0x89, 0x80, 0x5f, 0x02, 0x27, 0xa0,
-	0x99, 0x60, 0x38, 0x10, 0xfe, 0x5c, 0x69, 0x3b, 0x4f, 0xa6, 0x7f, 0xe8, 0x8b, 0x11, 0x1e, 0x6a,
-	0x4e, 0x4c, 0x2f, 0x4c, 0xfd, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4e, 0xa8, 0x23, 0x0d,
-	0x20, 0x52, 0x55, 0x53, 0xbb, 0x5c, 0x51, 0x17, 0xce, 0x1f, 0xde, 0xdd, 0xe5, 0x85, 0x56, 0xe9,
-	0xec, 0x9a, 0x4b, 0x67, 0x43, 0x6b, 0x9b, 0x7c, 0x4f, 0xd6, 0x56, 0xb5, 0x92, 0x03, 0x2a, 0x8c,
-	0x08, 0xb5, 0x69, 0x75, 0x24, 0x87, 0xf0, 0x31, 0xb0, 0x19, 0x19, 0x12, 0x26, 0x48, 0xa8, 0x36,
-	0xc7, 0x46, 0x25, 0x86, 0x1f, 0x03, 0x3b, 0xc2, 0xdc, 0xcf, 0x39, 0x09, 0xf5, 0x4e, 0xa0, 0xcd,
-	0x08, 0xf3, 0xaf, 0x38, 0x09, 0x3f, 0xb7, 0xfe, 0xfa, 0xad, 0xbb, 0xe2, 0x61, 0x50, 0x7b, 0x11,
-	0x04, 0x84, 0xf3, 0xcb, 0x7c, 0x90, 0x90, 0x07, 0x3a, 0xec, 0x04, 0xd4, 0xb9, 0xa0, 0x0c, 0x47,
-	0xc4, 0xef, 0x93, 0xb1, 0xe9, 0x33, 0xdd, 0x35, 0xc6, 0xfe, 0x1b, 0x32, 0xe6, 0x68, 0x16, 0x18,
-	0x89, 0x6f, 0x2d, 0x50, 0xbb, 0x64, 0x38, 0x20, 0xe6, 0x02, 0x2b, 0x7b, 0x55, 0x42, 0x66, 0x24,
-	0x0c, 0x92, 0xda, 0x22, 0x4e, 0x09, 0xcd, 0x85, 0xf9, 0x9e, 0x26, 0x50, 0x66, 0x30, 0x42, 0x46,
-	0x24, 0x50, 0x65, 0xb4, 0x90, 0x41, 0xf0, 0x14, 0x6c, 0x85, 0x31, 0x57, 0xaf, 0x06, 0x2e, 0x70,
-	0xd0, 0xd7, 0xcb, 0xef, 0x38, 0x37, 0x85, 0x5b, 0x37, 0x8e, 0x77, 0xd2, 0x8e, 0xe6, 0x10, 0xfc,
-	0x02, 0x34, 0xa7, 0x69, 0x6a, 0xb6, 0xfa, 0x9e, 0xde, 0x81, 0x37, 0x85, 0xdb, 0x28, 0x43, 0x95,
-	0x07, 0x2d, 0x60, 0xb9, 0xd3, 0x21, 0xe9, 0xe6, 0x91, 0x6a, 0x3e, 0x1b, 0x69, 0x20, 0xad, 0x49,
-	0x9c, 0xc6, 0x42, 0x35, 0xdb, 0x3a, 0xd2, 0x00, 0x7e, 0x01, 0xaa, 0x74, 0x48, 0x18, 0x8b, 0x43,
-	0xc2, 0xd5, 0x05, 0xe2, 0xfb, 0x9e, 0x1c, 0x68, 0x1a, 0x2f, 0x17, 0x67, 0x5e, 0x44, 0x29, 0x49,
-	0x29, 0x1b, 0xab, 0x2b, 0x82, 0x59, 0x9c, 0x76, 0xfc, 0x56, 0xd9, 0xd1, 0x1c, 0x82, 0x1d, 0x00,
-	0x4d, 0x1a, 0x23, 0x22, 0x67, 0x99, 0xaf, 0xbe, 0xff, 0xba, 0xca, 0x55, 0x5f, 0xa1, 0xf6, 0x22,
-	0xe5, 0x7c, 0x85, 0x05, 0x46, 0x77, 0x2c, 0xf0, 0x17, 0x00, 0xea, 0x3d, 0xf1, 0xbf, 0xe1, 0xb4,
-	0x7c, 0x33, 0xe9, 0x33, 0x5e, 0xe9, 0x6b, 0xaf, 0x99, 0xb3, 0xa3, 0xd1, 0x05, 0xa7, 0x66, 0x15,
-	0x17, 0x96, 0x6d, 0x39, 0xeb, 0x17, 0x96, 0xbd, 0xe9, 0xd8, 0x65, 0xfd, 0xcc, 0x2a, 0xd0, 0xf6,
-	0x04, 0xcf, 0x4c, 0xaf, 0x73, 0xf6, 0xdd, 0xf5, 0xde, 0xea, 0xfb, 0xeb, 0xbd, 0xd5, 0xff, 0x5c,
-	0xef, 0xad, 0xfe, 0xfd, 0xc3, 0xde, 0xca, 0xfb, 0x0f, 0x7b, 0x2b, 0xff, 0xfa, 0xb0, 0xb7, 0xf2,
-	0xc7, 0x9f, 0x44, 0xb1, 0xe8, 0xe5, 0xdd, 0xc3, 0x80, 0xa6, 0x47, 0x09, 0xed, 0xe3, 0x4f, 0x33,
-	0x22, 0xae, 0x28, 0xeb, 0x2b, 0x20, 0x1f, 0xc1, 0x23, 0xf5, 0x1a, 0x16, 0xe3, 0x01, 0xe1, 0xdd,
-	0x0d, 0xf5, 0xca, 0x7d, 0xf6, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x61, 0xff, 0xfe, 0x2b,
-	0x0f, 0x00, 0x00,
+	// 2001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0xb7, 0x6c, 0xd9, 0x96, 0x46, 0xff, 0x98, 0xb1, 0xec, 0x28, 0x4e, 0xd7, 0x74, 0x59, 0xa0,
+	0x70, 0xdb, 0x5d, 0x3b, 0x76, 0xea, 0x26, 0xd8, 0x45, 0x5b, 0x98, 0xb6, 0xb3, 0xb5, 0xeb, 0x24,
+	0xc6, 0xd8, 0xd9, 0x45, 0x8a, 0x16, 0xc4, 0x88, 0x9c, 0x50, 0x5c, 0x93, 0x1c, 0x81, 0x33, 0x52,
+	0xa4, 0x7e, 0x82, 0x45, 0x7a, 0xe9, 0xb5, 0x87, 0x00, 0x41, 0x7b, 0xe8, 0x57, 0x59, 0xf4, 0xb4,
+	0x97, 0x02, 0xc5, 0x1e, 0x88, 0xc2, 0xb9, 0xf9, 0xe8, 0x4f, 0xb0, 0x98, 0x3f, 0x92, 0x28, 0x39,
+	0x31, 0x74, 0xd2, 0xbc, 0x37, 0xef, 0xf7, 0x7b, 0xf3, 0xde, 0xbc, 0x99, 0x79, 0x14, 0x58, 0x25,
+	0xbc, 0x45, 0x92, 0x28, 0x88, 0xf9, 0x16, 0xe9, 0x46, 0x5b, 0xdd, 0x6d, 0xf1, 0xb3, 0xd9, 0x4e,
+	0x28, 0xa7, 0xd0, 0x18, 0xce, 0x6d, 0x0a, 0x65, 0x77, 0x7b, 0xb5, 0xee, 0x53, 0x9f, 0xca, 0xc9,
+	0x2d, 0x31, 0x52, 0x76, 0xd6, 0xbb, 0x79, 0xb0, 0x70, 0x8a, 0x13, 0x1c, 0x31, 0xb8, 0x0d, 0x8a,
+	0xa4, 0x1b, 0x39, 0x1e, 0x89, 0x69, 0xd4, 0xc8, 0xad, 0xe7, 0x36, 0x8a, 0x76, 0xfd, 0x3a, 0x35,
+	0x8d, 0x3e, 0x8e, 0xc2, 0xcf, 0xad, 0xe1, 0x94, 0x85, 0x0a, 0xa4, 0x1b, 0x1d, 0x88, 0x21, 0xfc,
+	0x2d, 0xa8, 0x90, 0x18, 0x37, 0x43, 0xe2, 0xb8, 0x09, 0xc1, 0x9c, 0x34, 0x66, 0xd7, 0x73, 0x1b,
+	0x05, 0xbb, 0x71, 0x9d, 0x9a, 0x75, 0x0d, 0xcb, 0x4e, 0x5b, 0xa8, 0xac, 0xe4, 0x7d, 0x29, 0xc2,
+	0x47, 0xa0, 0x34, 0x98, 0xc7, 0x61, 0xd8, 0x98, 0x93, 0xe0, 0x95, 0xeb, 0xd4, 0x84, 0xe3, 0x60,
+	0x1c, 0x86, 0x16, 0x02, 0x1a, 0x8a, 0xc3, 0x10, 0xee, 0x01, 0x40, 0x7a, 0x3c, 0xc1, 0x0e, 0x09,
+	0xda, 0xac, 0x91, 0x5f, 0x9f, 0xdb, 0x98, 0xb3, 0xad, 0xcb, 0xd4, 0x2c, 0x1e, 0x0a, 0xed, 0xe1,
+	0xd1, 0x29, 0xbb, 0x4e, 0xcd, 0x3b, 0x9a, 0x64, 0x68, 0x68, 0xa1, 0xa2, 0x14, 0x0e, 0x83, 0x36,
+	0x83, 0x7f, 0x01, 0x65, 0xb7, 0x85, 0x83, 0xd8, 0x71, 0x69, 0xfc, 0x2a, 0xf0, 0x1b, 0xf3, 0xeb,
+	0xb9, 0x8d, 0xd2, 0xce, 0x27, 0x9b, 0x93, 0x79, 0xdb, 0xdc, 0x17, 0x56, 0xfb, 0xd2, 0xc8, 0xbe,
+	0xff, 0x5d, 0x6a, 0xce, 0x5c, 0xa7, 0xe6, 0x92, 0xa2, 0xce, 0x12, 0x58, 0xa8, 0xe4, 0x8e, 0x2c,
+	0xe1, 0x0e, 0x58, 0xc6, 0x61, 0x48, 0x5f, 0x3b, 0x9d, 0x58, 0x24, 0x9a, 0xb8, 0x9c, 0x78, 0x0e,
+	0xef, 0xb1, 0xc6, 0x82, 0x08, 0x12, 0x2d, 0xc9, 0xc9, 0x17, 0xa3, 0xb9, 0xf3, 0x1e, 0x83, 0x3f,
+	0x07, 0xb5, 0x16, 0xc1, 0x1e, 0x49, 0x9c, 0x16, 0x66, 0x2d, 0x27, 0xee, 0x44, 0x8d, 0xc5, 0xf5,
+	0xdc, 0x46, 0x1e, 0x55, 0x94, 0xfa, 0x0f, 0x98, 0xb5, 0x9e, 0x75, 0x22, 0xf8, 0x00, 0x94, 0xc5,
+	0x6e, 0x28, 0xef, 0x81, 0xd7, 0x28, 0x08, 0x23, 0xbb, 0x7a, 0x99, 0x9a, 0xe0, 0xf0, 0xab, 0xa7,
+	0x72, 0xbd, 0x47, 0x07, 0x08, 0x90, 0x6e, 0xa4, 0xc6, 0x1e, 0x24, 0xa0, 0x8a, 0x5d, 0x97, 0x30,
+	0x26, 0x16, 0xcb, 0x13, 0x1a, 0x36, 0x8a, 0x32, 0x5c, 0xf3, 0x66, 0xb8, 0x7b, 0xd2, 0x6e, 0x5f,
+	0x99, 0xd9, 0x9f, 0xe8, 0x80, 0x97, 0x55, 0xc0, 0xe3, 0x24, 0x16, 0xaa, 0xe0, 0xac, 0x35, 0x3c,
+	0x07, 0xe0, 0x15, 0x21, 0xaa, 0x4c, 0x58, 0x03, 0xac, 0xcf, 0x6d, 0x94, 0x76, 0x56, 0x6f, 0xba,
+	0x78, 0x42, 0x88, 0x2c, 0x1f, 0xfb, 0x9e, 0x66, 0xd7, 0x3b, 0x35, 0xc2, 0x5a, 0xa8, 0xf8, 0x4a,
+	0x1b, 0x31, 0xeb, 0x25, 0x28, 0x0c, 0x10, 0xb0, 0x0e, 0xe6, 0x33, 0xf5, 0x89, 0x94, 0x00, 0x1f,
+	0x81, 0x7c, 0x32, 0xa8, 0xbe, 0xa2, 0xfd, 0x33, 0xc1, 0xfa, 0x43, 0x6a, 0xde, 0x77, 0x29, 0x8b,
+	0x28, 0x63, 0xde, 0xc5, 0x66, 0x40, 0xb7, 0x22, 0xcc, 0x5b, 0x9b, 0x27, 0xc4, 0xc7, 0x6e, 0xff,
+	0x80, 0xb8, 0x48, 0x02, 0xac, 0x7f, 0xe7, 0x40, 0x65, 0x2c, 0x60, 0xf8, 0x14, 0x2c, 0xe8, 0x52,
+	0xce, 0xc9, 0x0c, 0xad, 0x7d, 0x2c, 0x43, 0xa7, 0x34, 0x0c, 0xdc, 0xbe, 0xbd, 0xac, 0x43, 0xa8,
+	0xe8, 0x8a, 0xd0, 0x75, 0xae, 0x49, 0xe0, 0x97, 0x20, 0x2f, 0x4b, 0x7b, 0x76, 0x2a, 0xb2, 0x25,
+	0x4d, 0x56, 0xd2, 0x64, 0xb2, 0xee, 0x25, 0x81, 0xf5, 0x8f, 0x1c, 0x28, 0x67, 0x6d, 0xe1, 0x0b,
+	0x50, 0xd2, 0xbb, 0xc1, 0xfb, 0x6d, 0xb5, 0xda, 0xea, 0xce, 0x4f, 0x3e, 0xe6, 0xe0, 0xbc, 0xdf,
+	0x26, 0xd9, 0x93, 0x95, 0x81, 0x5a, 0x08, 0xe0, 0xa1, 0x0d, 0xdc, 0x01, 0x45, 0xec, 0x79, 0x09,
+	0x61, 0x8c, 0xb0, 0xc6, 0xec, 0xfa, 0xdc, 0xf8, 0x25, 0x30, 0x9c, 0xb2, 0xd0, 0xc8, 0xcc, 0xfa,
+	0x6f, 0x0d, 0x94, 0x32, 0xa7, 0x04, 0xfe, 0x19, 0xd4, 0x5a, 0x34, 0x22, 0x8c, 0x13, 0xec, 0x39,
+	0xcd, 0x90, 0xba, 0x17, 0xfa, 0x3a, 0x79, 0xf8, 0x43, 0x6a, 0x2e, 0xdf, 0xdc, 0x95, 0xa3, 0x98,
+	0x5f, 0xa7, 0xe6, 0x8a, 0x72, 0x31, 0x81, 0xb4, 0x50, 0x75, 0xa8, 0xb1, 0x85, 0x02, 0xb6, 0x40,
+	0xd5, 0xc3, 0xd4, 0x79, 0x45, 0x93, 0x0b, 0x4d, 0xae, 0xb6, 0xdd, 0xfe, 0x28, 0xf9, 0x65, 0x6a,
+	0x96, 0x0f, 0xf6, 0x9e, 0x3f, 0xa1, 0xc9, 0x85, 0xa4, 0x18, 0xd5, 0xf3, 0x38, 0x91, 0x85, 0xca,
+	0x1e, 0xa6, 0x43, 0x33, 0xf8, 0x35, 0x30, 0x86, 0x06, 0xac, 0xd3, 0x6e, 0xd3, 0x84, 0xeb, 0x3b,
+	0xea, 0xb3, 0xcb, 0xd4, 0xac, 0x6a, 0xca, 0x33, 0x35, 0x73, 0x9d, 0x9a, 0x77, 0x27, 0x48, 0x35,
+	0xc6, 0x42, 0x55, 0x4d, 0xab, 0x4d, 0x61, 0x13, 0x94, 0x49, 0xd0, 0xde, 0xde, 0x7d, 0xa0, 0x03,
+	0xc8, 0xcb, 0x00, 0x7e, 0x7f, 0x5b, 0x00, 0xa5, 0xc3, 0xa3, 0xd3, 0xed, 0xdd, 0x07, 0x83, 0xf5,
+	0xeb, 0x0b, 0x28, 0xcb, 0x62, 0xa1, 0x92, 0x12, 0xd5, 0xe2, 0x8f, 0x80, 0x16, 0xe5, 0x65, 0x22,
+	0xaf, 0xb7, 0xa2, 0xbd, 0x21, 0xef, 0x08, 0xc9, 0x24, 0x2e, 0x93, 0x51, 0xd6, 0x9b, 0xfd, 0xbf,
+	0xe2, 0x98, 0x07, 0x9d, 0x68, 0xc0, 0x05, 0x14, 0x58, 0x58, 0x0d, 0x97, 0xbb, 0xab, 0x97, 0xbb,
+	0x30, 0xed, 0x72, 0x77, 0x3f, 0xb4, 0xdc, 0xdd, 0xf1, 0xe5, 0x2a, 0x9b, 0xa1, 0x8f, 0xc7, 0xda,
+	0xc7, 0xe2, 0xb4, 0x3e, 0x1e, 0x7f, 0xc8, 0xc7, 0xe3, 0x71, 0x1f, 0xca, 0x46, 0xd4, 0xe5, 0x44,
+	0x9c, 0x8d, 0xc2, 0xd4, 0x75, 0x79, 0x23, 0x43, 0xd5, 0xa1, 0x46, 0xb1, 0x5f, 0x80, 0xba, 0x4b,
+	0x63, 0xc6, 0x85, 0x2e, 0xa6, 0xed, 0x90, 0x68, 0x17, 0x45, 0xe9, 0xe2, 0xf1, 0x6d, 0x2e, 0xee,
+	0xeb, 0xf3, 0xfe, 0x01, 0xb8, 0x85, 0x96, 0xc6, 0xd5, 0xca, 0x99, 0x03, 0x8c, 0x36, 0xe1, 0x24,
+	0x61, 0xcd, 0x4e, 0xe2, 0x6b, 0x47, 0x40, 0x3a, 0xfa, 0xf5, 0x6d, 0x8e, 0x74, 0x85, 0x4e, 0x42,
+	0x2d, 0x54, 0x1b, 0xa9, 0x94, 0x83, 0x97, 0xa0, 0x1a, 0x08, 0xaf, 0xcd, 0x4e, 0xa8, 0xe9, 0x4b,
+	0x92, 0x7e, 0xe7, 0x36, 0x7a, 0x7d, 0xaa, 0xc6, 0x81, 0x16, 0xaa, 0x0c, 0x14, 0x8a, 0xda, 0x03,
+	0x30, 0xea, 0x04, 0x89, 0xe3, 0x87, 0xd8, 0x0d, 0x48, 0xa2, 0xe9, 0xcb, 0x92, 0xfe, 0x37, 0xb7,
+	0xd1, 0xdf, 0x53, 0xf4, 0x37, 0xc1, 0x16, 0x32, 0x84, 0xf2, 0x4b, 0xa5, 0x53, 0x5e, 0xce, 0x40,
+	0xb9, 0x49, 0x92, 0x30, 0x88, 0x35, 0x7f, 0x45, 0xf2, 0x3f, 0xb8, 0x8d, 0x5f, 0x57, 0x50, 0x16,
+	0x66, 0xa1, 0x92, 0x12, 0x87, 0xa4, 0x21, 0x8d, 0x3d, 0x3a, 0x20, 0xbd, 0x33, 0x35, 0x69, 0x16,
+	0x66, 0xa1, 0x92, 0x12, 0x15, 0xa9, 0x0f, 0x96, 0x70, 0x92, 0xd0, 0xd7, 0x13, 0x09, 0x81, 0x92,
+	0xfb, 0xd1, 0x6d, 0xdc, 0xab, 0x8a, 0xfb, 0x03, 0x68, 0x0b, 0xdd, 0x91, 0xda, 0xb1, 0x94, 0x78,
+	0x00, 0xfa, 0x09, 0xee, 0x4f, 0xf8, 0xa9, 0x4f, 0x9d, 0xf8, 0x9b, 0x60, 0x0b, 0x19, 0x42, 0x39,
+	0xe6, 0xe5, 0x1b, 0x50, 0x8f, 0x48, 0xe2, 0x13, 0x27, 0x26, 0x9c, 0xb5, 0xc3, 0x80, 0x6b, 0x3f,
+	0xcb, 0x53, 0x9f, 0x83, 0x0f, 0xc1, 0x2d, 0x04, 0xa5, 0xfa, 0x99, 0xd6, 0x0e, 0xab, 0x94, 0xb5,
+	0x70, 0xec, 0xb7, 0x70, 0xa0, 0xbd, 0xac, 0x4c, 0x5d, 0xa5, 0xe3, 0x40, 0x0b, 0x55, 0x06, 0x8a,
+	0xe1, 0x56, 0xbb, 0x38, 0x76, 0x3b, 0x83, 0xad, 0xbe, 0x3b, 0xf5, 0x56, 0x67, 0x61, 0xa2, 0x2b,
+	0x94, 0xa2, 0x22, 0xfd, 0x0a, 0x0c, 0xbd, 0x38, 0x3c, 0x88, 0x48, 0xa3, 0x21, 0x59, 0xb7, 0x6f,
+	0x63, 0xad, 0x4f, 0x2c, 0x57, 0xe0, 0x2c, 0x54, 0x1e, 0xc8, 0xe7, 0x41, 0x44, 0x8e, 0xf3, 0x85,
+	0xaa, 0x51, 0x3b, 0xce, 0x17, 0x6a, 0x86, 0x71, 0x9c, 0x2f, 0x18, 0xc6, 0x9d, 0xe3, 0x7c, 0x61,
+	0xc9, 0xa8, 0xa3, 0x4a, 0x9f, 0x86, 0xd4, 0xe9, 0x3e, 0x54, 0x8b, 0x41, 0x25, 0xf2, 0x1a, 0x33,
+	0x7d, 0x81, 0xa1, 0xaa, 0x8b, 0x39, 0x0e, 0xfb, 0x4c, 0x27, 0x18, 0x19, 0x2a, 0xed, 0x99, 0xe7,
+	0x70, 0x0b, 0xcc, 0x9f, 0x71, 0xd1, 0xc5, 0x18, 0x60, 0xee, 0x82, 0xf4, 0x75, 0xcf, 0x25, 0x86,
+	0xa2, 0x0f, 0xeb, 0xe2, 0xb0, 0xa3, 0x5b, 0x2e, 0xa4, 0x04, 0xeb, 0x14, 0xd4, 0xce, 0x13, 0x1c,
+	0x33, 0xec, 0xf2, 0x80, 0xc6, 0x27, 0xd4, 0x67, 0x10, 0x82, 0xbc, 0x7c, 0x7f, 0x14, 0x56, 0x8e,
+	0xe1, 0x2f, 0x40, 0x3e, 0xa4, 0xbe, 0x6a, 0x2f, 0x4a, 0x3b, 0xcb, 0x37, 0x7b, 0x96, 0x13, 0xea,
+	0x23, 0x69, 0x62, 0xfd, 0x67, 0x16, 0xcc, 0x9d, 0x50, 0x1f, 0x36, 0xc0, 0xa2, 0xee, 0x37, 0x34,
+	0xd3, 0x40, 0x84, 0x2b, 0x60, 0x81, 0xd3, 0x76, 0xe0, 0xea, 0x6e, 0x05, 0x69, 0x49, 0x38, 0xf6,
+	0x30, 0xc7, 0xf2, 0xc1, 0x2e, 0x23, 0x39, 0x86, 0x3b, 0xa0, 0x2c, 0x23, 0x13, 0xad, 0x75, 0x93,
+	0x24, 0xf2, 0xdd, 0xcd, 0xdb, 0xb5, 0xab, 0xd4, 0x2c, 0x49, 0xfd, 0x33, 0xa9, 0x46, 0x59, 0x01,
+	0x7e, 0x0a, 0x16, 0x79, 0x2f, 0xfb, 0x86, 0x2e, 0x5d, 0xa5, 0x66, 0x8d, 0x8f, 0xc2, 0x14, 0x4f,
+	0x24, 0x5a, 0xe0, 0x3d, 0xf1, 0x0b, 0xb7, 0x40, 0x81, 0xf7, 0x9c, 0x20, 0xf6, 0x48, 0x4f, 0x3e,
+	0x93, 0x79, 0xbb, 0x7e, 0x95, 0x9a, 0x46, 0xc6, 0xfc, 0x48, 0xcc, 0xa1, 0x45, 0xde, 0x93, 0x03,
+	0xf8, 0x29, 0x00, 0x6a, 0x49, 0xd2, 0x83, 0x7a, 0xf5, 0x2a, 0x57, 0xa9, 0x59, 0x94, 0x5a, 0xc9,
+	0x3d, 0x1a, 0x42, 0x0b, 0xcc, 0x2b, 0x6e, 0xd5, 0xf2, 0x97, 0xaf, 0x52, 0xb3, 0x10, 0x52, 0x5f,
+	0x71, 0xaa, 0x29, 0x91, 0xaa, 0x84, 0x44, 0xb4, 0x4b, 0x3c, 0xf9, 0xf4, 0x14, 0xd0, 0x40, 0xb4,
+	0xfe, 0x36, 0x0b, 0x0a, 0xe7, 0x3d, 0x44, 0x58, 0x27, 0xe4, 0xf0, 0x09, 0x30, 0x64, 0x1b, 0x8f,
+	0x5d, 0xee, 0x8c, 0xa5, 0xd6, 0xbe, 0x3f, 0x7a, 0x28, 0x26, 0x2d, 0x2c, 0x54, 0x1b, 0xa8, 0xf6,
+	0x74, 0xfe, 0xeb, 0x60, 0xbe, 0x19, 0x52, 0x1a, 0xc9, 0x4a, 0x28, 0x23, 0x25, 0x40, 0x24, 0xb3,
+	0x26, 0x77, 0x79, 0x4e, 0xb6, 0xbe, 0x3f, 0xbd, 0xb9, 0xcb, 0x13, 0xa5, 0x62, 0xaf, 0xe8, 0xee,
+	0xb7, 0xaa, 0x7c, 0x6b, 0xbc, 0x25, 0x72, 0x2b, 0x4b, 0xc9, 0x00, 0x73, 0x09, 0xe1, 0x72, 0xd3,
+	0xca, 0x48, 0x0c, 0xe1, 0x2a, 0x28, 0x24, 0xa4, 0x4b, 0x12, 0x4e, 0x3c, 0xb9, 0x39, 0x05, 0x34,
+	0x94, 0xe1, 0x3d, 0x50, 0xf0, 0x31, 0x73, 0x3a, 0x8c, 0x78, 0x6a, 0x27, 0xd0, 0xa2, 0x8f, 0xd9,
+	0x0b, 0x46, 0xbc, 0xcf, 0xf3, 0xdf, 0xbe, 0x33, 0x67, 0x2c, 0x0c, 0x4a, 0xba, 0x37, 0xee, 0xb4,
+	0x43, 0x72, 0x4b, 0x85, 0xed, 0x80, 0x32, 0xe3, 0x34, 0xc1, 0x3e, 0x71, 0x2e, 0x48, 0x7f, 0xd0,
+	0x15, 0xcb, 0xaa, 0xd1, 0xfa, 0x3f, 0x92, 0x3e, 0x43, 0x59, 0x41, 0xbb, 0x78, 0x97, 0x07, 0xa5,
+	0xf3, 0x04, 0xbb, 0x44, 0x37, 0xc6, 0xa2, 0x56, 0x85, 0x98, 0x68, 0x17, 0x5a, 0x12, 0xbe, 0xc5,
+	0xa9, 0xa6, 0x1d, 0xae, 0xcf, 0xd3, 0x40, 0x14, 0x88, 0x84, 0x90, 0x1e, 0x71, 0x65, 0x1a, 0xf3,
+	0x48, 0x4b, 0x70, 0x17, 0x54, 0xbc, 0x80, 0xc9, 0xaf, 0x63, 0xc6, 0xb1, 0x7b, 0xa1, 0xc2, 0xb7,
+	0x8d, 0xab, 0xd4, 0x2c, 0xeb, 0x89, 0x33, 0xa1, 0x47, 0x63, 0x12, 0xfc, 0x02, 0xd4, 0x46, 0x30,
+	0xb9, 0x5a, 0xf5, 0x3d, 0x6a, 0xc3, 0xab, 0xd4, 0xac, 0x0e, 0x4d, 0xe5, 0x0c, 0x9a, 0x90, 0xd5,
+	0xb7, 0x57, 0xb3, 0xe3, 0xcb, 0xe2, 0x2b, 0x20, 0x25, 0x08, 0x6d, 0x18, 0x44, 0x01, 0x97, 0xc5,
+	0x36, 0x8f, 0x94, 0x00, 0xbf, 0x00, 0x45, 0xda, 0x25, 0x49, 0x12, 0x78, 0x84, 0x35, 0xc0, 0x14,
+	0x9f, 0xd6, 0x68, 0x64, 0x2f, 0x82, 0xd3, 0x5f, 0xfe, 0x11, 0x89, 0x68, 0xd2, 0x6f, 0x94, 0x46,
+	0xc1, 0xa9, 0x89, 0xa7, 0x52, 0x8f, 0xc6, 0x24, 0x68, 0x03, 0xa8, 0x61, 0x09, 0xe1, 0x9d, 0x24,
+	0x76, 0xe4, 0xf9, 0x2f, 0x4b, 0xac, 0x3c, 0x85, 0x6a, 0x16, 0xc9, 0xc9, 0x03, 0xcc, 0x31, 0xba,
+	0xa1, 0x81, 0xbf, 0x03, 0x50, 0xed, 0x89, 0xf3, 0x0d, 0xa3, 0xc3, 0xff, 0x06, 0x54, 0xef, 0x20,
+	0xfd, 0xab, 0x59, 0xbd, 0x66, 0x43, 0x49, 0xc7, 0x8c, 0xea, 0x28, 0x8e, 0xf3, 0x85, 0xbc, 0x31,
+	0x7f, 0x9c, 0x2f, 0x2c, 0x1a, 0x85, 0x61, 0xfe, 0x74, 0x14, 0x68, 0x69, 0x20, 0x67, 0x96, 0xf7,
+	0xcb, 0x7f, 0xe6, 0x00, 0x18, 0x7d, 0xa2, 0xc1, 0x0d, 0x60, 0xec, 0xed, 0xef, 0x1f, 0x9e, 0x9d,
+	0x39, 0xe7, 0x2f, 0x4f, 0x0f, 0x9d, 0xe7, 0xa7, 0x87, 0xcf, 0x8c, 0x99, 0x55, 0xf8, 0xe6, 0xed,
+	0x7a, 0x75, 0x64, 0xf5, 0xbc, 0x4d, 0x62, 0xf1, 0x07, 0x43, 0xd6, 0x72, 0xef, 0xe4, 0xe4, 0xf9,
+	0xd7, 0x27, 0x47, 0x67, 0xe7, 0x46, 0x6e, 0xf5, 0xee, 0x9b, 0xb7, 0xeb, 0x4b, 0x23, 0xf3, 0x3d,
+	0xf1, 0x57, 0x43, 0x18, 0x30, 0x0e, 0x1f, 0x80, 0x7a, 0x16, 0x73, 0x70, 0xf8, 0xec, 0xa5, 0x84,
+	0xcc, 0xae, 0xae, 0xbc, 0x79, 0xbb, 0x0e, 0x47, 0x90, 0x03, 0x12, 0xf7, 0x05, 0x62, 0x35, 0xff,
+	0xed, 0xbf, 0xd6, 0x66, 0xec, 0xc3, 0xef, 0x2e, 0xd7, 0x72, 0xdf, 0x5f, 0xae, 0xe5, 0xfe, 0x7f,
+	0xb9, 0x96, 0xfb, 0xfb, 0xfb, 0xb5, 0x99, 0xef, 0xdf, 0xaf, 0xcd, 0xfc, 0xef, 0xfd, 0xda, 0xcc,
+	0x9f, 0x7e, 0xe5, 0x07, 0xbc, 0xd5, 0x69, 0x6e, 0xba, 0x34, 0xda, 0x0a, 0xe9, 0x05, 0xfe, 0x2c,
+	0x26, 0xfc, 0x35, 0x4d, 0x2e, 0xa4, 0x20, 0xfe, 0x91, 0xea, 0xc9, 0xbf, 0xa6, 0xc4, 0x77, 0x26,
+	0x6b, 0x2e, 0xc8, 0xbf, 0x9c, 0x1e, 0xfe, 0x38, 0x00, 0xd7, 0x05, 0xba, 0x56, 0xb8, 0x12, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -824,6 +1078,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.EVMChainID != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EVMChainID))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.HeaderHashNum != 0 {
 		i = encodeVarintEvm(dAtA, i, uint64(m.HeaderHashNum))
 		i--
@@ -850,21 +1133,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -898,6 +1181,126 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessControl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Create.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AccessType != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.AccessType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -918,6 +1321,20 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ShanghaiTime != nil {
+		{
+			size := m.ShanghaiTime.Size()
+			i -= size
+			if _, err := m.ShanghaiTime.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.CancunBlock != nil {
 		{
 			size := m.CancunBlock.Size()
@@ -1586,16 +2003,73 @@ func (m *Params) Size() (n int) {
 	if m.HeaderHashNum != 0 {
 		n += 1 + sovEvm(uint64(m.HeaderHashNum))
 	}
+	if m.EVMChainID != 0 {
+		n += 1 + sovEvm(uint64(m.EVMChainID))
+	}
+	l = m.AccessControl.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ChainConfig) Size() (n int) {
+func (m *FeeDenom) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.HomesteadBlock != nil {
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HomesteadBlock != nil {
 		l = m.HomesteadBlock.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
@@ -1670,6 +2144,10 @@ func (m *ChainConfig) Size() (n int) {
 		l = m.CancunBlock.Size()
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if m.ShanghaiTime != nil {
+		l = m.ShanghaiTime.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2101,6 +2579,425 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMChainID", wireType)
+			}
+			m.EVMChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EVMChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2815,6 +3712,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ShanghaiTime = &v
+			if err := m.ShanghaiTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type feeDenomKey struct{}
+
+// Validate checks the denomination and that the conversion rate is positive.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return err
+	}
+	if fd.Rate.IsNil() || !fd.Rate.IsPositive() {
+		return fmt.Errorf("fee denom %s rate must be positive: %s", fd.Denom, fd.Rate)
+	}
+	return nil
+}
+
+// ConvertFees converts the fees in evm_denom to the fee denomination, rounding up. It returns
+// empty coins for zero fees.
+func (fd FeeDenom) ConvertFees(fees sdk.Coins, evmDenom string) sdk.Coins {
+	amount := fd.Rate.MulInt(fees.AmountOf(evmDenom)).Ceil().TruncateInt()
+	if !amount.IsPositive() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{{Denom: fd.Denom, Amount: amount}}
+}
+
+// ConvertRefund converts an amount of evm_denom refunded to the sender to the fee denomination,
+// rounding down. It returns empty coins when nothing is refunded.
+func (fd FeeDenom) ConvertRefund(amount *big.Int) sdk.Coins {
+	refund := fd.Rate.MulInt(sdkmath.NewIntFromBigInt(amount)).TruncateInt()
+	if !refund.IsPositive() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{{Denom: fd.Denom, Amount: refund}}
+}
+
+// ToEVMDenom converts an amount of the fee denomination to evm_denom, rounding down.
+func (fd FeeDenom) ToEVMDenom(amount sdkmath.Int) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromInt(amount).Quo(fd.Rate).TruncateInt()
+}
+
+// GetFeeDenom returns the alternative fee denomination registered for the denom.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == denom {
+			return fd, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// FeeDenomOf returns the alternative fee denomination the fees of a tx are paid in, i.e when the
+// fees are a single coin of a registered fee denomination.
+func (p Params) FeeDenomOf(fees sdk.Coins) (FeeDenom, bool) {
+	if len(fees) != 1 {
+		return FeeDenom{}, false
+	}
+	return p.GetFeeDenom(fees[0].Denom)
+}
+
+func validateFeeDenoms(evmDenom string, feeDenoms []FeeDenom) error {
+	seen := make(map[string]bool, len(feeDenoms))
+	for _, fd := range feeDenoms {
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+		if fd.Denom == evmDenom {
+			return fmt.Errorf("fee denom %s is the evm denom", fd.Denom)
+		}
+		if seen[fd.Denom] {
+			return fmt.Errorf("duplicated fee denom %s", fd.Denom)
+		}
+		seen[fd.Denom] = true
+	}
+	return nil
+}
+
+// WithFeeDenom returns the context recording the alternative fee denomination the fees of the
+// Ethereum txs of the cosmos tx are paid in, set by the ante handler.
+func WithFeeDenom(ctx sdk.Context, feeDenom FeeDenom) sdk.Context {
+	return ctx.WithValue(feeDenomKey{}, feeDenom)
+}
+
+// FeeDenomFromContext returns the fee denomination recorded by WithFeeDenom, the second return
+// value is false when the fees are paid in evm_denom.
+func FeeDenomFromContext(ctx sdk.Context) (FeeDenom, bool) {
+	feeDenom, ok := ctx.Value(feeDenomKey{}).(FeeDenom)
+	return feeDenom, ok
+}
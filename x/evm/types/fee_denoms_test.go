@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+func TestFeeDenomConversions(t *testing.T) {
+	// 1 aloka is paid with 0.5 uatom
+	fd := evmtypes.FeeDenom{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)}
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("aloka", 21001))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("uatom", 10501)}, fd.ConvertFees(fees, "aloka"))
+	require.Equal(t, sdk.Coins{}, fd.ConvertFees(sdk.Coins{}, "aloka"))
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("uatom", 10500)}, fd.ConvertRefund(big.NewInt(21001)))
+	require.Equal(t, sdk.Coins{}, fd.ConvertRefund(big.NewInt(1)))
+
+	require.Equal(t, sdkmath.NewInt(21000), fd.ToEVMDenom(sdkmath.NewInt(10500)))
+}
+
+func TestParamsFeeDenomOf(t *testing.T) {
+	params := evmtypes.DefaultParams()
+	params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyOneDec()}}
+
+	fd, ok := params.FeeDenomOf(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	require.True(t, ok)
+	require.Equal(t, "uatom", fd.Denom)
+
+	_, ok = params.FeeDenomOf(sdk.NewCoins(sdk.NewInt64Coin(params.EvmDenom, 1)))
+	require.False(t, ok)
+	_, ok = params.FeeDenomOf(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("uosmo", 1)))
+	require.False(t, ok)
+	_, ok = params.FeeDenomOf(sdk.Coins{})
+	require.False(t, ok)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type feePayerKey struct{}
+
+// WithFeePayer returns the context recording the sponsor paying the fees of the Ethereum txs of
+// the cosmos tx, set by the ante handler when the tx has a fee granter.
+func WithFeePayer(ctx sdk.Context, payer common.Address) sdk.Context {
+	return ctx.WithValue(feePayerKey{}, payer)
+}
+
+// FeePayerFromContext returns the sponsor recorded by WithFeePayer, the second return value is
+// false when the fees are paid by the sender.
+func FeePayerFromContext(ctx sdk.Context) (common.Address, bool) {
+	payer, ok := ctx.Value(feePayerKey{}).(common.Address)
+	return payer, ok
+}
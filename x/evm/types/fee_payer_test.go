@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/loka-network/loka/v1/x/evm/types"
+)
+
+func TestFeePayerFromContext(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	_, ok := evmtypes.FeePayerFromContext(ctx)
+	require.False(t, ok)
+
+	sponsor := common.HexToAddress("0x1000")
+	payer, ok := evmtypes.FeePayerFromContext(evmtypes.WithFeePayer(ctx, sponsor))
+	require.True(t, ok)
+	require.Equal(t, sponsor, payer)
+}
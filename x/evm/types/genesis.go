@@ -18,6 +18,8 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/loka-network/loka/v1/types"
 )
 
@@ -29,6 +31,14 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// Validate performs a basic validation of an AccountFeeDenom fields.
+func (afd AccountFeeDenom) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(afd.Address); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(afd.Denom)
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -60,5 +70,22 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenFeeDenoms := make(map[string]bool)
+	for _, afd := range gs.AccountFeeDenoms {
+		if seenFeeDenoms[afd.Address] {
+			return fmt.Errorf("duplicated account fee denom %s", afd.Address)
+		}
+		if err := afd.Validate(); err != nil {
+			return fmt.Errorf("invalid account fee denom %s: %w", afd.Address, err)
+		}
+		if _, ok := gs.Params.GetFeeDenom(afd.Denom); !ok {
+			return fmt.Errorf("account %s fee denom %s is not registered", afd.Address, afd.Denom)
+		}
+		seenFeeDenoms[afd.Address] = true
+	}
+	return nil
 }
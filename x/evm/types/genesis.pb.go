@@ -29,6 +29,8 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// account_fee_denoms are the alternative fee denominations the accounts opted in.
+	AccountFeeDenoms []AccountFeeDenom `protobuf:"bytes,3,rep,name=account_fee_denoms,json=accountFeeDenoms,proto3" json:"account_fee_denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAccountFeeDenoms() []AccountFeeDenom {
+	if m != nil {
+		return m.AccountFeeDenoms
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -144,35 +153,95 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+// AccountFeeDenom defines the alternative fee denomination an account pays the fees of its
+// Ethereum transactions in.
+type AccountFeeDenom struct {
+	// address defines the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom defines the alternative fee denomination
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *AccountFeeDenom) Reset()         { *m = AccountFeeDenom{} }
+func (m *AccountFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*AccountFeeDenom) ProtoMessage()    {}
+func (*AccountFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{2}
+}
+func (m *AccountFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFeeDenom.Merge(m, src)
+}
+func (m *AccountFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountFeeDenom proto.InternalMessageInfo
+
+func (m *AccountFeeDenom) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.evm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "ethermint.evm.v1.GenesisAccount")
+	proto.RegisterType((*AccountFeeDenom)(nil), "ethermint.evm.v1.AccountFeeDenom")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbd, 0x4e, 0xc3, 0x30,
-	0x10, 0x8e, 0x69, 0xd5, 0x52, 0x17, 0x01, 0xb2, 0x90, 0x88, 0x3a, 0xb8, 0x55, 0xa7, 0x2e, 0xd8,
-	0x6a, 0x91, 0xd8, 0xc9, 0x82, 0xd8, 0x50, 0xba, 0xb1, 0xb9, 0xe9, 0x29, 0x29, 0x52, 0xe2, 0xc8,
-	0x76, 0x22, 0x58, 0x19, 0x99, 0x78, 0x0e, 0x9e, 0xa4, 0x63, 0x47, 0x26, 0x40, 0xc9, 0x8b, 0xa0,
-	0x38, 0x69, 0x25, 0xc8, 0x76, 0xe7, 0xef, 0xef, 0xfc, 0x61, 0x0a, 0x26, 0x02, 0x15, 0x6f, 0x12,
-	0xc3, 0x21, 0x8f, 0x79, 0x3e, 0xe7, 0x21, 0x24, 0xa0, 0x37, 0x9a, 0xa5, 0x4a, 0x1a, 0x49, 0xce,
-	0x0f, 0x38, 0x83, 0x3c, 0x66, 0xf9, 0x7c, 0x34, 0x6a, 0x29, 0x2a, 0xc0, 0xb2, 0x47, 0x17, 0xa1,
-	0x0c, 0xa5, 0x1d, 0x79, 0x35, 0xd5, 0xaf, 0xd3, 0x37, 0x84, 0x4f, 0xee, 0x6a, 0xd7, 0xa5, 0x11,
-	0x06, 0x88, 0x87, 0x8f, 0x45, 0x10, 0xc8, 0x2c, 0x31, 0xda, 0x45, 0x93, 0xce, 0x6c, 0xb8, 0x98,
-	0xb0, 0xff, 0x39, 0xac, 0x51, 0xdc, 0xd6, 0x44, 0xaf, 0xbb, 0xfd, 0x1a, 0x3b, 0xfe, 0x41, 0x47,
-	0x6e, 0x70, 0x2f, 0x15, 0x4a, 0xc4, 0xda, 0x3d, 0x9a, 0xa0, 0xd9, 0x70, 0xe1, 0xb6, 0x1d, 0x1e,
-	0x2c, 0xde, 0x28, 0x1b, 0xf6, 0xf4, 0x15, 0xe1, 0xd3, 0xbf, 0xd6, 0xc4, 0xc5, 0x7d, 0xb1, 0x5e,
-	0x2b, 0xd0, 0xd5, 0x35, 0x68, 0x36, 0xf0, 0xf7, 0x2b, 0x21, 0xb8, 0x1b, 0xc8, 0x35, 0xd8, 0x88,
-	0x81, 0x6f, 0x67, 0xe2, 0xe1, 0xbe, 0x36, 0x52, 0x89, 0x10, 0xdc, 0x8e, 0xbd, 0xfd, 0xb2, 0x9d,
-	0x6c, 0xbf, 0xe9, 0x9d, 0x55, 0xc1, 0x1f, 0xdf, 0xe3, 0xfe, 0xb2, 0xe6, 0xfb, 0x7b, 0xa1, 0x77,
-	0xbf, 0x2d, 0x28, 0xda, 0x15, 0x14, 0xfd, 0x14, 0x14, 0xbd, 0x97, 0xd4, 0xd9, 0x95, 0xd4, 0xf9,
-	0x2c, 0xa9, 0xf3, 0xc8, 0xc3, 0x8d, 0x89, 0xb2, 0x15, 0x0b, 0x64, 0xcc, 0x23, 0x30, 0xd9, 0x55,
-	0xaa, 0xe4, 0x13, 0x04, 0xa6, 0x5e, 0xa2, 0x6c, 0x55, 0x15, 0xfe, 0x6c, 0x9b, 0x37, 0x2f, 0x29,
-	0xe8, 0x55, 0xcf, 0x76, 0x7c, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xaa, 0x80, 0xc4, 0xc9,
-	0x01, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x6e, 0xe2, 0x30,
+	0x14, 0x85, 0xe3, 0x81, 0x81, 0xc1, 0x8c, 0x06, 0x64, 0x21, 0x4d, 0xc4, 0xc2, 0x50, 0x56, 0x48,
+	0x55, 0x13, 0x41, 0xa5, 0xee, 0x89, 0xfa, 0xb3, 0xad, 0x82, 0xba, 0xe9, 0x06, 0x99, 0xe4, 0x36,
+	0x20, 0x9a, 0x18, 0xc5, 0x26, 0x6d, 0xb7, 0x7d, 0x82, 0x3e, 0x47, 0x9f, 0x84, 0x25, 0xcb, 0xae,
+	0xfa, 0x03, 0x2f, 0x52, 0xc5, 0x31, 0x48, 0x10, 0xa9, 0xbb, 0x7b, 0x7d, 0xcf, 0x39, 0xfe, 0x9c,
+	0x5c, 0x4c, 0x41, 0x4e, 0x20, 0x0e, 0xa7, 0x91, 0xb4, 0x21, 0x09, 0xed, 0xa4, 0x67, 0x07, 0x10,
+	0x81, 0x98, 0x0a, 0x6b, 0x1e, 0x73, 0xc9, 0x49, 0x7d, 0x37, 0xb7, 0x20, 0x09, 0xad, 0xa4, 0xd7,
+	0x6c, 0xe6, 0x1c, 0xe9, 0x40, 0xa9, 0x9b, 0x8d, 0x80, 0x07, 0x5c, 0x95, 0x76, 0x5a, 0x65, 0xa7,
+	0x9d, 0x2f, 0x84, 0xff, 0x5e, 0x65, 0xa9, 0x43, 0xc9, 0x24, 0x10, 0x07, 0xff, 0x61, 0x9e, 0xc7,
+	0x17, 0x91, 0x14, 0x26, 0x6a, 0x17, 0xba, 0xd5, 0x7e, 0xdb, 0x3a, 0xbc, 0xc7, 0xd2, 0x8e, 0x41,
+	0x26, 0x74, 0x8a, 0xcb, 0xf7, 0x96, 0xe1, 0xee, 0x7c, 0xe4, 0x0c, 0x97, 0xe6, 0x2c, 0x66, 0xa1,
+	0x30, 0x7f, 0xb5, 0x51, 0xb7, 0xda, 0x37, 0xf3, 0x09, 0xd7, 0x6a, 0xae, 0x9d, 0x5a, 0x4d, 0x6e,
+	0x30, 0xd1, 0x19, 0xa3, 0x3b, 0x80, 0x91, 0x0f, 0x11, 0x0f, 0x85, 0x59, 0x50, 0x14, 0x47, 0xf9,
+	0x0c, 0x7d, 0xfd, 0x25, 0xc0, 0x79, 0xaa, 0xd4, 0x61, 0x75, 0xb6, 0x7f, 0x2c, 0x3a, 0xcf, 0x08,
+	0xff, 0xdb, 0x27, 0x26, 0x26, 0x2e, 0x33, 0xdf, 0x8f, 0x41, 0xa4, 0x8f, 0x44, 0xdd, 0x8a, 0xbb,
+	0x6d, 0x09, 0xc1, 0x45, 0x8f, 0xfb, 0xa0, 0xc8, 0x2b, 0xae, 0xaa, 0x89, 0x83, 0xcb, 0x42, 0xf2,
+	0x98, 0x05, 0xa0, 0x61, 0xfe, 0xe7, 0x61, 0xd4, 0xd7, 0x73, 0x6a, 0x29, 0xc2, 0xeb, 0x47, 0xab,
+	0x3c, 0xcc, 0xf4, 0xee, 0xd6, 0xd8, 0x19, 0xe0, 0xda, 0x01, 0xef, 0x0f, 0x10, 0x0d, 0xfc, 0x5b,
+	0x3d, 0x5e, 0x53, 0x64, 0x8d, 0x73, 0xb1, 0x5c, 0x53, 0xb4, 0x5a, 0x53, 0xf4, 0xb9, 0xa6, 0xe8,
+	0x65, 0x43, 0x8d, 0xd5, 0x86, 0x1a, 0x6f, 0x1b, 0x6a, 0xdc, 0x1e, 0x07, 0x53, 0x39, 0x59, 0x8c,
+	0x2d, 0x8f, 0x87, 0xf6, 0x3d, 0x9f, 0xb1, 0x93, 0x08, 0xe4, 0x03, 0x8f, 0x67, 0xaa, 0x49, 0xd7,
+	0xe0, 0x51, 0xed, 0x83, 0x7c, 0x9a, 0x83, 0x18, 0x97, 0xd4, 0x9f, 0x3f, 0xfd, 0x1e, 0x00, 0xf0,
+	0x87, 0x71, 0x33, 0x5f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +264,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountFeeDenoms) > 0 {
+		for iNdEx := len(m.AccountFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -273,6 +356,43 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -298,6 +418,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountFeeDenoms) > 0 {
+		for _, e := range m.AccountFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -324,6 +450,23 @@ func (m *GenesisAccount) Size() (n int) {
 	return n
 }
 
+func (m *AccountFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -426,6 +569,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountFeeDenoms = append(m.AccountFeeDenoms, AccountFeeDenom{})
+			if err := m.AccountFeeDenoms[len(m.AccountFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -595,6 +772,120 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -83,6 +85,10 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	accAddress := sdk.AccAddress(common.HexToAddress(suite.address).Bytes()).String()
+	feeDenomParams := DefaultParams()
+	feeDenomParams.FeeDenoms = []FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyOneDec()}}
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -211,6 +217,41 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid account fee denom",
+			genState: &GenesisState{
+				Params:           feeDenomParams,
+				AccountFeeDenoms: []AccountFeeDenom{{Address: accAddress, Denom: "uatom"}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid account fee denom address",
+			genState: &GenesisState{
+				Params:           feeDenomParams,
+				AccountFeeDenoms: []AccountFeeDenom{{Address: suite.address, Denom: "uatom"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "unregistered account fee denom",
+			genState: &GenesisState{
+				Params:           DefaultParams(),
+				AccountFeeDenoms: []AccountFeeDenom{{Address: accAddress, Denom: "uatom"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated account fee denom",
+			genState: &GenesisState{
+				Params: feeDenomParams,
+				AccountFeeDenoms: []AccountFeeDenom{
+					{Address: accAddress, Denom: "uatom"},
+					{Address: accAddress, Denom: "uatom"},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
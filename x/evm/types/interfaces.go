@@ -26,8 +26,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	feemarkettypes "github.com/loka-network/loka/v1/x/feemarket/types"
 )
 
@@ -46,6 +48,7 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	authtypes.BankKeeper
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccountVirtual(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModuleVirtual(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -68,6 +71,15 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 }
 
+// TransferChecker defines the expected interface needed to check the EVM value transfers before
+// they're executed, e.g. against the compliance rules.
+type TransferChecker interface {
+	// CheckEVMTransfer returns an error if the transfer is denied, the recipient is empty if it
+	// isn't known before the transfer, e.g. for the contracts created by the CREATE and CREATE2
+	// opcodes.
+	CheckEVMTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+}
+
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
@@ -77,6 +89,24 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmPreTxHooks are the optional hooks of the EvmHooks called before the evm tx processing.
+type EvmPreTxHooks interface {
+	// Called before the tx is executed, if it returns an error the tx is rejected without being executed:
+	// the sender nonce stays increased and only the intrinsic gas is charged, the receipt has a failed
+	// status and the post processing hooks are not called. The state changes of the hook are only
+	// committed with the ones of a successful tx.
+	PreTxProcessing(ctx sdk.Context, msg core.Message, cfg *PreTxConfig) error
+}
+
+// PreTxConfig is the configuration of the EVM executing the tx, passed to the pre processing hooks.
+type PreTxConfig struct {
+	Params      Params
+	ChainConfig *params.ChainConfig
+	CoinBase    common.Address
+	BaseFee     *big.Int
+	TxHash      common.Hash
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
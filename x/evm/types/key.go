@@ -18,6 +18,7 @@ package types
 import (
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/loka-network/loka/v1/types"
 )
@@ -49,6 +50,7 @@ const (
 	prefixStorage
 	prefixParams
 	prefixHeaderHash
+	prefixFeeDenom
 )
 
 // prefix bytes for the EVM transient store
@@ -72,6 +74,7 @@ var (
 	KeyPrefixStorage    = []byte{prefixStorage}
 	KeyPrefixParams     = []byte{prefixParams}
 	KeyPrefixHeaderHash = []byte{prefixHeaderHash}
+	KeyPrefixFeeDenom   = []byte{prefixFeeDenom}
 )
 
 // Transient Store key prefixes
@@ -95,6 +98,11 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// FeeDenomKey defines the key under which the alternative fee denomination an account opted in is stored.
+func FeeDenomKey(address sdk.AccAddress) []byte {
+	return append(KeyPrefixFeeDenom, address.Bytes()...)
+}
+
 // StateKey defines the full key under which an account state is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
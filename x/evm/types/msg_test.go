@@ -430,7 +430,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 			errMsg:     "failed to unpack tx data",
 		},
 		{
-			msg:        "invalid chain ID (neither 567000 nor 567001)",
+			msg:        "custom chain ID",
 			to:         suite.to.Hex(),
 			amount:     hundredInt,
 			gasLimit:   1000,
@@ -439,8 +439,20 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 			gasTipCap:  nil,
 			accessList: &ethtypes.AccessList{},
 			chainID:    hundredInt,
+			expectPass: true,
+		},
+		{
+			msg:        "invalid chain ID (not positive)",
+			to:         suite.to.Hex(),
+			amount:     hundredInt,
+			gasLimit:   1000,
+			gasPrice:   zeroInt,
+			gasFeeCap:  nil,
+			gasTipCap:  nil,
+			accessList: &ethtypes.AccessList{},
+			chainID:    big.NewInt(-1),
 			expectPass: false,
-			errMsg:     "chain ID must be 567000 or 567001 on Evmos",
+			errMsg:     "chain ID must be positive",
 		},
 	}
 
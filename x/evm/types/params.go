@@ -22,8 +22,11 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
+	evmostypes "github.com/loka-network/loka/v1/types"
 	"github.com/loka-network/loka/v1/utils"
 )
 
@@ -45,6 +48,16 @@ var (
 // https://github.com/ethereum/go-ethereum/blob/master/core/vm/interpreter.go#L97
 var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
 
+const (
+	// ShanghaiEIP is the Shanghai instruction set change enabled on top of the extra EIPs
+	// once the fork is active: the PUSH0 instruction (EIP-3855)
+	ShanghaiEIP = 3855
+	// MaxInitCodeSize is the maximum init code size of a contract creation after Shanghai (EIP-3860)
+	MaxInitCodeSize = 2 * params.MaxCodeSize
+	// InitCodeWordGas is the gas charged per word of init code after Shanghai (EIP-3860)
+	InitCodeWordGas = 2
+)
+
 // NewParams creates a new Params instance
 func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
 	return Params{
@@ -92,6 +105,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.AccessControl.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenoms(p.EvmDenom, p.FeeDenoms); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -145,6 +166,30 @@ func validateChainConfig(i interface{}) error {
 	return cfg.Validate()
 }
 
+// ParseEVMChainID parses the EIP155 chain id of a chain identifier to an EVM chain id param.
+func ParseEVMChainID(chainID string) (uint64, error) {
+	eip155ChainID, err := evmostypes.ParseChainID(chainID)
+	if err != nil {
+		return 0, err
+	}
+	if !eip155ChainID.IsUint64() {
+		return 0, errorsmod.Wrapf(errortypes.ErrInvalidChainID, "EIP155 chain id %s overflows uint64", eip155ChainID)
+	}
+	return eip155ChainID.Uint64(), nil
+}
+
+// ValidateEVMChainID returns an error if the EVM chain id param is set and the EIP155 chain id
+// parsed from the chain identifier doesn't match it.
+func ValidateEVMChainID(chainID *big.Int, evmChainID uint64) error {
+	if evmChainID == 0 || chainID.Cmp(new(big.Int).SetUint64(evmChainID)) == 0 {
+		return nil
+	}
+	return errorsmod.Wrapf(
+		errortypes.ErrInvalidChainID,
+		"EIP155 chain id %s of the chain identifier doesn't match the EVM chain id %d", chainID, evmChainID,
+	)
+}
+
 // IsLondon returns if london hardfork is enabled.
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +43,51 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid access control",
+			func() Params {
+				params := DefaultParams()
+				params.AccessControl.Create = AccessPolicy{AccessType: AccessTypeAllowlist, Addresses: []string{"0x1"}}
+				return params
+			}(),
+			true,
+		},
+		{
+			"valid fee denoms",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []FeeDenom{{Denom: "uatom", Rate: math.LegacyNewDecWithPrec(5, 13)}}
+				return params
+			}(),
+			false,
+		},
+		{
+			"fee denom without rate",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []FeeDenom{{Denom: "uatom", Rate: math.LegacyZeroDec()}}
+				return params
+			}(),
+			true,
+		},
+		{
+			"fee denom is the evm denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []FeeDenom{{Denom: params.EvmDenom, Rate: math.LegacyOneDec()}}
+				return params
+			}(),
+			true,
+		},
+		{
+			"duplicated fee denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []FeeDenom{{Denom: "uatom", Rate: math.LegacyOneDec()}, {Denom: "uatom", Rate: math.LegacyOneDec()}}
+				return params
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
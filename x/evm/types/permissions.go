@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/loka-network/loka/v1/types"
+)
+
+var _ vm.OpCodeHooks = permissionHooks{}
+
+// Validate performs a basic validation of the create and call permission policies.
+func (ac AccessControl) Validate() error {
+	if err := ac.Create.Validate(); err != nil {
+		return fmt.Errorf("invalid create permission policy: %w", err)
+	}
+	if err := ac.Call.Validate(); err != nil {
+		return fmt.Errorf("invalid call permission policy: %w", err)
+	}
+	return nil
+}
+
+// CheckPermission returns an error if the permission policies don't allow the sender of a
+// transaction to create a contract or to call an address.
+func (ac AccessControl) CheckPermission(sender common.Address, contractCreation bool) error {
+	if contractCreation {
+		if !ac.Create.IsAllowed(sender, sender) {
+			return errorsmod.Wrapf(ErrCreateNotPermitted, "sender %s", sender)
+		}
+		return nil
+	}
+	if !ac.Call.IsAllowed(sender, sender) {
+		return errorsmod.Wrapf(ErrCallNotPermitted, "sender %s", sender)
+	}
+	return nil
+}
+
+// Validate checks the access type and that the addresses are valid and not duplicated.
+func (p AccessPolicy) Validate() error {
+	if _, ok := AccessType_name[int32(p.AccessType)]; !ok {
+		return fmt.Errorf("invalid access type %d", p.AccessType)
+	}
+
+	seen := make(map[common.Address]bool, len(p.Addresses))
+	for _, address := range p.Addresses {
+		if err := types.ValidateAddress(address); err != nil {
+			return err
+		}
+		addr := common.HexToAddress(address)
+		if seen[addr] {
+			return fmt.Errorf("duplicated address %s", address)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// IsAllowed returns true if the policy lets the transaction signer perform the operation through
+// the caller. The caller is the signer for the transaction itself and the executing contract for the
+// CREATE, CREATE2 and CALL opcodes. An allowlist grants the permission if either the signer or the
+// caller is listed, so listed contracts can be used by anybody, while a denylist refuses it if
+// either of them is listed.
+func (p AccessPolicy) IsAllowed(signer, caller common.Address) bool {
+	switch p.AccessType {
+	case AccessTypeAllowlist:
+		return p.contains(signer) || p.contains(caller)
+	case AccessTypeDenylist:
+		return !p.contains(signer) && !p.contains(caller)
+	default:
+		return true
+	}
+}
+
+func (p AccessPolicy) contains(address common.Address) bool {
+	return slices.ContainsFunc(p.Addresses, func(addr string) bool {
+		return common.HexToAddress(addr) == address
+	})
+}
+
+// permissionHooks enforces the permission policies on the CREATE, CREATE2 and CALL opcodes
+// executed by a transaction.
+type permissionHooks struct {
+	accessControl AccessControl
+	signer        common.Address
+}
+
+// NewPermissionHooks returns the EVM opcode hooks enforcing the permission policies on the
+// contracts created and called by a transaction of the signer.
+func NewPermissionHooks(accessControl AccessControl, signer common.Address) vm.OpCodeHooks {
+	return permissionHooks{
+		accessControl: accessControl,
+		signer:        signer,
+	}
+}
+
+// CallHook implements vm.OpCodeHooks, it's called before the CALL, CALLCODE, DELEGATECALL and
+// STATICCALL opcodes.
+func (h permissionHooks) CallHook(_ *vm.EVM, caller, _ common.Address) error {
+	if !h.accessControl.Call.IsAllowed(h.signer, caller) {
+		return errorsmod.Wrapf(ErrCallNotPermitted, "caller %s", caller)
+	}
+	return nil
+}
+
+// CreateHook implements vm.OpCodeHooks, it's called before the CREATE and CREATE2 opcodes.
+func (h permissionHooks) CreateHook(_ *vm.EVM, caller common.Address) error {
+	if !h.accessControl.Create.IsAllowed(h.signer, caller) {
+		return errorsmod.Wrapf(ErrCreateNotPermitted, "caller %s", caller)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessPolicyValidate(t *testing.T) {
+	addr := common.HexToAddress("0x1000").Hex()
+	testCases := []struct {
+		name     string
+		policy   AccessPolicy
+		expError bool
+	}{
+		{"open", AccessPolicy{}, false},
+		{"allowlist", AccessPolicy{AccessType: AccessTypeAllowlist, Addresses: []string{addr}}, false},
+		{"denylist", AccessPolicy{AccessType: AccessTypeDenylist, Addresses: []string{addr}}, false},
+		{"invalid access type", AccessPolicy{AccessType: AccessType(3)}, true},
+		{"invalid address", AccessPolicy{AccessType: AccessTypeAllowlist, Addresses: []string{"0x1"}}, true},
+		{
+			"duplicated address",
+			AccessPolicy{AccessType: AccessTypeDenylist, Addresses: []string{addr, common.HexToAddress("0x1000").String()}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.policy.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestAccessPolicyIsAllowed(t *testing.T) {
+	listed := common.HexToAddress("0x1000")
+	other := common.HexToAddress("0x2000")
+	addresses := []string{listed.Hex()}
+
+	testCases := []struct {
+		name       string
+		accessType AccessType
+		signer     common.Address
+		caller     common.Address
+		expAllowed bool
+	}{
+		{"open", AccessTypeOpen, other, other, true},
+		{"allowlist - listed signer", AccessTypeAllowlist, listed, listed, true},
+		{"allowlist - not listed signer", AccessTypeAllowlist, other, other, false},
+		{"allowlist - listed caller contract", AccessTypeAllowlist, other, listed, true},
+		{"allowlist - listed signer through a contract", AccessTypeAllowlist, listed, other, true},
+		{"denylist - listed signer", AccessTypeDenylist, listed, listed, false},
+		{"denylist - not listed signer", AccessTypeDenylist, other, other, true},
+		{"denylist - listed caller contract", AccessTypeDenylist, other, listed, false},
+		{"denylist - listed signer through a contract", AccessTypeDenylist, listed, other, false},
+	}
+
+	for _, tc := range testCases {
+		policy := AccessPolicy{AccessType: tc.accessType, Addresses: addresses}
+		require.Equal(t, tc.expAllowed, policy.IsAllowed(tc.signer, tc.caller), tc.name)
+	}
+}
+
+func TestPermissionHooks(t *testing.T) {
+	signer := common.HexToAddress("0x1000")
+	factory := common.HexToAddress("0x2000")
+	contract := common.HexToAddress("0x3000")
+
+	accessControl := AccessControl{
+		Create: AccessPolicy{AccessType: AccessTypeAllowlist, Addresses: []string{factory.Hex()}},
+		Call:   AccessPolicy{AccessType: AccessTypeDenylist, Addresses: []string{contract.Hex()}},
+	}
+	require.NoError(t, accessControl.Validate())
+
+	// the signer can't deploy contracts but can call the allowed factory
+	require.ErrorIs(t, accessControl.CheckPermission(signer, true), ErrCreateNotPermitted)
+	require.NoError(t, accessControl.CheckPermission(signer, false))
+
+	hooks := NewPermissionHooks(accessControl, signer)
+	require.ErrorIs(t, hooks.CreateHook(nil, signer), ErrCreateNotPermitted)
+	require.NoError(t, hooks.CreateHook(nil, factory))
+	require.NoError(t, hooks.CallHook(nil, signer, factory))
+	require.ErrorIs(t, hooks.CallHook(nil, contract, factory), ErrCallNotPermitted)
+}
@@ -787,6 +787,109 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPermissionsRequest defines the request type for querying the permissions
+// of an address.
+type QueryPermissionsRequest struct {
+	// address is the ethereum hex address to query the permissions for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPermissionsRequest) Reset()         { *m = QueryPermissionsRequest{} }
+func (m *QueryPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsRequest) ProtoMessage()    {}
+func (*QueryPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{16}
+}
+func (m *QueryPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsRequest.Merge(m, src)
+}
+func (m *QueryPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionsRequest proto.InternalMessageInfo
+
+func (m *QueryPermissionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryPermissionsResponse defines the response type for querying the
+// permissions of an address.
+type QueryPermissionsResponse struct {
+	// can_create defines if the address is allowed to create contracts
+	CanCreate bool `protobuf:"varint,1,opt,name=can_create,json=canCreate,proto3" json:"can_create,omitempty"`
+	// can_call defines if the address is allowed to call contracts
+	CanCall bool `protobuf:"varint,2,opt,name=can_call,json=canCall,proto3" json:"can_call,omitempty"`
+}
+
+func (m *QueryPermissionsResponse) Reset()         { *m = QueryPermissionsResponse{} }
+func (m *QueryPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsResponse) ProtoMessage()    {}
+func (*QueryPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{17}
+}
+func (m *QueryPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsResponse.Merge(m, src)
+}
+func (m *QueryPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryPermissionsResponse) GetCanCreate() bool {
+	if m != nil {
+		return m.CanCreate
+	}
+	return false
+}
+
+func (m *QueryPermissionsResponse) GetCanCall() bool {
+	if m != nil {
+		return m.CanCall
+	}
+	return false
+}
+
 // EthCallRequest defines EthCall request
 type EthCallRequest struct {
 	// args uses the same json format as the json rpc api.
@@ -803,7 +906,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{16}
+	return fileDescriptor_e15a877459347994, []int{18}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +973,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{17}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,7 +1034,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{20}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1129,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{21}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1084,7 +1187,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1172,7 +1275,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1320,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1359,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,6 +1405,8 @@ func init() {
 	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPermissionsRequest)(nil), "ethermint.evm.v1.QueryPermissionsRequest")
+	proto.RegisterType((*QueryPermissionsResponse)(nil), "ethermint.evm.v1.QueryPermissionsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
@@ -1315,98 +1420,103 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x03, 0xf8, 0x1b, 0x02, 0x38, 0x4b, 0x62, 0x87, 0xfd, 0xbe,
-	0x60, 0xc3, 0x47, 0x76, 0x49, 0xbe, 0x4f, 0x48, 0xed, 0xa5, 0xc5, 0x16, 0x50, 0x0a, 0x54, 0xd4,
-	0x8d, 0x7a, 0xa8, 0x54, 0x59, 0xe3, 0xf5, 0xb0, 0x76, 0x63, 0xef, 0x98, 0x9d, 0xb1, 0xe5, 0x80,
-	0x38, 0x14, 0x55, 0xfd, 0xa1, 0x4a, 0x15, 0x52, 0x6f, 0x3d, 0x71, 0xef, 0x1f, 0xd0, 0x63, 0xaf,
-	0x1c, 0x91, 0x7a, 0xa9, 0x7a, 0xa0, 0x08, 0x7a, 0xe8, 0xdf, 0xd0, 0x53, 0x35, 0xbf, 0xec, 0x5d,
-	0xdb, 0x1b, 0x87, 0x8a, 0x9e, 0x7a, 0xda, 0x9d, 0x99, 0x77, 0xde, 0xe7, 0x99, 0xf7, 0x7d, 0x67,
-	0xde, 0x07, 0xac, 0x61, 0xd6, 0xc4, 0x41, 0xa7, 0xe5, 0x33, 0x07, 0xf7, 0x3b, 0x4e, 0x7f, 0xdb,
-	0xb9, 0xdb, 0xc3, 0xc1, 0xbe, 0xdd, 0x0d, 0x08, 0x23, 0x30, 0x3b, 0x5c, 0xb5, 0x71, 0xbf, 0x63,
-	0xf7, 0xb7, 0xcd, 0xf3, 0x2e, 0xa1, 0x1d, 0x42, 0x9d, 0x3a, 0xa2, 0x58, 0x9a, 0x3a, 0xfd, 0xed,
-	0x3a, 0x66, 0x68, 0xdb, 0xe9, 0x22, 0xaf, 0xe5, 0x23, 0xd6, 0x22, 0xbe, 0xdc, 0x6d, 0x9a, 0x13,
-	0xbe, 0xb9, 0x13, 0xb9, 0xb6, 0x3a, 0xb1, 0xc6, 0x06, 0x6a, 0x69, 0xc5, 0x23, 0x1e, 0x11, 0xbf,
-	0x0e, 0xff, 0x53, 0xb3, 0x6b, 0x1e, 0x21, 0x5e, 0x1b, 0x3b, 0xa8, 0xdb, 0x72, 0x90, 0xef, 0x13,
-	0x26, 0x90, 0xa8, 0x5a, 0x2d, 0xa8, 0x55, 0x31, 0xaa, 0xf7, 0xee, 0x38, 0xac, 0xd5, 0xc1, 0x94,
-	0xa1, 0x4e, 0x57, 0x1a, 0x58, 0x6f, 0x80, 0xe3, 0xef, 0x73, 0xb6, 0x97, 0x5d, 0x97, 0xf4, 0x7c,
-	0x56, 0xc5, 0x77, 0x7b, 0x98, 0x32, 0x98, 0x03, 0x29, 0xd4, 0x68, 0x04, 0x98, 0xd2, 0x9c, 0xb1,
-	0x61, 0x94, 0x96, 0xaa, 0x7a, 0xf8, 0x66, 0xfa, 0xcb, 0xc7, 0x85, 0xb9, 0xdf, 0x1f, 0x17, 0xe6,
-	0x2c, 0x17, 0xac, 0x44, 0xb7, 0xd2, 0x2e, 0xf1, 0x29, 0xe6, 0x7b, 0xeb, 0xa8, 0x8d, 0x7c, 0x17,
-	0xeb, 0xbd, 0x6a, 0x08, 0x4f, 0x83, 0x25, 0x97, 0x34, 0x70, 0xad, 0x89, 0x68, 0x33, 0x37, 0x2f,
-	0xd6, 0xd2, 0x7c, 0xe2, 0x1d, 0x44, 0x9b, 0x70, 0x05, 0x2c, 0xf8, 0x84, 0x6f, 0x4a, 0x6c, 0x18,
-	0xa5, 0x64, 0x55, 0x0e, 0xac, 0xb7, 0xc0, 0xaa, 0x00, 0xa9, 0x88, 0xf0, 0xfe, 0x05, 0x96, 0x9f,
-	0x1b, 0xc0, 0x9c, 0xe6, 0x41, 0x91, 0xdd, 0x04, 0x47, 0x65, 0xe6, 0x6a, 0x51, 0x4f, 0x47, 0xe4,
-	0xec, 0x65, 0x39, 0x09, 0x4d, 0x90, 0xa6, 0x1c, 0x94, 0xf3, 0x9b, 0x17, 0xfc, 0x86, 0x63, 0xee,
-	0x02, 0x49, 0xaf, 0x35, 0xbf, 0xd7, 0xa9, 0xe3, 0x40, 0x9d, 0xe0, 0x88, 0x9a, 0x7d, 0x4f, 0x4c,
-	0x5a, 0x37, 0xc0, 0x9a, 0xe0, 0xf1, 0x21, 0x6a, 0xb7, 0x1a, 0x88, 0x91, 0x60, 0xec, 0x30, 0x67,
-	0xc0, 0xb2, 0x4b, 0xfc, 0x71, 0x1e, 0x19, 0x3e, 0x77, 0x79, 0xe2, 0x54, 0x5f, 0x1b, 0x60, 0x3d,
-	0xc6, 0x9b, 0x3a, 0x58, 0x11, 0x1c, 0xd3, 0xac, 0xa2, 0x1e, 0x35, 0xd9, 0xd7, 0x78, 0x34, 0x5d,
-	0x44, 0x65, 0x99, 0xe7, 0x57, 0x49, 0xcf, 0x45, 0x55, 0x44, 0xc3, 0xad, 0xb3, 0x8a, 0xc8, 0xba,
-	0xa1, 0xc0, 0x3e, 0x60, 0x24, 0x40, 0xde, 0x6c, 0x30, 0x98, 0x05, 0x89, 0x3d, 0xbc, 0xaf, 0xea,
-	0x8d, 0xff, 0x86, 0xe0, 0x2f, 0x28, 0xf8, 0xa1, 0x33, 0x05, 0xbf, 0x02, 0x16, 0xfa, 0xa8, 0xdd,
-	0xd3, 0xe0, 0x72, 0x60, 0x5d, 0x02, 0x59, 0x55, 0x4a, 0x8d, 0x57, 0x3a, 0x64, 0x11, 0xfc, 0x2b,
-	0xb4, 0x4f, 0x41, 0x40, 0x90, 0xe4, 0xb5, 0x2f, 0x76, 0x2d, 0x57, 0xc5, 0xbf, 0x75, 0x0f, 0x40,
-	0x61, 0xb8, 0x3b, 0xb8, 0x49, 0x3c, 0xaa, 0x21, 0x20, 0x48, 0x8a, 0x1b, 0x23, 0xfd, 0x8b, 0x7f,
-	0x78, 0x15, 0x80, 0xd1, 0xbb, 0x22, 0xce, 0x96, 0xd9, 0x39, 0x6b, 0xcb, 0xa2, 0xb5, 0xf9, 0x23,
-	0x64, 0xcb, 0xf7, 0x4a, 0x3d, 0x42, 0xf6, 0xed, 0x51, 0xa8, 0xaa, 0xa1, 0x9d, 0x21, 0x92, 0x5f,
-	0x19, 0x2a, 0xb0, 0x1a, 0x5c, 0xf1, 0x3c, 0x07, 0x92, 0x6d, 0xe2, 0xf1, 0xd3, 0x25, 0x4a, 0x99,
-	0x9d, 0x13, 0xf6, 0xf8, 0xd3, 0x67, 0xdf, 0x24, 0x5e, 0x55, 0x98, 0xc0, 0x6b, 0x53, 0x48, 0x15,
-	0x67, 0x92, 0x92, 0x38, 0x61, 0x56, 0xd6, 0x8a, 0x8a, 0xc3, 0x6d, 0x14, 0xa0, 0x8e, 0x8e, 0x83,
-	0x75, 0x4b, 0x11, 0xd4, 0xb3, 0x8a, 0xe0, 0x25, 0xb0, 0xd8, 0x15, 0x33, 0x22, 0x40, 0x99, 0x9d,
-	0xdc, 0x24, 0x45, 0xb9, 0xa3, 0x9c, 0x7c, 0xf2, 0xac, 0x30, 0x57, 0x55, 0xd6, 0xd6, 0x0f, 0x06,
-	0x38, 0x7a, 0x85, 0x35, 0x2b, 0xa8, 0xdd, 0x0e, 0x45, 0x1a, 0x05, 0x1e, 0xd5, 0x39, 0xe1, 0xff,
-	0xf0, 0x14, 0x48, 0x79, 0x88, 0xd6, 0x5c, 0xd4, 0x55, 0xd7, 0x63, 0xd1, 0x43, 0xb4, 0x82, 0xba,
-	0xf0, 0x63, 0x90, 0xed, 0x06, 0xa4, 0x4b, 0x28, 0x0e, 0x86, 0x57, 0x8c, 0x5f, 0x8f, 0xe5, 0xf2,
-	0xce, 0x1f, 0xcf, 0x0a, 0xb6, 0xd7, 0x62, 0xcd, 0x5e, 0xdd, 0x76, 0x49, 0xc7, 0x51, 0xbd, 0x41,
-	0x7e, 0xb6, 0x68, 0x63, 0xcf, 0x61, 0xfb, 0x5d, 0x4c, 0xed, 0xca, 0xe8, 0x6e, 0x57, 0x8f, 0x69,
-	0x5f, 0xfa, 0x5e, 0xae, 0x82, 0xb4, 0xdb, 0x44, 0x2d, 0xbf, 0xd6, 0x6a, 0xe4, 0x92, 0x1b, 0x46,
-	0x29, 0x51, 0x4d, 0x89, 0xf1, 0xf5, 0x86, 0x55, 0x04, 0xc7, 0xaf, 0x50, 0xd6, 0xea, 0x20, 0x86,
-	0xaf, 0xa1, 0x51, 0x20, 0xb2, 0x20, 0xe1, 0x21, 0x49, 0x3e, 0x59, 0xe5, 0xbf, 0xd6, 0xf3, 0x84,
-	0xce, 0x69, 0x80, 0x5c, 0xbc, 0x3b, 0xd0, 0xe7, 0xdc, 0x06, 0x89, 0x0e, 0xf5, 0x54, 0xbc, 0x0a,
-	0x93, 0xf1, 0xba, 0x45, 0xbd, 0x2b, 0x7c, 0x0e, 0xf7, 0x3a, 0xbb, 0x83, 0x2a, 0xb7, 0x85, 0x6f,
-	0x83, 0x65, 0xc6, 0x9d, 0xd4, 0x5c, 0xe2, 0xdf, 0x69, 0x79, 0xe2, 0xa4, 0x99, 0x9d, 0xf5, 0xc9,
-	0xbd, 0x02, 0xaa, 0x22, 0x8c, 0xaa, 0x19, 0x36, 0x1a, 0xc0, 0x0a, 0x58, 0xee, 0x06, 0xb8, 0x81,
-	0x5d, 0x4c, 0x29, 0x09, 0x68, 0x2e, 0x29, 0x0a, 0x6a, 0x26, 0x7a, 0x64, 0x13, 0x7f, 0x25, 0xeb,
-	0x6d, 0xe2, 0xee, 0xe9, 0xf7, 0x68, 0x41, 0x44, 0x26, 0x23, 0xe6, 0xe4, 0x6b, 0x04, 0xd7, 0x01,
-	0x90, 0x26, 0xe2, 0xd2, 0x2c, 0x8a, 0x4b, 0xb3, 0x24, 0x66, 0x44, 0x9f, 0xa9, 0xe8, 0x65, 0xde,
-	0x0a, 0x73, 0x29, 0x71, 0x0c, 0xd3, 0x96, 0x7d, 0xd2, 0xd6, 0x7d, 0xd2, 0xde, 0xd5, 0x7d, 0xb2,
-	0x9c, 0xe6, 0x45, 0xf3, 0xe8, 0xd7, 0x82, 0xa1, 0x9c, 0xf0, 0x95, 0xa9, 0xb9, 0x4f, 0xff, 0x3d,
-	0xb9, 0x5f, 0x8a, 0xe4, 0xfe, 0xdd, 0x64, 0x7a, 0x3e, 0x9b, 0xa8, 0xa6, 0xd9, 0xa0, 0xd6, 0xf2,
-	0x1b, 0x78, 0x60, 0x9d, 0x57, 0x2f, 0xd8, 0x30, 0xc3, 0xa3, 0xe7, 0xa5, 0x81, 0x18, 0xd2, 0xa5,
-	0xcc, 0xff, 0xad, 0x6f, 0x12, 0xe0, 0xe4, 0xc8, 0xb8, 0xcc, 0x4f, 0x13, 0xaa, 0x08, 0x36, 0xd0,
-	0x97, 0x7c, 0x76, 0x45, 0xb0, 0x01, 0x7d, 0x0d, 0x15, 0xf1, 0x4f, 0x4f, 0xa6, 0xb5, 0x05, 0x4e,
-	0x4d, 0xe4, 0xe3, 0x80, 0xfc, 0x9d, 0x18, 0xf6, 0x59, 0x8a, 0xaf, 0x62, 0xfd, 0x9e, 0x5b, 0x37,
-	0x87, 0x3d, 0x54, 0x4d, 0x2b, 0x17, 0xff, 0x07, 0x69, 0xfe, 0xe8, 0xd6, 0xee, 0x60, 0xd5, 0xc7,
-	0xca, 0xab, 0xbf, 0x3c, 0x2b, 0x9c, 0x90, 0xec, 0x69, 0x63, 0xcf, 0x6e, 0x11, 0xa7, 0x83, 0x58,
-	0xd3, 0xbe, 0xee, 0x33, 0xde, 0x5f, 0xc5, 0xee, 0x9d, 0x1f, 0x97, 0xc1, 0x82, 0x70, 0x07, 0x3f,
-	0x35, 0x40, 0x4a, 0xc9, 0x0a, 0xb8, 0x39, 0x99, 0xd6, 0x29, 0xba, 0xd1, 0x3c, 0x3b, 0xcb, 0x4c,
-	0x52, 0xb3, 0x8a, 0x0f, 0x7f, 0xfa, 0xed, 0xdb, 0xf9, 0x33, 0xb0, 0xc0, 0x55, 0x2e, 0xa1, 0x5a,
-	0xeb, 0x2a, 0x59, 0xe1, 0xdc, 0x57, 0x69, 0x78, 0x00, 0xbf, 0x33, 0xc0, 0x91, 0x88, 0x72, 0x83,
-	0xff, 0x8d, 0x81, 0x98, 0xa6, 0x10, 0xcd, 0x0b, 0x87, 0x33, 0x56, 0xac, 0x6c, 0xc1, 0xaa, 0x04,
-	0xcf, 0x46, 0x59, 0x69, 0x81, 0x38, 0x41, 0xee, 0x7b, 0x03, 0x64, 0xc7, 0x05, 0x18, 0xb4, 0x63,
-	0x20, 0x63, 0x74, 0x9f, 0xe9, 0x1c, 0xda, 0x5e, 0xb1, 0xbc, 0x24, 0x58, 0x5e, 0x84, 0x76, 0x94,
-	0x65, 0x5f, 0xdb, 0x8f, 0x88, 0x86, 0xf5, 0xe4, 0x03, 0xf8, 0xd0, 0x00, 0x29, 0x25, 0xb3, 0x62,
-	0xd3, 0x19, 0x55, 0x70, 0xb1, 0xe9, 0x1c, 0x53, 0x6b, 0x56, 0x49, 0x50, 0xb2, 0xe0, 0x46, 0x94,
-	0x92, 0x92, 0x6c, 0x34, 0x14, 0xb2, 0x2f, 0x0c, 0x90, 0x52, 0x62, 0x2b, 0x96, 0x44, 0x54, 0xd9,
-	0xc5, 0x92, 0x18, 0xd3, 0x6c, 0xd6, 0x96, 0x20, 0x51, 0x84, 0x9b, 0x51, 0x12, 0x54, 0x9a, 0x8d,
-	0x38, 0x38, 0xf7, 0xf7, 0xf0, 0xfe, 0x03, 0xd8, 0x07, 0x49, 0xae, 0xc7, 0xa0, 0x15, 0x5b, 0x22,
-	0x43, 0x91, 0x67, 0xfe, 0xfb, 0x40, 0x1b, 0x85, 0xbf, 0x29, 0xf0, 0x0b, 0x70, 0x7d, 0xbc, 0x7a,
-	0x1a, 0x91, 0x08, 0x50, 0xb0, 0x28, 0xe5, 0x08, 0xfc, 0x4f, 0x8c, 0xd7, 0x88, 0xea, 0x31, 0x37,
-	0x67, 0x58, 0x29, 0xf4, 0x35, 0x81, 0x7e, 0x12, 0xae, 0x44, 0xd1, 0xa5, 0xd6, 0x81, 0x0c, 0xa4,
-	0x94, 0xd4, 0x81, 0x1b, 0x93, 0xfe, 0xa2, 0x2a, 0xc8, 0x2c, 0xce, 0x7a, 0xfe, 0x35, 0x66, 0x5e,
-	0x60, 0xe6, 0xe0, 0xc9, 0x28, 0x26, 0x66, 0xcd, 0x9a, 0xcb, 0xa1, 0xee, 0x81, 0x4c, 0x48, 0xa7,
-	0x1c, 0x02, 0x79, 0xca, 0x59, 0xa7, 0x08, 0x1d, 0xcb, 0x12, 0xb8, 0x6b, 0xd0, 0x1c, 0xc3, 0x55,
-	0xa6, 0x35, 0x0f, 0x51, 0x38, 0x00, 0x29, 0xd5, 0x12, 0x63, 0xeb, 0x2c, 0x2a, 0x8a, 0x62, 0xeb,
-	0x6c, 0xac, 0xb3, 0xc6, 0x9d, 0x5a, 0xf6, 0x42, 0x36, 0x80, 0x9f, 0x19, 0x00, 0x8c, 0x1e, 0x74,
-	0x58, 0x3a, 0xc8, 0x6d, 0xb8, 0x07, 0x9b, 0xe7, 0x0e, 0x61, 0xa9, 0x38, 0x9c, 0x11, 0x1c, 0x4e,
-	0xc3, 0xd5, 0x69, 0x1c, 0x44, 0x67, 0xe3, 0x01, 0x50, 0x0d, 0xe1, 0x80, 0xdb, 0x1e, 0xee, 0x23,
-	0x07, 0xdc, 0xf6, 0x48, 0x5f, 0x89, 0x0b, 0x80, 0xee, 0x35, 0xe5, 0xeb, 0x4f, 0x5e, 0xe4, 0x8d,
-	0xa7, 0x2f, 0xf2, 0xc6, 0xf3, 0x17, 0x79, 0xe3, 0xd1, 0xcb, 0xfc, 0xdc, 0xd3, 0x97, 0xf9, 0xb9,
-	0x9f, 0x5f, 0xe6, 0xe7, 0x3e, 0x72, 0x42, 0xbd, 0xb4, 0x89, 0x59, 0x6f, 0xab, 0x1b, 0x90, 0x4f,
-	0xb0, 0xcb, 0xe4, 0xa0, 0xd9, 0xab, 0x73, 0x3f, 0x03, 0xe1, 0x50, 0x34, 0xd6, 0xfa, 0xa2, 0xe8,
-	0xe1, 0xff, 0xfb, 0x33, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xff, 0x7a, 0x8b, 0x8f, 0x11, 0x00, 0x00,
+	// 1526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcd, 0x6f, 0x13, 0xd7,
+	0x16, 0xcf, 0xc4, 0x4e, 0xec, 0x1c, 0x27, 0x90, 0x77, 0x63, 0xc0, 0x19, 0x92, 0x38, 0x0c, 0x2f,
+	0x1f, 0x7c, 0x64, 0x86, 0x84, 0x27, 0xa4, 0xf7, 0x36, 0xaf, 0x24, 0x0a, 0x94, 0x02, 0x15, 0x9d,
+	0x46, 0x5d, 0x54, 0xaa, 0xac, 0xeb, 0xf1, 0x65, 0x6c, 0xc5, 0x9e, 0x6b, 0xe6, 0x5e, 0xbb, 0x0e,
+	0x88, 0x45, 0x51, 0xd5, 0x0f, 0x55, 0xaa, 0xa8, 0xba, 0xeb, 0x8a, 0x7d, 0xff, 0x80, 0xfe, 0x0b,
+	0x2c, 0x91, 0xd8, 0x54, 0x5d, 0x50, 0x04, 0x5d, 0xf4, 0x6f, 0xe8, 0xaa, 0xba, 0x1f, 0x13, 0xcf,
+	0xc4, 0x76, 0x1c, 0x2a, 0xba, 0xea, 0x6a, 0xee, 0x3d, 0xf7, 0xdc, 0x73, 0x7e, 0xf7, 0x9c, 0x33,
+	0xe7, 0xfc, 0x60, 0x8e, 0xf0, 0x2a, 0x09, 0x1b, 0xb5, 0x80, 0x3b, 0xa4, 0xdd, 0x70, 0xda, 0xeb,
+	0xce, 0xbd, 0x16, 0x09, 0xf7, 0xec, 0x66, 0x48, 0x39, 0x45, 0xd3, 0xfb, 0xa7, 0x36, 0x69, 0x37,
+	0xec, 0xf6, 0xba, 0x79, 0xde, 0xa3, 0xac, 0x41, 0x99, 0x53, 0xc6, 0x8c, 0x28, 0x55, 0xa7, 0xbd,
+	0x5e, 0x26, 0x1c, 0xaf, 0x3b, 0x4d, 0xec, 0xd7, 0x02, 0xcc, 0x6b, 0x34, 0x50, 0xb7, 0x4d, 0xb3,
+	0xc7, 0xb6, 0x30, 0xa2, 0xce, 0x66, 0x7b, 0xce, 0x78, 0x47, 0x1f, 0xe5, 0x7d, 0xea, 0x53, 0xb9,
+	0x74, 0xc4, 0x4a, 0x4b, 0xe7, 0x7c, 0x4a, 0xfd, 0x3a, 0x71, 0x70, 0xb3, 0xe6, 0xe0, 0x20, 0xa0,
+	0x5c, 0x7a, 0x62, 0xfa, 0xb4, 0xa8, 0x4f, 0xe5, 0xae, 0xdc, 0xba, 0xeb, 0xf0, 0x5a, 0x83, 0x30,
+	0x8e, 0x1b, 0x4d, 0xa5, 0x60, 0xfd, 0x17, 0x66, 0x3e, 0x10, 0x68, 0xaf, 0x7a, 0x1e, 0x6d, 0x05,
+	0xdc, 0x25, 0xf7, 0x5a, 0x84, 0x71, 0x54, 0x80, 0x0c, 0xae, 0x54, 0x42, 0xc2, 0x58, 0xc1, 0x58,
+	0x34, 0x56, 0x27, 0xdc, 0x68, 0xfb, 0xbf, 0xec, 0x57, 0x4f, 0x8a, 0x23, 0xbf, 0x3f, 0x29, 0x8e,
+	0x58, 0x1e, 0xe4, 0x93, 0x57, 0x59, 0x93, 0x06, 0x8c, 0x88, 0xbb, 0x65, 0x5c, 0xc7, 0x81, 0x47,
+	0xa2, 0xbb, 0x7a, 0x8b, 0x4e, 0xc3, 0x84, 0x47, 0x2b, 0xa4, 0x54, 0xc5, 0xac, 0x5a, 0x18, 0x95,
+	0x67, 0x59, 0x21, 0x78, 0x17, 0xb3, 0x2a, 0xca, 0xc3, 0x58, 0x40, 0xc5, 0xa5, 0xd4, 0xa2, 0xb1,
+	0x9a, 0x76, 0xd5, 0xc6, 0xfa, 0x3f, 0xcc, 0x4a, 0x27, 0x5b, 0x32, 0xbc, 0x7f, 0x01, 0xe5, 0x17,
+	0x06, 0x98, 0xfd, 0x2c, 0x68, 0xb0, 0x4b, 0x70, 0x4c, 0x65, 0xae, 0x94, 0xb4, 0x34, 0xa5, 0xa4,
+	0x57, 0x95, 0x10, 0x99, 0x90, 0x65, 0xc2, 0xa9, 0xc0, 0x37, 0x2a, 0xf1, 0xed, 0xef, 0x85, 0x09,
+	0xac, 0xac, 0x96, 0x82, 0x56, 0xa3, 0x4c, 0x42, 0xfd, 0x82, 0x29, 0x2d, 0x7d, 0x5f, 0x0a, 0xad,
+	0x9b, 0x30, 0x27, 0x71, 0x7c, 0x84, 0xeb, 0xb5, 0x0a, 0xe6, 0x34, 0x3c, 0xf0, 0x98, 0x33, 0x30,
+	0xe9, 0xd1, 0xe0, 0x20, 0x8e, 0x9c, 0x90, 0x5d, 0xed, 0x79, 0xd5, 0x37, 0x06, 0xcc, 0x0f, 0xb0,
+	0xa6, 0x1f, 0xb6, 0x02, 0xc7, 0x23, 0x54, 0x49, 0x8b, 0x11, 0xd8, 0xb7, 0xf8, 0xb4, 0xa8, 0x88,
+	0x36, 0x55, 0x9e, 0xdf, 0x24, 0x3d, 0x97, 0x20, 0x9f, 0xbc, 0x3a, 0xac, 0x88, 0xac, 0x9b, 0xda,
+	0xd9, 0x87, 0x9c, 0x86, 0xd8, 0x1f, 0xee, 0x0c, 0x4d, 0x43, 0x6a, 0x97, 0xec, 0xe9, 0x7a, 0x13,
+	0xcb, 0x98, 0xfb, 0x8b, 0x90, 0x4f, 0x1a, 0xd3, 0xee, 0xf3, 0x30, 0xd6, 0xc6, 0xf5, 0x56, 0xe4,
+	0x5c, 0x6d, 0xac, 0x2b, 0x30, 0xad, 0x4b, 0xa9, 0xf2, 0x46, 0x8f, 0x5c, 0x81, 0x7f, 0xc5, 0xee,
+	0x69, 0x17, 0x08, 0xd2, 0xa2, 0xf6, 0xe5, 0xad, 0x49, 0x57, 0xae, 0xad, 0xfb, 0x80, 0xa4, 0xe2,
+	0x4e, 0xe7, 0x16, 0xf5, 0x59, 0xe4, 0x02, 0x41, 0x5a, 0xfe, 0x31, 0xca, 0xbe, 0x5c, 0xa3, 0x6b,
+	0x00, 0xdd, 0xbe, 0x22, 0xdf, 0x96, 0xdb, 0x58, 0xb6, 0x55, 0xd1, 0xda, 0xa2, 0x09, 0xd9, 0xaa,
+	0x5f, 0xe9, 0x26, 0x64, 0xdf, 0xe9, 0x86, 0xca, 0x8d, 0xdd, 0x8c, 0x81, 0xfc, 0xda, 0x80, 0x99,
+	0x84, 0x73, 0x8d, 0xf3, 0x1c, 0xa4, 0xeb, 0xd4, 0x17, 0xaf, 0x4b, 0xad, 0xe6, 0x36, 0x4e, 0xd8,
+	0x07, 0x5b, 0x9f, 0x7d, 0x8b, 0xfa, 0xae, 0x54, 0x41, 0xd7, 0xfb, 0x80, 0x5a, 0x19, 0x0a, 0x4a,
+	0xf9, 0x89, 0xa3, 0xb2, 0xf2, 0x3a, 0x0e, 0x77, 0x70, 0x88, 0x1b, 0x51, 0x1c, 0xac, 0xdb, 0x30,
+	0x93, 0x90, 0x6a, 0x80, 0x57, 0x60, 0xbc, 0x29, 0x25, 0x32, 0x40, 0xb9, 0x8d, 0x42, 0x2f, 0x44,
+	0x75, 0x63, 0x33, 0xfd, 0xf4, 0x45, 0x71, 0xc4, 0xd5, 0xda, 0xd6, 0x65, 0x38, 0xa5, 0xcc, 0x09,
+	0x5d, 0xc6, 0x44, 0xd7, 0x1c, 0x9a, 0x54, 0x6b, 0x07, 0x0a, 0xbd, 0x97, 0x34, 0x90, 0x79, 0x00,
+	0x0f, 0x07, 0x25, 0x2f, 0x24, 0x98, 0xab, 0xbc, 0x66, 0xdd, 0x09, 0x0f, 0x07, 0x5b, 0x52, 0x80,
+	0x66, 0x21, 0x2b, 0x8f, 0x71, 0xbd, 0x2e, 0x63, 0x93, 0x75, 0x33, 0xe2, 0x10, 0xd7, 0xeb, 0xd6,
+	0x4f, 0x06, 0x1c, 0xdb, 0xe6, 0x55, 0xb1, 0x8e, 0x25, 0x1d, 0x87, 0x3e, 0x8b, 0xca, 0x43, 0xac,
+	0xd1, 0x29, 0xc8, 0xf8, 0x98, 0x95, 0x3c, 0xdc, 0xd4, 0x7f, 0xea, 0xb8, 0x8f, 0xd9, 0x16, 0x6e,
+	0xa2, 0x4f, 0x60, 0xba, 0x19, 0xd2, 0x26, 0x65, 0x24, 0xdc, 0xff, 0xdb, 0xc5, 0x9f, 0x3a, 0xb9,
+	0xb9, 0xf1, 0xc7, 0x8b, 0xa2, 0xed, 0xd7, 0x78, 0xb5, 0x55, 0xb6, 0x3d, 0xda, 0x70, 0xf4, 0x98,
+	0x52, 0x9f, 0x35, 0x56, 0xd9, 0x75, 0xf8, 0x5e, 0x93, 0x30, 0x7b, 0xab, 0xdb, 0x66, 0xdc, 0xe3,
+	0x91, 0x2d, 0x2d, 0x90, 0xc8, 0xab, 0xb8, 0x16, 0x94, 0x6a, 0x95, 0x42, 0x7a, 0xd1, 0x58, 0x4d,
+	0xb9, 0x19, 0xb9, 0xbf, 0x51, 0xb1, 0x56, 0x60, 0x66, 0x9b, 0xf1, 0x5a, 0x03, 0x73, 0x72, 0x1d,
+	0x77, 0x43, 0x31, 0x0d, 0x29, 0x1f, 0x2b, 0xf0, 0x69, 0x57, 0x2c, 0xad, 0x97, 0xa9, 0xa8, 0xbc,
+	0x42, 0xec, 0x91, 0x9d, 0x4e, 0xf4, 0xce, 0x75, 0x48, 0x35, 0x98, 0xaf, 0x53, 0x57, 0xec, 0x4d,
+	0xdd, 0x6d, 0xe6, 0x6f, 0x0b, 0x19, 0x69, 0x35, 0x76, 0x3a, 0xae, 0xd0, 0x45, 0xef, 0xc0, 0x24,
+	0x17, 0x46, 0x4a, 0x1e, 0x0d, 0xee, 0xd6, 0x7c, 0xf9, 0xd2, 0xdc, 0xc6, 0x7c, 0xef, 0x5d, 0xe9,
+	0x6a, 0x4b, 0x2a, 0xb9, 0x39, 0xde, 0xdd, 0xa0, 0x2d, 0x98, 0x6c, 0x86, 0xa4, 0x42, 0x3c, 0xc2,
+	0x18, 0x0d, 0x59, 0x21, 0xbd, 0x98, 0x3a, 0x8a, 0xf7, 0xc4, 0x25, 0xd1, 0xb0, 0xcb, 0x75, 0xea,
+	0xed, 0x46, 0xad, 0x71, 0x4c, 0x46, 0x26, 0x27, 0x65, 0xaa, 0x31, 0x8a, 0x8a, 0x50, 0x2a, 0xf2,
+	0xff, 0x1d, 0x97, 0xa5, 0x34, 0x21, 0x25, 0x72, 0xe4, 0x6d, 0x45, 0xc7, 0x62, 0x2a, 0x17, 0x32,
+	0xf2, 0x19, 0xa6, 0xad, 0x46, 0xb6, 0x1d, 0x8d, 0x6c, 0x7b, 0x27, 0x1a, 0xd9, 0x9b, 0x59, 0x51,
+	0xbf, 0x8f, 0x7f, 0x2d, 0x1a, 0xda, 0x88, 0x38, 0xe9, 0x9b, 0xfb, 0xec, 0xdf, 0x93, 0xfb, 0x89,
+	0x44, 0xee, 0xdf, 0x4b, 0x67, 0x47, 0xa7, 0x53, 0x6e, 0x96, 0x77, 0x4a, 0xb5, 0xa0, 0x42, 0x3a,
+	0xd6, 0x79, 0xdd, 0x4c, 0xf7, 0x33, 0xdc, 0xed, 0x74, 0x15, 0xcc, 0x71, 0x54, 0xca, 0x62, 0x6d,
+	0x7d, 0x9b, 0x82, 0x93, 0x5d, 0xe5, 0x4d, 0xf1, 0x9a, 0x58, 0x45, 0xf0, 0x4e, 0xd4, 0x6f, 0x86,
+	0x57, 0x04, 0xef, 0xb0, 0xb7, 0x50, 0x11, 0xff, 0xf4, 0x64, 0x5a, 0x6b, 0xba, 0x1b, 0xc6, 0xf3,
+	0x71, 0x48, 0xfe, 0x4e, 0xec, 0x8f, 0x7c, 0x46, 0xae, 0x91, 0x68, 0xb4, 0x58, 0xb7, 0x20, 0x9f,
+	0x14, 0x6b, 0x13, 0xff, 0x81, 0xac, 0xe8, 0xff, 0xa5, 0xbb, 0x44, 0x8f, 0xd4, 0xcd, 0xd9, 0x5f,
+	0x5e, 0x14, 0x4f, 0x28, 0xf4, 0xac, 0xb2, 0x6b, 0xd7, 0xa8, 0xd3, 0xc0, 0xbc, 0x6a, 0xdf, 0x08,
+	0xb8, 0x18, 0xf5, 0xf2, 0xf6, 0xc6, 0xf3, 0x29, 0x18, 0x93, 0xe6, 0xd0, 0x67, 0x06, 0x64, 0x34,
+	0xc3, 0x41, 0x4b, 0xbd, 0x69, 0xed, 0x43, 0x61, 0xcd, 0xe5, 0x61, 0x6a, 0x0a, 0x9a, 0xb5, 0xf2,
+	0xe8, 0xf9, 0x6f, 0xdf, 0x8f, 0x9e, 0x41, 0x45, 0x41, 0xb8, 0x29, 0x8b, 0x68, 0xb7, 0x66, 0x38,
+	0xce, 0x03, 0x9d, 0x86, 0x87, 0xe8, 0x07, 0x03, 0xa6, 0x12, 0x24, 0x12, 0x5d, 0x18, 0xe0, 0xa2,
+	0x1f, 0x59, 0x35, 0x2f, 0x1e, 0x4d, 0x59, 0xa3, 0xb2, 0x25, 0xaa, 0x55, 0xb4, 0x9c, 0x44, 0x15,
+	0x71, 0xd5, 0x1e, 0x70, 0x3f, 0x1a, 0x30, 0x7d, 0x90, 0x0b, 0x22, 0x7b, 0x80, 0xcb, 0x01, 0x14,
+	0xd4, 0x74, 0x8e, 0xac, 0xaf, 0x51, 0x5e, 0x91, 0x28, 0x2f, 0x21, 0x3b, 0x89, 0xb2, 0x1d, 0xe9,
+	0x77, 0x81, 0xc6, 0xa9, 0xed, 0x43, 0xf4, 0xc8, 0x80, 0x8c, 0x66, 0x7c, 0x03, 0xd3, 0x99, 0x24,
+	0x93, 0xe6, 0xf2, 0x30, 0x35, 0x0d, 0x69, 0x55, 0x42, 0xb2, 0xd0, 0x62, 0x12, 0x92, 0x66, 0x8f,
+	0x2c, 0x16, 0xb2, 0x2f, 0x0d, 0xc8, 0x68, 0xde, 0x37, 0x10, 0x44, 0x92, 0x64, 0x9a, 0xcb, 0xc3,
+	0xd4, 0x34, 0x88, 0x35, 0x09, 0x62, 0x05, 0x2d, 0x25, 0x41, 0x30, 0xa5, 0xd6, 0xc5, 0xe0, 0x3c,
+	0xd8, 0x25, 0x7b, 0x0f, 0x51, 0x1b, 0xd2, 0x82, 0x1a, 0x22, 0x6b, 0x60, 0x89, 0xec, 0xf3, 0x4d,
+	0xf3, 0xec, 0xa1, 0x3a, 0xda, 0xff, 0x92, 0xf4, 0x5f, 0x44, 0xf3, 0x07, 0xab, 0xa7, 0x92, 0x88,
+	0x00, 0x83, 0x71, 0xc5, 0x8c, 0xd0, 0xbf, 0x07, 0x58, 0x4d, 0x10, 0x30, 0x73, 0x69, 0x88, 0x96,
+	0xf6, 0x3e, 0x27, 0xbd, 0x9f, 0x44, 0xf9, 0xa4, 0x77, 0x45, 0xbb, 0xd0, 0x77, 0x06, 0xe4, 0x62,
+	0xec, 0x09, 0x9d, 0x1b, 0x64, 0xb4, 0x87, 0x96, 0x99, 0xe7, 0x8f, 0xa2, 0xaa, 0x41, 0x5c, 0x90,
+	0x20, 0x96, 0xd0, 0xd9, 0x03, 0x20, 0xba, 0xaa, 0xb1, 0x40, 0x70, 0xc8, 0x68, 0xfa, 0x85, 0x16,
+	0x7b, 0x7d, 0x24, 0x99, 0x99, 0xb9, 0x32, 0x6c, 0x24, 0x45, 0x10, 0x16, 0x24, 0x84, 0x02, 0x3a,
+	0x99, 0x84, 0x40, 0x78, 0x55, 0x92, 0x40, 0x74, 0x1f, 0x72, 0x31, 0xee, 0x74, 0x04, 0xcf, 0x7d,
+	0xe2, 0xdf, 0x87, 0x7c, 0x59, 0x96, 0xf4, 0x3b, 0x87, 0xcc, 0x03, 0x7e, 0xb5, 0x6a, 0xc9, 0xc7,
+	0x0c, 0x75, 0x20, 0xa3, 0xc7, 0xf4, 0xc0, 0xda, 0x4f, 0x12, 0x35, 0x73, 0x79, 0x98, 0xda, 0xe1,
+	0xaf, 0x56, 0xf3, 0x99, 0x77, 0xd0, 0xe7, 0x06, 0x40, 0x77, 0xc8, 0xa0, 0xd5, 0xc3, 0xcc, 0xc6,
+	0x79, 0x81, 0x79, 0xee, 0x08, 0x9a, 0x1a, 0xc3, 0x19, 0x89, 0xe1, 0x34, 0x9a, 0xed, 0x87, 0x41,
+	0x4e, 0x5b, 0x11, 0x00, 0x3d, 0xa4, 0x0e, 0xe9, 0x40, 0xf1, 0xd9, 0x66, 0x2e, 0x0f, 0x53, 0x3b,
+	0x3c, 0x00, 0xd1, 0xfc, 0xdb, 0xdc, 0x7e, 0xfa, 0x6a, 0xc1, 0x78, 0xf6, 0x6a, 0xc1, 0x78, 0xf9,
+	0x6a, 0xc1, 0x78, 0xfc, 0x7a, 0x61, 0xe4, 0xd9, 0xeb, 0x85, 0x91, 0x9f, 0x5f, 0x2f, 0x8c, 0x7c,
+	0x7c, 0x21, 0x36, 0xdf, 0xeb, 0x74, 0x17, 0xaf, 0x05, 0x84, 0x7f, 0x4a, 0xc3, 0x5d, 0xb9, 0x11,
+	0x36, 0x3a, 0xd2, 0x98, 0x1c, 0xf4, 0xe5, 0x71, 0xc9, 0x29, 0x2e, 0xff, 0x39, 0x00, 0xd7, 0xe0,
+	0x94, 0xca, 0xaa, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1437,6 +1547,9 @@ type QueryClient interface {
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Permissions queries if the permission policies allow an address to create
+	// and call contracts.
+	Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
@@ -1521,6 +1634,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
+	out := new(QueryPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Permissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
 	out := new(MsgEthereumTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EthCall", in, out, opts...)
@@ -1584,6 +1706,9 @@ type QueryServer interface {
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Permissions queries if the permission policies allow an address to create
+	// and call contracts.
+	Permissions(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
@@ -1622,6 +1747,9 @@ func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Permissions(ctx context.Context, req *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Permissions not implemented")
+}
 func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallRequest) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
 }
@@ -1768,6 +1896,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Permissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Permissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Permissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Permissions(ctx, req.(*QueryPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
@@ -1890,6 +2036,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Permissions",
+			Handler:    _Query_Permissions_Handler,
+		},
 		{
 			MethodName: "EthCall",
 			Handler:    _Query_EthCall_Handler,
@@ -2461,6 +2611,79 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanCall {
+		i--
+		if m.CanCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CanCreate {
+		i--
+		if m.CanCreate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3074,6 +3297,34 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanCreate {
+		n += 2
+	}
+	if m.CanCall {
+		n += 2
+	}
+	return n
+}
+
 func (m *EthCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4753,6 +5004,178 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanCreate = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanCall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
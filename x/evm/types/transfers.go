@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.OpCodeHooks = &TransferGuard{}
+
+type valueTransferKey struct{}
+
+// WithValueTransfer returns the context marking the bank transfers executed with it as EVM value
+// transfers, e.g. for the send restrictions of the bank keeper.
+func WithValueTransfer(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(valueTransferKey{}, true)
+}
+
+// IsValueTransfer returns true if the bank transfers executed with the context are EVM value
+// transfers.
+func IsValueTransfer(ctx sdk.Context) bool {
+	ok, _ := ctx.Value(valueTransferKey{}).(bool)
+	return ok
+}
+
+// TransferGuard checks the value transfers of the CALL, CALLCODE, CREATE and CREATE2 opcodes with a
+// TransferChecker before they're executed. The opcode hooks record the recipient which is checked
+// along with the value by CanTransfer, called by the EVM right before the transfer, so a denied
+// transfer fails the call or the creation instead of the whole transaction. The recipient of the
+// CREATE and CREATE2 opcodes isn't known by the hooks, it's left to the bank send restrictions,
+// and the one of CALLCODE is the called address even though the value stays with the caller.
+type TransferGuard struct {
+	vm.OpCodeHooks
+	ctx       sdk.Context
+	checker   TransferChecker
+	denom     string
+	recipient *common.Address
+}
+
+// NewTransferGuard returns the TransferGuard checking the value transfers of the EVM in the evm
+// denom, the opcode hooks are wrapped to record the recipients.
+func NewTransferGuard(ctx sdk.Context, checker TransferChecker, denom string, hooks vm.OpCodeHooks) *TransferGuard {
+	return &TransferGuard{
+		OpCodeHooks: hooks,
+		ctx:         ctx,
+		checker:     checker,
+		denom:       denom,
+	}
+}
+
+// CallHook implements vm.OpCodeHooks, it records the recipient of the call.
+func (g *TransferGuard) CallHook(evm *vm.EVM, caller, recipient common.Address) error {
+	g.recipient = &recipient
+	return g.OpCodeHooks.CallHook(evm, caller, recipient)
+}
+
+// CreateHook implements vm.OpCodeHooks, it resets the recipient as the created address isn't known.
+func (g *TransferGuard) CreateHook(evm *vm.EVM, caller common.Address) error {
+	g.recipient = nil
+	return g.OpCodeHooks.CreateHook(evm, caller)
+}
+
+// CanTransfer implements vm.CanTransferFunc, it checks the balance of the sender and the transfer
+// of the amount to the recorded recipient with the TransferChecker.
+func (g *TransferGuard) CanTransfer(db vm.StateDB, sender common.Address, amount *big.Int) bool {
+	if !core.CanTransfer(db, sender, amount) {
+		return false
+	}
+	if amount.Sign() == 0 {
+		return true
+	}
+
+	var recipient sdk.AccAddress
+	if g.recipient != nil {
+		recipient = g.recipient.Bytes()
+	}
+	coins := sdk.Coins{sdk.NewCoin(g.denom, sdkmath.NewIntFromBigInt(amount))}
+	return g.checker.CheckEVMTransfer(g.ctx, sender.Bytes(), recipient, coins) == nil
+}
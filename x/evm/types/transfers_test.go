@@ -0,0 +1,128 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTransferChecker denies the transfers to the denied address and records the checked ones
+type mockTransferChecker struct {
+	denied  common.Address
+	checked []sdk.AccAddress
+}
+
+func (c *mockTransferChecker) CheckEVMTransfer(_ sdk.Context, _, to sdk.AccAddress, coins sdk.Coins) error {
+	c.checked = append(c.checked, to)
+	if coins.AmountOf("aloka").Sign() <= 0 {
+		return errors.New("invalid amount")
+	}
+	if common.BytesToAddress(to) == c.denied && !to.Empty() {
+		return errors.New("denied")
+	}
+	return nil
+}
+
+func TestValueTransferContext(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	require.False(t, IsValueTransfer(ctx))
+	require.True(t, IsValueTransfer(WithValueTransfer(ctx)))
+}
+
+func TestTransferGuard(t *testing.T) {
+	sender := common.HexToAddress("0x1000")
+	allowed := common.HexToAddress("0x2000")
+	denied := common.HexToAddress("0x3000")
+	caller := common.HexToAddress("0x4000")
+
+	// callerCode calls the recipient with 1 wei and stores the result of the call in the slot 0
+	callerCode := func(recipient common.Address) []byte {
+		code := []byte{
+			byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+			byte(vm.PUSH1), 1, byte(vm.PUSH20),
+		}
+		code = append(code, recipient.Bytes()...)
+		return append(code,
+			byte(vm.PUSH2), 0xff, 0xff, byte(vm.CALL),
+			byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+		)
+	}
+
+	setup := func(t *testing.T) (*vm.EVM, *state.StateDB, *mockTransferChecker) {
+		db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		require.NoError(t, err)
+		db.AddBalance(sender, big.NewInt(10))
+		db.AddBalance(caller, big.NewInt(10))
+
+		checker := &mockTransferChecker{denied: denied}
+		guard := NewTransferGuard(sdk.Context{}, checker, "aloka", vm.NewDefaultOpCodeHooks())
+		blockCtx := vm.BlockContext{
+			CanTransfer: guard.CanTransfer,
+			Transfer:    core.Transfer,
+			BlockNumber: big.NewInt(1),
+		}
+		return vm.NewEVMWithHooks(guard, blockCtx, vm.TxContext{}, db, params.TestChainConfig, vm.Config{}), db, checker
+	}
+
+	t.Run("call", func(t *testing.T) {
+		evm, db, checker := setup(t)
+		_, _, err := evm.Call(vm.AccountRef(sender), allowed, nil, 100000, big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1), db.GetBalance(allowed))
+
+		_, _, err = evm.Call(vm.AccountRef(sender), denied, nil, 100000, big.NewInt(1))
+		require.ErrorIs(t, err, vm.ErrInsufficientBalance)
+		require.Equal(t, big.NewInt(0), db.GetBalance(denied))
+		require.Equal(t, big.NewInt(9), db.GetBalance(sender))
+
+		// the value-less calls aren't checked
+		_, _, err = evm.Call(vm.AccountRef(sender), denied, nil, 100000, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, []sdk.AccAddress{allowed.Bytes(), denied.Bytes()}, checker.checked)
+	})
+
+	t.Run("sub call", func(t *testing.T) {
+		evm, db, _ := setup(t)
+		db.PrepareAccessList(sender, &caller, nil, nil)
+		db.SetCode(caller, callerCode(allowed))
+		_, _, err := evm.Call(vm.AccountRef(sender), caller, nil, 100000, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, common.BigToHash(big.NewInt(1)), db.GetState(caller, common.Hash{}))
+		require.Equal(t, big.NewInt(1), db.GetBalance(allowed))
+
+		// the denied sub call fails without failing the call of the caller
+		db.SetCode(caller, callerCode(denied))
+		_, _, err = evm.Call(vm.AccountRef(sender), caller, nil, 100000, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, common.Hash{}, db.GetState(caller, common.Hash{}))
+		require.Equal(t, big.NewInt(0), db.GetBalance(denied))
+		require.Equal(t, big.NewInt(9), db.GetBalance(caller))
+	})
+
+	t.Run("create", func(t *testing.T) {
+		evm, _, checker := setup(t)
+		_, _, _, err := evm.Create(vm.AccountRef(sender), nil, 100000, big.NewInt(1))
+		require.NoError(t, err)
+		// the created address isn't known by the guard
+		require.Equal(t, []sdk.AccAddress{nil}, checker.checked)
+	})
+
+	t.Run("create after call", func(t *testing.T) {
+		evm, _, checker := setup(t)
+		_, _, err := evm.Call(vm.AccountRef(sender), allowed, nil, 100000, big.NewInt(1))
+		require.NoError(t, err)
+		_, _, _, err = evm.Create(vm.AccountRef(sender), nil, 100000, big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, []sdk.AccAddress{allowed.Bytes(), nil}, checker.checked)
+	})
+}
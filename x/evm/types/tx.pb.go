@@ -441,6 +441,101 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetFeeDenom defines a Msg for an account to opt in paying the fees of the Ethereum
+// transactions it sends or sponsors in an alternative fee denomination.
+type MsgSetFeeDenom struct {
+	// sender is the address of the account paying the fees.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the alternative fee denomination registered in the parameters, the fees are paid
+	// in evm_denom again when it's empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgSetFeeDenom) Reset()         { *m = MsgSetFeeDenom{} }
+func (m *MsgSetFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeDenom) ProtoMessage()    {}
+func (*MsgSetFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgSetFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeDenom.Merge(m, src)
+}
+func (m *MsgSetFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeDenom proto.InternalMessageInfo
+
+func (m *MsgSetFeeDenom) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetFeeDenomResponse defines the response structure for executing a
+// MsgSetFeeDenom message.
+type MsgSetFeeDenomResponse struct {
+}
+
+func (m *MsgSetFeeDenomResponse) Reset()         { *m = MsgSetFeeDenomResponse{} }
+func (m *MsgSetFeeDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeDenomResponse) ProtoMessage()    {}
+func (*MsgSetFeeDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgSetFeeDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeDenomResponse.Merge(m, src)
+}
+func (m *MsgSetFeeDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeDenomResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -450,75 +545,81 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetFeeDenom)(nil), "ethermint.evm.v1.MsgSetFeeDenom")
+	proto.RegisterType((*MsgSetFeeDenomResponse)(nil), "ethermint.evm.v1.MsgSetFeeDenomResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0xda, 0xeb, 0x5f, 0x63, 0x13, 0x8e, 0x55, 0xa2, 0xac, 0x0d, 0x78, 0x7d, 0x4b, 0x81,
-	0x2f, 0x92, 0x77, 0x75, 0x41, 0x3a, 0xe9, 0xdc, 0xc5, 0x97, 0x1c, 0x0a, 0x4a, 0xc4, 0x69, 0xf1,
-	0x35, 0x80, 0x64, 0x4d, 0xd6, 0x93, 0xf5, 0x80, 0x77, 0x67, 0xb5, 0x33, 0x6b, 0xd9, 0x54, 0xe8,
-	0x2a, 0x44, 0xc5, 0x89, 0x7f, 0x80, 0x82, 0x0a, 0x9a, 0x14, 0x57, 0x53, 0x9f, 0xa8, 0x4e, 0xd0,
-	0x20, 0x0a, 0x83, 0x1c, 0x50, 0xa4, 0x94, 0xd4, 0x14, 0x68, 0x66, 0xd6, 0x71, 0x7c, 0xc6, 0x09,
-	0x9c, 0x04, 0x4d, 0x34, 0x6f, 0xdf, 0xf7, 0x7e, 0x7d, 0xdf, 0xe4, 0x8d, 0x41, 0x05, 0xb1, 0x3e,
-	0x8a, 0x7c, 0x1c, 0x30, 0x1b, 0x0d, 0x7d, 0x7b, 0x78, 0xdb, 0x66, 0x23, 0x2b, 0x8c, 0x08, 0x23,
-	0xda, 0x8d, 0x0b, 0x97, 0x85, 0x86, 0xbe, 0x35, 0xbc, 0x5d, 0x7d, 0x05, 0xfa, 0x38, 0x20, 0xb6,
-	0xf8, 0x2b, 0x41, 0xd5, 0x4d, 0x97, 0x50, 0x9f, 0x50, 0xdb, 0xa7, 0x1e, 0x0f, 0xf6, 0xa9, 0x97,
-	0x38, 0x2a, 0xd2, 0xd1, 0x15, 0x96, 0x2d, 0x8d, 0xc4, 0x55, 0x5d, 0xaa, 0xc9, 0xf3, 0x4b, 0xdf,
-	0xba, 0x47, 0x3c, 0x22, 0x63, 0xf8, 0x29, 0xf9, 0xfa, 0x9a, 0x47, 0x88, 0x37, 0x40, 0x36, 0x0c,
-	0xb1, 0x0d, 0x83, 0x80, 0x30, 0xc8, 0x30, 0x09, 0x66, 0xf9, 0x2a, 0x89, 0x57, 0x58, 0x47, 0xf1,
-	0xb1, 0x0d, 0x83, 0xb1, 0x74, 0x99, 0xdf, 0x2a, 0xe0, 0xa5, 0x43, 0xea, 0xed, 0xf1, 0x82, 0x28,
-	0xf6, 0x3b, 0x23, 0xad, 0x01, 0xd4, 0x1e, 0x64, 0x50, 0x57, 0xea, 0x4a, 0xa3, 0xb4, 0xbd, 0x6e,
-	0xc9, 0x58, 0x6b, 0x16, 0x6b, 0xed, 0x04, 0x63, 0x47, 0x20, 0xb4, 0x0a, 0x50, 0x29, 0xfe, 0x04,
-	0xe9, 0xe9, 0xba, 0xd2, 0x50, 0xda, 0xd9, 0xf3, 0x89, 0xa1, 0x34, 0x1d, 0xf1, 0x49, 0x33, 0x80,
-	0xda, 0x87, 0xb4, 0xaf, 0x67, 0xea, 0x4a, 0xa3, 0xd8, 0x2e, 0xfd, 0x31, 0x31, 0xf2, 0xd1, 0x20,
-	0x6c, 0x99, 0x4d, 0xd3, 0x11, 0x0e, 0x4d, 0x03, 0xea, 0x71, 0x44, 0x7c, 0x5d, 0xe5, 0x00, 0x47,
-	0x9c, 0x5b, 0xf5, 0xcf, 0xbe, 0x32, 0x52, 0x9f, 0x9f, 0x9d, 0x6c, 0x6d, 0xce, 0xe7, 0x5f, 0xe8,
-	0xcd, 0x7c, 0x9c, 0x06, 0x85, 0x03, 0xe4, 0x41, 0x77, 0xdc, 0x19, 0x69, 0xeb, 0x20, 0x1b, 0x90,
-	0xc0, 0x45, 0xa2, 0x53, 0xd5, 0x91, 0x86, 0x76, 0x07, 0x14, 0x3d, 0xc8, 0x59, 0xc5, 0xae, 0xec,
-	0xac, 0xd8, 0xae, 0xfc, 0x3c, 0x31, 0x36, 0x24, 0xc1, 0xb4, 0xf7, 0xb1, 0x85, 0x89, 0xed, 0x43,
-	0xd6, 0xb7, 0xf6, 0x03, 0xe6, 0x14, 0x3c, 0x48, 0x1f, 0x70, 0xa8, 0x56, 0x03, 0x19, 0x0f, 0x52,
-	0xd1, 0xb0, 0xda, 0x2e, 0x4f, 0x27, 0x46, 0xe1, 0x6d, 0x48, 0x0f, 0xb0, 0x8f, 0x99, 0xc3, 0x1d,
-	0xda, 0x1a, 0x48, 0x33, 0x92, 0xb4, 0x9b, 0x66, 0x44, 0xbb, 0x0b, 0xb2, 0x43, 0x38, 0x88, 0x91,
-	0x9e, 0x15, 0x35, 0xde, 0x58, 0x59, 0x63, 0x3a, 0x31, 0x72, 0x3b, 0x3e, 0x89, 0x03, 0xe6, 0xc8,
-	0x08, 0x3e, 0xbb, 0x60, 0x38, 0x57, 0x57, 0x1a, 0xe5, 0x84, 0xcb, 0x32, 0x50, 0x86, 0x7a, 0x5e,
-	0x7c, 0x50, 0x86, 0xdc, 0x8a, 0xf4, 0x82, 0xb4, 0x22, 0x6e, 0x51, 0xbd, 0x28, 0x2d, 0xda, 0x5a,
-	0xe3, 0x2c, 0x7d, 0xff, 0xa4, 0x99, 0xeb, 0x8c, 0x76, 0x21, 0x83, 0xe6, 0x77, 0x19, 0x50, 0xde,
-	0x71, 0x5d, 0x44, 0xe9, 0x01, 0xa6, 0xac, 0x33, 0xd2, 0xde, 0x01, 0x05, 0xb7, 0x0f, 0x71, 0xd0,
-	0xc5, 0x3d, 0x41, 0x4d, 0xb1, 0x6d, 0x5f, 0xd5, 0x5c, 0xfe, 0x1e, 0x07, 0xef, 0xef, 0x9e, 0x4f,
-	0x8c, 0xbc, 0x2b, 0x8f, 0x4e, 0x72, 0xe8, 0xcd, 0x39, 0x4e, 0xaf, 0xe4, 0x38, 0xf3, 0xaf, 0x39,
-	0x56, 0xaf, 0xe6, 0x38, 0xbb, 0xcc, 0x71, 0xee, 0x85, 0x39, 0xce, 0x5f, 0xe2, 0xf8, 0x03, 0x50,
-	0x80, 0x82, 0x28, 0x44, 0xf5, 0x42, 0x3d, 0xd3, 0x28, 0x6d, 0xbf, 0x6e, 0x3d, 0xff, 0x2f, 0x6c,
-	0x49, 0x2a, 0x3b, 0x71, 0x38, 0x40, 0xed, 0xfa, 0xd3, 0x89, 0x91, 0x3a, 0x9f, 0x18, 0x00, 0x5e,
-	0xf0, 0xfb, 0xcd, 0x2f, 0x06, 0x98, 0xb3, 0xed, 0x5c, 0x24, 0x94, 0x02, 0x16, 0x17, 0x04, 0x04,
-	0x0b, 0x02, 0x96, 0x56, 0x09, 0xf8, 0x67, 0x06, 0x94, 0x77, 0xc7, 0x01, 0xf4, 0xb1, 0x7b, 0x1f,
-	0xa1, 0xff, 0x45, 0xc0, 0xbb, 0xa0, 0xc4, 0x05, 0x64, 0x38, 0xec, 0xba, 0x30, 0xbc, 0x5e, 0x42,
-	0x2e, 0x77, 0x07, 0x87, 0xf7, 0x60, 0x38, 0x0b, 0x3d, 0x46, 0x48, 0x84, 0xaa, 0xff, 0x24, 0xf4,
-	0x3e, 0x42, 0x3c, 0x34, 0x91, 0x3f, 0x7b, 0xb5, 0xfc, 0xb9, 0x65, 0xf9, 0xf3, 0x2f, 0x2c, 0x7f,
-	0x61, 0x85, 0xfc, 0xc5, 0xff, 0x44, 0x7e, 0xb0, 0x20, 0x7f, 0x69, 0x41, 0xfe, 0xf2, 0x2a, 0xf9,
-	0x4d, 0x50, 0xdd, 0x1b, 0x31, 0x14, 0x50, 0x4c, 0x82, 0x77, 0x43, 0xb1, 0xb6, 0xe7, 0x1b, 0xaf,
-	0xa5, 0x72, 0xb4, 0xf9, 0xb5, 0x02, 0x36, 0x16, 0x36, 0xa1, 0x83, 0x68, 0x48, 0x02, 0x2a, 0x06,
-	0x15, 0x8b, 0x56, 0x91, 0x7b, 0x54, 0xec, 0xd6, 0x5b, 0x40, 0x1d, 0x10, 0x8f, 0xea, 0x69, 0x31,
-	0xe4, 0xc6, 0xf2, 0x90, 0x07, 0xc4, 0x73, 0x04, 0x44, 0xbb, 0x01, 0x32, 0x11, 0x62, 0xe2, 0x02,
-	0x94, 0x1d, 0x7e, 0xd4, 0x2a, 0xa0, 0x30, 0xf4, 0xbb, 0x28, 0x8a, 0x48, 0x94, 0x6c, 0xbb, 0xfc,
-	0xd0, 0xdf, 0xe3, 0x26, 0x77, 0x71, 0xe9, 0x63, 0x8a, 0x7a, 0x52, 0x44, 0x27, 0xef, 0x41, 0xfa,
-	0x90, 0xa2, 0x5e, 0xd2, 0xe6, 0x63, 0x05, 0xbc, 0x7c, 0x48, 0xbd, 0x87, 0x61, 0x0f, 0x32, 0xf4,
-	0x00, 0x46, 0xd0, 0xa7, 0x7c, 0x57, 0xc0, 0x98, 0xf5, 0x49, 0x84, 0xd9, 0x38, 0xb9, 0xcd, 0xfa,
-	0x0f, 0x4f, 0x9a, 0xeb, 0xc9, 0x83, 0xb7, 0xd3, 0xeb, 0x45, 0x88, 0xd2, 0xf7, 0x58, 0x84, 0x03,
-	0xcf, 0x99, 0x43, 0xb5, 0x3b, 0x20, 0x17, 0x8a, 0x0c, 0xe2, 0xe6, 0x96, 0xb6, 0xf5, 0xe5, 0x31,
-	0x64, 0x85, 0xb6, 0xca, 0x65, 0x72, 0x12, 0x74, 0x6b, 0xed, 0xd1, 0xd9, 0xc9, 0xd6, 0x3c, 0x8f,
-	0x59, 0x01, 0x9b, 0xcf, 0xb5, 0x34, 0xe3, 0x6e, 0xfb, 0x77, 0x05, 0x64, 0x0e, 0xa9, 0xa7, 0x8d,
-	0x01, 0xb8, 0xf4, 0xfe, 0x19, 0xcb, 0x85, 0x16, 0xa8, 0xaf, 0xbe, 0x79, 0x0d, 0x60, 0x96, 0xdf,
-	0xbc, 0xf9, 0xe8, 0xc7, 0xdf, 0xbe, 0x4c, 0xbf, 0x6a, 0x56, 0xf8, 0xf3, 0x4d, 0xe8, 0xc5, 0x5b,
-	0x9e, 0x20, 0xbb, 0x6c, 0xa4, 0x7d, 0x08, 0xca, 0x0b, 0x6c, 0xdd, 0xfc, 0xdb, 0xdc, 0x97, 0x21,
-	0xd5, 0x5b, 0xd7, 0x42, 0x66, 0x0d, 0x54, 0xb3, 0x9f, 0x9e, 0x9d, 0x6c, 0x29, 0xed, 0xfd, 0xa7,
-	0xd3, 0x9a, 0xf2, 0x6c, 0x5a, 0x53, 0x7e, 0x9d, 0xd6, 0x94, 0x2f, 0x4e, 0x6b, 0xa9, 0x67, 0xa7,
-	0xb5, 0xd4, 0x4f, 0xa7, 0xb5, 0xd4, 0xfb, 0xb6, 0x87, 0x59, 0x3f, 0x3e, 0xb2, 0x5c, 0xe2, 0xdb,
-	0x7d, 0xc4, 0xe2, 0x66, 0x18, 0x91, 0x8f, 0x90, 0xcb, 0xa4, 0xd1, 0x8f, 0x8f, 0x78, 0xbf, 0x23,
-	0xd1, 0x38, 0x1b, 0x87, 0x88, 0x1e, 0xe5, 0xc4, 0xcf, 0x80, 0xb7, 0xfe, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0x2e, 0xca, 0x42, 0x07, 0x16, 0x09, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xae, 0x13, 0xe7, 0x6b, 0x92, 0x5f, 0x7f, 0x8b, 0xd5, 0x52, 0x27, 0x40, 0x9c, 0x35, 0x07,
+	0xb2, 0x45, 0xb5, 0xd9, 0x22, 0xad, 0xb4, 0xb9, 0x35, 0xdb, 0x16, 0x2d, 0x6a, 0xc5, 0xca, 0x9b,
+	0x3d, 0xf0, 0x21, 0x55, 0x53, 0x7b, 0xea, 0x58, 0x8d, 0x67, 0x2c, 0xcf, 0x24, 0x24, 0x9c, 0xd0,
+	0x9e, 0x10, 0x27, 0x56, 0xfc, 0x03, 0x1c, 0x38, 0xc1, 0xa5, 0x87, 0x3d, 0x23, 0x8e, 0x2b, 0x4e,
+	0x2b, 0xb8, 0x20, 0x0e, 0x01, 0xb5, 0x48, 0x95, 0x7a, 0xe4, 0xcc, 0x01, 0xcd, 0x8c, 0x93, 0x34,
+	0x9b, 0x4d, 0x03, 0x2b, 0xc1, 0x25, 0x9a, 0xd7, 0xef, 0xf3, 0x7e, 0x3d, 0xcf, 0xe4, 0xb5, 0x41,
+	0x19, 0xb1, 0x36, 0x8a, 0xc3, 0x00, 0x33, 0x1b, 0xf5, 0x42, 0xbb, 0x77, 0xd3, 0x66, 0x7d, 0x2b,
+	0x8a, 0x09, 0x23, 0xda, 0xb5, 0xb1, 0xcb, 0x42, 0xbd, 0xd0, 0xea, 0xdd, 0xac, 0xbc, 0x04, 0xc3,
+	0x00, 0x13, 0x5b, 0xfc, 0x4a, 0x50, 0x65, 0xcd, 0x25, 0x34, 0x24, 0xd4, 0x0e, 0xa9, 0xcf, 0x83,
+	0x43, 0xea, 0x27, 0x8e, 0xb2, 0x74, 0x1c, 0x08, 0xcb, 0x96, 0x46, 0xe2, 0xaa, 0xcc, 0xd4, 0xe4,
+	0xf9, 0xa5, 0x6f, 0xc5, 0x27, 0x3e, 0x91, 0x31, 0xfc, 0x94, 0x3c, 0x7d, 0xd5, 0x27, 0xc4, 0xef,
+	0x20, 0x1b, 0x46, 0x81, 0x0d, 0x31, 0x26, 0x0c, 0xb2, 0x80, 0xe0, 0x51, 0xbe, 0x72, 0xe2, 0x15,
+	0xd6, 0x61, 0xf7, 0xc8, 0x86, 0x78, 0x20, 0x5d, 0xe6, 0xb7, 0x0a, 0xf8, 0xdf, 0x3e, 0xf5, 0x77,
+	0x78, 0x41, 0xd4, 0x0d, 0x5b, 0x7d, 0xad, 0x0e, 0x54, 0x0f, 0x32, 0xa8, 0x2b, 0x35, 0xa5, 0x5e,
+	0xdc, 0x5c, 0xb1, 0x64, 0xac, 0x35, 0x8a, 0xb5, 0xb6, 0xf0, 0xc0, 0x11, 0x08, 0xad, 0x0c, 0x54,
+	0x1a, 0x7c, 0x82, 0xf4, 0x54, 0x4d, 0xa9, 0x2b, 0xcd, 0xcc, 0xc5, 0xd0, 0x50, 0x36, 0x1c, 0xf1,
+	0x48, 0x33, 0x80, 0xda, 0x86, 0xb4, 0xad, 0xa7, 0x6b, 0x4a, 0xbd, 0xd0, 0x2c, 0xfe, 0x31, 0x34,
+	0x72, 0x71, 0x27, 0x6a, 0x98, 0x1b, 0xa6, 0x23, 0x1c, 0x9a, 0x06, 0xd4, 0xa3, 0x98, 0x84, 0xba,
+	0xca, 0x01, 0x8e, 0x38, 0x37, 0x6a, 0x9f, 0x7d, 0x65, 0x2c, 0x7d, 0x7e, 0x7e, 0xb2, 0xbe, 0x36,
+	0x99, 0x7f, 0xaa, 0x37, 0xf3, 0x51, 0x0a, 0xe4, 0xf7, 0x90, 0x0f, 0xdd, 0x41, 0xab, 0xaf, 0xad,
+	0x80, 0x0c, 0x26, 0xd8, 0x45, 0xa2, 0x53, 0xd5, 0x91, 0x86, 0x76, 0x0b, 0x14, 0x7c, 0xc8, 0x59,
+	0x0d, 0x5c, 0xd9, 0x59, 0xa1, 0x59, 0xfe, 0x65, 0x68, 0xac, 0x4a, 0x82, 0xa9, 0x77, 0x6c, 0x05,
+	0xc4, 0x0e, 0x21, 0x6b, 0x5b, 0x77, 0x31, 0x73, 0xf2, 0x3e, 0xa4, 0xf7, 0x38, 0x54, 0xab, 0x82,
+	0xb4, 0x0f, 0xa9, 0x68, 0x58, 0x6d, 0x96, 0x4e, 0x87, 0x46, 0xfe, 0x1d, 0x48, 0xf7, 0x82, 0x30,
+	0x60, 0x0e, 0x77, 0x68, 0xcb, 0x20, 0xc5, 0x48, 0xd2, 0x6e, 0x8a, 0x11, 0xed, 0x36, 0xc8, 0xf4,
+	0x60, 0xa7, 0x8b, 0xf4, 0x8c, 0xa8, 0xf1, 0xfa, 0xdc, 0x1a, 0xa7, 0x43, 0x23, 0xbb, 0x15, 0x92,
+	0x2e, 0x66, 0x8e, 0x8c, 0xe0, 0xb3, 0x0b, 0x86, 0xb3, 0x35, 0xa5, 0x5e, 0x4a, 0xb8, 0x2c, 0x01,
+	0xa5, 0xa7, 0xe7, 0xc4, 0x03, 0xa5, 0xc7, 0xad, 0x58, 0xcf, 0x4b, 0x2b, 0xe6, 0x16, 0xd5, 0x0b,
+	0xd2, 0xa2, 0x8d, 0x65, 0xce, 0xd2, 0x0f, 0x8f, 0x37, 0xb2, 0xad, 0xfe, 0x36, 0x64, 0xd0, 0xfc,
+	0x2e, 0x0d, 0x4a, 0x5b, 0xae, 0x8b, 0x28, 0xdd, 0x0b, 0x28, 0x6b, 0xf5, 0xb5, 0x77, 0x41, 0xde,
+	0x6d, 0xc3, 0x00, 0x1f, 0x04, 0x9e, 0xa0, 0xa6, 0xd0, 0xb4, 0xaf, 0x6a, 0x2e, 0x77, 0x87, 0x83,
+	0xef, 0x6e, 0x5f, 0x0c, 0x8d, 0x9c, 0x2b, 0x8f, 0x4e, 0x72, 0xf0, 0x26, 0x1c, 0xa7, 0xe6, 0x72,
+	0x9c, 0xfe, 0xc7, 0x1c, 0xab, 0x57, 0x73, 0x9c, 0x99, 0xe5, 0x38, 0xfb, 0xc2, 0x1c, 0xe7, 0x2e,
+	0x71, 0xfc, 0x21, 0xc8, 0x43, 0x41, 0x14, 0xa2, 0x7a, 0xbe, 0x96, 0xae, 0x17, 0x37, 0x5f, 0xb3,
+	0x9e, 0xfd, 0x0b, 0x5b, 0x92, 0xca, 0x56, 0x37, 0xea, 0xa0, 0x66, 0xed, 0xc9, 0xd0, 0x58, 0xba,
+	0x18, 0x1a, 0x00, 0x8e, 0xf9, 0xfd, 0xe6, 0x57, 0x03, 0x4c, 0xd8, 0x76, 0xc6, 0x09, 0xa5, 0x80,
+	0x85, 0x29, 0x01, 0xc1, 0x94, 0x80, 0xc5, 0x79, 0x02, 0xfe, 0x99, 0x06, 0xa5, 0xed, 0x01, 0x86,
+	0x61, 0xe0, 0xee, 0x22, 0xf4, 0x9f, 0x08, 0x78, 0x1b, 0x14, 0xb9, 0x80, 0x2c, 0x88, 0x0e, 0x5c,
+	0x18, 0x2d, 0x96, 0x90, 0xcb, 0xdd, 0x0a, 0xa2, 0x3b, 0x30, 0x1a, 0x85, 0x1e, 0x21, 0x24, 0x42,
+	0xd5, 0xbf, 0x13, 0xba, 0x8b, 0x10, 0x0f, 0x4d, 0xe4, 0xcf, 0x5c, 0x2d, 0x7f, 0x76, 0x56, 0xfe,
+	0xdc, 0x0b, 0xcb, 0x9f, 0x9f, 0x23, 0x7f, 0xe1, 0x5f, 0x91, 0x1f, 0x4c, 0xc9, 0x5f, 0x9c, 0x92,
+	0xbf, 0x34, 0x4f, 0x7e, 0x13, 0x54, 0x76, 0xfa, 0x0c, 0x61, 0x1a, 0x10, 0xfc, 0x5e, 0x24, 0xd6,
+	0xf6, 0x64, 0xe3, 0x35, 0x54, 0x8e, 0x36, 0xbf, 0x56, 0xc0, 0xea, 0xd4, 0x26, 0x74, 0x10, 0x8d,
+	0x08, 0xa6, 0x62, 0x50, 0xb1, 0x68, 0x15, 0xb9, 0x47, 0xf9, 0x59, 0xbb, 0x01, 0xd4, 0x0e, 0xf1,
+	0xa9, 0x9e, 0x12, 0x43, 0xae, 0xce, 0x0e, 0xb9, 0x47, 0x7c, 0x47, 0x40, 0xb4, 0x6b, 0x20, 0x1d,
+	0x23, 0x26, 0x2e, 0x40, 0xc9, 0xe1, 0x47, 0xad, 0x0c, 0xf2, 0xbd, 0xf0, 0x00, 0xc5, 0x31, 0x89,
+	0x93, 0x6d, 0x97, 0xeb, 0x85, 0x3b, 0xdc, 0xe4, 0x2e, 0x2e, 0x7d, 0x97, 0x22, 0x4f, 0x8a, 0xe8,
+	0xe4, 0x7c, 0x48, 0x1f, 0x50, 0xe4, 0x25, 0x6d, 0x3e, 0x52, 0xc0, 0xff, 0xf7, 0xa9, 0xff, 0x20,
+	0xf2, 0x20, 0x43, 0xf7, 0x60, 0x0c, 0x43, 0xca, 0x77, 0x05, 0xec, 0xb2, 0x36, 0x89, 0x03, 0x36,
+	0x48, 0x6e, 0xb3, 0xfe, 0xe3, 0xe3, 0x8d, 0x95, 0xe4, 0x85, 0xb7, 0xe5, 0x79, 0x31, 0xa2, 0xf4,
+	0x3e, 0x8b, 0x03, 0xec, 0x3b, 0x13, 0xa8, 0x76, 0x0b, 0x64, 0x23, 0x91, 0x41, 0xdc, 0xdc, 0xe2,
+	0xa6, 0x3e, 0x3b, 0x86, 0xac, 0xd0, 0x54, 0xb9, 0x4c, 0x4e, 0x82, 0x6e, 0x2c, 0x3f, 0x3c, 0x3f,
+	0x59, 0x9f, 0xe4, 0x31, 0xcb, 0x60, 0xed, 0x99, 0x96, 0x46, 0xdc, 0x99, 0x08, 0x2c, 0xef, 0x53,
+	0xff, 0x3e, 0x62, 0xbb, 0x08, 0x6d, 0x23, 0x4c, 0x42, 0xed, 0x2d, 0x90, 0xa5, 0x08, 0x7b, 0x28,
+	0x5e, 0xd8, 0x69, 0x82, 0xe3, 0xff, 0x2f, 0x8f, 0x87, 0xca, 0x57, 0x8d, 0x23, 0x8d, 0x46, 0x91,
+	0x37, 0x91, 0x40, 0x4c, 0x1d, 0xbc, 0x3c, 0x5d, 0x66, 0xd4, 0xc0, 0xe6, 0xf7, 0x29, 0x90, 0xde,
+	0xa7, 0xbe, 0x36, 0x00, 0xe0, 0xd2, 0x0b, 0xd8, 0x98, 0x9d, 0x74, 0x4a, 0xfb, 0xca, 0x1b, 0x0b,
+	0x00, 0xe3, 0x01, 0xaf, 0x3f, 0xfc, 0xe9, 0xf7, 0x2f, 0x53, 0xaf, 0x98, 0x65, 0xfe, 0xfd, 0x40,
+	0xe8, 0xf8, 0x63, 0x22, 0x41, 0x1e, 0xb0, 0xbe, 0xf6, 0x11, 0x28, 0x4d, 0xc9, 0x75, 0xfd, 0xb9,
+	0xb9, 0x2f, 0x43, 0x2a, 0x37, 0x16, 0x42, 0xc6, 0xb7, 0xf3, 0x7d, 0x50, 0xbc, 0x4c, 0x6f, 0xed,
+	0xb9, 0x91, 0x97, 0x10, 0x95, 0xfa, 0x22, 0xc4, 0x28, 0x75, 0x25, 0xf3, 0xe9, 0xf9, 0xc9, 0xba,
+	0xd2, 0xdc, 0x79, 0x72, 0x5a, 0x55, 0x9e, 0x9e, 0x56, 0x95, 0xdf, 0x4e, 0xab, 0xca, 0x17, 0x67,
+	0xd5, 0xa5, 0xa7, 0x67, 0xd5, 0xa5, 0x9f, 0xcf, 0xaa, 0x4b, 0x1f, 0xbc, 0xe9, 0x07, 0xac, 0xdd,
+	0x3d, 0xb4, 0x5c, 0x12, 0xda, 0x1d, 0x72, 0x0c, 0x37, 0x30, 0x62, 0x1f, 0x93, 0xf8, 0x58, 0x18,
+	0x9c, 0x86, 0xbe, 0xe0, 0x83, 0x0d, 0x22, 0x44, 0x0f, 0xb3, 0xe2, 0xf3, 0xe6, 0xed, 0xbf, 0x06,
+	0x00, 0x65, 0xc3, 0xb8, 0x88, 0xee, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -538,6 +639,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to opt in paying the fees of its Ethereum
+	// transactions in an alternative fee denomination.
+	SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error)
 }
 
 type msgClient struct {
@@ -566,6 +670,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error) {
+	out := new(MsgSetFeeDenomResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/SetFeeDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -573,6 +686,9 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to opt in paying the fees of its Ethereum
+	// transactions in an alternative fee denomination.
+	SetFeeDenom(context.Context, *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -585,6 +701,9 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFeeDenom(ctx context.Context, req *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeDenom not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -626,6 +745,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/SetFeeDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeDenom(ctx, req.(*MsgSetFeeDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -638,6 +775,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeDenom",
+			Handler:    _Msg_SetFeeDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1187,6 +1328,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1438,6 +1639,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3181,6 +3408,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0